@@ -0,0 +1,142 @@
+package app
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+)
+
+// setupApp returns an app past InitChain with a single validator, delegated to by a funded
+// sender.
+func setupApp(t *testing.T) (*App, *secp256k1.PrivKey, *authtypes.BaseAccount) {
+	t.Helper()
+
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{}, baseapp.SetChainID(SimAppChainID))
+
+	valKey := ed25519.GenPrivKey().PubKey()
+	valPubKey, err := cryptocodec.ToCmtPubKeyInterface(valKey)
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(valPubKey, 1)})
+
+	senderKey := secp256k1.GenPrivKey()
+	sender := authtypes.NewBaseAccount(senderKey.PubKey().Address().Bytes(), senderKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: sender.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100_000_000))),
+	}
+	genesis, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), app.DefaultGenesis(), valSet, []authtypes.GenesisAccount{sender}, balance)
+	require.NoError(t, err)
+	appState, err := json.Marshal(genesis)
+	require.NoError(t, err)
+
+	_, err = app.InitChain(&abci.RequestInitChain{
+		ChainId:         SimAppChainID,
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   appState,
+	})
+	require.NoError(t, err)
+
+	return app, senderKey, sender
+}
+
+func TestVestingAccountIndex(t *testing.T) {
+	app, senderKey, sender := setupApp(t)
+
+	// the sender funds one vesting account directly and one through authz
+	direct := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	nested := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	newVestingMsg := func(recipient sdk.AccAddress) sdk.Msg {
+		return vestingtypes.NewMsgCreateVestingAccount(sender.GetAddress(), recipient,
+			sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000))), time.Now().Add(time.Hour).Unix(), false)
+	}
+	exec := authz.NewMsgExec(sender.GetAddress(), []sdk.Msg{newVestingMsg(nested)})
+
+	var txs [][]byte
+	for sequence, msg := range []sdk.Msg{newVestingMsg(direct), &exec} {
+		tx, err := simtestutil.GenSignedMockTx(rand.New(rand.NewSource(1)), app.TxConfig(), []sdk.Msg{msg},
+			sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(2000))), simtestutil.DefaultGenTxGas, SimAppChainID,
+			[]uint64{sender.GetAccountNumber()}, []uint64{uint64(sequence)}, senderKey)
+		require.NoError(t, err)
+		txBytes, err := app.TxConfig().TxEncoder()(tx)
+		require.NoError(t, err)
+		txs = append(txs, txBytes)
+	}
+
+	res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Time: time.Now(), Txs: txs})
+	require.NoError(t, err)
+	require.Len(t, res.TxResults, 2)
+	for _, txResult := range res.TxResults {
+		require.Zero(t, txResult.Code, txResult.Log)
+	}
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	for _, recipient := range []sdk.AccAddress{direct, nested} {
+		indexed, err := app.DelegationKeeper.VestingAccounts.Has(app.NewContext(true), recipient)
+		require.NoError(t, err)
+		require.True(t, indexed)
+	}
+}
+
+func TestTimelockProposalMarker(t *testing.T) {
+	app, _, sender := setupApp(t)
+	_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Time: time.Now()})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: 2, Time: time.Now()})
+
+	submit := func() v1.Proposal {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, nil, "", "title", "summary", sender.GetAddress(), false)
+		require.NoError(t, err)
+		require.NoError(t, app.GovKeeper.ActivateVotingPeriod(ctx, proposal))
+		return proposal
+	}
+
+	// the sender holds all the stake, so its yes vote passes the proposal
+	passed := submit()
+	require.NoError(t, app.GovKeeper.AddVote(ctx, passed.Id, sender.GetAddress(), v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	passes, _, _, err := app.GovKeeper.Tally(ctx, passed)
+	require.NoError(t, err)
+	require.True(t, passes)
+	proposalID, err := app.VotingKeeper.TimelockProposal.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, passed.Id, proposalID)
+
+	// the voting period ended hook clears the marker once the messages ran
+	require.NoError(t, app.GovKeeper.Hooks().AfterProposalVotingPeriodEnded(ctx, passed.Id))
+	has, err := app.VotingKeeper.TimelockProposal.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// a rejected proposal does not take the marker, nor keeps the one of an earlier tally
+	require.NoError(t, app.VotingKeeper.TimelockProposal.Set(ctx, passed.Id))
+	rejected := submit()
+	passes, _, _, err = app.GovKeeper.Tally(ctx, rejected)
+	require.NoError(t, err)
+	require.False(t, passes)
+	has, err = app.VotingKeeper.TimelockProposal.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+}
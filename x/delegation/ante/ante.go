@@ -23,4 +23,4 @@ type terminalDecorator struct {
 func (t terminalDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	// Ignore the next handler and run our original handler
 	return t.originalHandler(ctx, tx, simulate)
-}
\ No newline at end of file
+}
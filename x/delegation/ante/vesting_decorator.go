@@ -33,12 +33,12 @@ func (vdd VestingDelegationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 		// Check if this is a delegation message
 		switch msg := msg.(type) {
 		case *stakingtypes.MsgDelegate:
-			if err := vdd.validateDelegation(ctx, msg.DelegatorAddress, msg.Amount); err != nil {
+			if err := vdd.validateDelegation(ctx, sdk.MsgTypeURL(msg), msg.DelegatorAddress, msg.Amount); err != nil {
 				return ctx, err
 			}
 		case *stakingtypes.MsgBeginRedelegate:
 			// For redelegation, we need to check if the source delegation can be moved
-			if err := vdd.validateDelegation(ctx, msg.DelegatorAddress, msg.Amount); err != nil {
+			if err := vdd.validateDelegation(ctx, sdk.MsgTypeURL(msg), msg.DelegatorAddress, msg.Amount); err != nil {
 				return ctx, err
 			}
 		case *stakingtypes.MsgUndelegate:
@@ -51,11 +51,16 @@ func (vdd VestingDelegationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 }
 
 // validateDelegation checks if the delegation is allowed based on vesting status
-func (vdd VestingDelegationDecorator) validateDelegation(ctx sdk.Context, delegatorAddr string, amount sdk.Coin) error {
-	// Use the keeper's validation method
-	err := vdd.dk.ValidateStakingTransaction(ctx, delegatorAddr, amount)
+func (vdd VestingDelegationDecorator) validateDelegation(ctx sdk.Context, msgTypeURL, delegatorAddr string, amount sdk.Coin) error {
+	// Use the keeper's guard so the ante handler and the dry-run query agree
+	decision, err := vdd.dk.EvaluateStakingTransaction(ctx, delegatorAddr, msgTypeURL, amount)
 	if err != nil {
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "vesting validation failed: %s", err.Error())
+		return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if decision.Err != nil {
+		// keep the registered error so clients can match on its ABCI code
+		return errorsmod.Wrap(decision.Err, "vesting validation failed")
 	}
 
 	return nil
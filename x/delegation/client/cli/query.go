@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"cosmos-weighted-governance-sdk/x/delegation/types"
+)
+
+// GetQueryCmd returns the query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdStakingEligibilityBatchFile())
+
+	return cmd
+}
+
+// CmdStakingEligibilityBatchFile queries staking eligibility for the addresses listed in a file.
+// It wraps the staking-eligibility-batch AutoCLI command, which takes the addresses as arguments.
+func CmdStakingEligibilityBatchFile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staking-eligibility-batch-file [file]",
+		Short: "Query staking eligibility for the addresses listed in a file",
+		Long: `Query staking eligibility for every address listed in a file, one address per line.
+Blank lines and lines starting with # are ignored. The number of addresses is limited by
+the max_batch_addresses module parameter.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addresses, err := readAddresses(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.StakingEligibilityBatch(cmd.Context(), &types.QueryStakingEligibilityBatchRequest{
+				Addresses: addresses,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readAddresses reads one address per line, skipping blank lines and comments.
+func readAddresses(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var addresses []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		addresses = append(addresses, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("no addresses found in %s", path)
+	}
+
+	return addresses, nil
+}
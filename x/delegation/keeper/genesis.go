@@ -8,7 +8,12 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
-	return k.Params.Set(ctx, genState.Params)
+	if err := k.Params.Set(ctx, genState.Params); err != nil {
+		return err
+	}
+
+	// vesting accounts from the auth genesis are indexed here, later ones by the post handler
+	return k.IndexAllVestingAccounts(ctx)
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"cosmos-weighted-governance-sdk/x/delegation/types"
 )
@@ -24,6 +25,14 @@ type Keeper struct {
 
 	Schema collections.Schema
 	Params collections.Item[types.Params]
+	// VestingAccounts indexes every known vesting account address.
+	VestingAccounts collections.KeySet[sdk.AccAddress]
+	// RestrictedAccounts holds indexed vesting accounts that are not yet fully vested.
+	RestrictedAccounts collections.KeySet[sdk.AccAddress]
+	// VestingEndQueue orders restricted accounts by their vesting end time.
+	VestingEndQueue collections.KeySet[collections.Pair[int64, sdk.AccAddress]]
+	// VestingPeriodQueue orders the remaining periods of periodic vesting accounts by end time.
+	VestingPeriodQueue collections.KeySet[collections.Triple[int64, sdk.AccAddress, uint64]]
 }
 
 func NewKeeper(
@@ -49,7 +58,13 @@ func NewKeeper(
 		authKeeper:   authKeeper,
 		bankKeeper:   bankKeeper,
 
-		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Params:             collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		VestingAccounts:    collections.NewKeySet(sb, types.VestingAccountKey, "vestingAccounts", sdk.AccAddressKey),
+		RestrictedAccounts: collections.NewKeySet(sb, types.RestrictedAccountKey, "restrictedAccounts", sdk.AccAddressKey),
+		VestingEndQueue: collections.NewKeySet(sb, types.VestingEndQueueKey, "vestingEndQueue",
+			collections.PairKeyCodec(collections.Int64Key, sdk.AccAddressKey)),
+		VestingPeriodQueue: collections.NewKeySet(sb, types.VestingPeriodQueueKey, "vestingPeriodQueue",
+			collections.TripleKeyCodec(collections.Int64Key, sdk.AccAddressKey, collections.Uint64Key)),
 	}
 
 	schema, err := sb.Build()
@@ -22,6 +22,7 @@ type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	authKeeper   *mockAuthKeeper
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -35,12 +36,15 @@ func initFixture(t *testing.T) *fixture {
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	authKeeper := newMockAuthKeeper(addressCodec)
 
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
+		authKeeper,
+		mockBankKeeper{},
 	)
 
 	// Initialize params
@@ -52,5 +56,45 @@ func initFixture(t *testing.T) *fixture {
 		ctx:          ctx,
 		keeper:       k,
 		addressCodec: addressCodec,
+		authKeeper:   authKeeper,
 	}
 }
+
+type mockAuthKeeper struct {
+	addressCodec address.Codec
+	accounts     map[string]sdk.AccountI
+	order        []string
+}
+
+func newMockAuthKeeper(addressCodec address.Codec) *mockAuthKeeper {
+	return &mockAuthKeeper{addressCodec: addressCodec, accounts: make(map[string]sdk.AccountI)}
+}
+
+func (m *mockAuthKeeper) AddressCodec() address.Codec {
+	return m.addressCodec
+}
+
+func (m *mockAuthKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return m.accounts[addr.String()]
+}
+
+func (m *mockAuthKeeper) IterateAccounts(_ context.Context, cb func(sdk.AccountI) bool) {
+	for _, addr := range m.order {
+		if cb(m.accounts[addr]) {
+			return
+		}
+	}
+}
+
+func (m *mockAuthKeeper) SetAccount(acc sdk.AccountI) {
+	if _, ok := m.accounts[acc.GetAddress().String()]; !ok {
+		m.order = append(m.order, acc.GetAddress().String())
+	}
+	m.accounts[acc.GetAddress().String()] = acc
+}
+
+type mockBankKeeper struct{}
+
+func (mockBankKeeper) SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins {
+	return sdk.NewCoins()
+}
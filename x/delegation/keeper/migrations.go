@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"cosmos-weighted-governance-sdk/x/delegation/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the module state from version 1 to 2. Version 2 adds the
+// max_batch_addresses param, which is unset in version 1 state and gets its default,
+// and the vesting account index and queues, which are filled from the auth store.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.MaxBatchAddresses == 0 {
+		params.MaxBatchAddresses = types.DefaultMaxBatchAddresses
+	}
+
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	return m.keeper.IndexAllVestingAccounts(ctx)
+}
@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmos-weighted-governance-sdk/x/delegation/keeper"
+	"cosmos-weighted-governance-sdk/x/delegation/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+	now := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+	restricted, vested, regular := setupVestingAccounts(t, f, now)
+
+	// version 1 params have no max batch addresses
+	require.NoError(t, f.keeper.Params.Set(ctx, types.Params{StakeDenom: "ustake", EmitPeriodEvents: true}))
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.NewParams("ustake", true, types.DefaultMaxBatchAddresses), params)
+	require.NoError(t, params.Validate())
+
+	// vesting accounts created before the upgrade are indexed and queued
+	for addr, indexed := range map[string]bool{restricted.String(): true, vested.String(): true, regular.String(): false} {
+		has, err := f.keeper.VestingAccounts.Has(ctx, sdk.MustAccAddressFromBech32(addr))
+		require.NoError(t, err)
+		require.Equal(t, indexed, has, addr)
+	}
+	has, err := f.keeper.RestrictedAccounts.Has(ctx, restricted)
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = f.keeper.VestingEndQueue.Has(ctx, collections.Join(now.Add(time.Hour).Unix(), restricted))
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = f.keeper.RestrictedAccounts.Has(ctx, vested)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// a value already set is kept
+	params.MaxBatchAddresses = 7
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+	params, err = f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint32(7), params.MaxBatchAddresses)
+}
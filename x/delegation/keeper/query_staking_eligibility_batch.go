@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmos-weighted-governance-sdk/x/delegation/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) StakingEligibilityBatch(ctx context.Context, req *types.QueryStakingEligibilityBatchRequest) (*types.QueryStakingEligibilityBatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Addresses) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "addresses cannot be empty")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	if uint64(len(req.Addresses)) > uint64(params.MaxBatchAddresses) {
+		return nil, status.Error(codes.InvalidArgument,
+			fmt.Sprintf("too many addresses: got %d, max %d", len(req.Addresses), params.MaxBatchAddresses))
+	}
+
+	// invalid or unknown addresses are reported per entry rather than failing the batch
+	results := make([]types.StakingEligibilityResult, 0, len(req.Addresses))
+	for _, address := range req.Addresses {
+		eligibility, err := q.k.CheckStakingEligibility(ctx, address)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		results = append(results, types.StakingEligibilityResult{
+			Address:     address,
+			Eligibility: *eligibility,
+		})
+	}
+
+	return &types.QueryStakingEligibilityBatchResponse{Results: results}, nil
+}
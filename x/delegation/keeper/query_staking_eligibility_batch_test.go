@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmos-weighted-governance-sdk/x/delegation/keeper"
+	"cosmos-weighted-governance-sdk/x/delegation/types"
+)
+
+func TestStakingEligibilityBatchQuery(t *testing.T) {
+	f := initFixture(t)
+	now := time.Unix(1_700_000_000, 0)
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+	restricted, vested, regular := setupVestingAccounts(t, f, now)
+
+	params := types.DefaultParams()
+	params.MaxBatchAddresses = 3
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	var addresses []string
+	for _, addr := range []sdk.AccAddress{restricted, vested, regular} {
+		address, err := f.addressCodec.BytesToString(addr)
+		require.NoError(t, err)
+		addresses = append(addresses, address)
+	}
+
+	t.Run("results in request order", func(t *testing.T) {
+		response, err := qs.StakingEligibilityBatch(f.ctx, &types.QueryStakingEligibilityBatchRequest{Addresses: addresses})
+		require.NoError(t, err)
+		require.Len(t, response.Results, len(addresses))
+
+		for i, result := range response.Results {
+			require.Equal(t, addresses[i], result.Address)
+			single, err := qs.StakingEligibility(f.ctx, &types.QueryStakingEligibilityRequest{Address: addresses[i]})
+			require.NoError(t, err)
+			require.Equal(t, *single, result.Eligibility)
+		}
+		require.False(t, response.Results[0].Eligibility.IsEligible)
+		require.True(t, response.Results[1].Eligibility.IsEligible)
+		require.True(t, response.Results[2].Eligibility.IsEligible)
+	})
+
+	t.Run("invalid address reported per entry", func(t *testing.T) {
+		response, err := qs.StakingEligibilityBatch(f.ctx, &types.QueryStakingEligibilityBatchRequest{Addresses: []string{"invalid", addresses[2]}})
+		require.NoError(t, err)
+		require.Len(t, response.Results, 2)
+		require.False(t, response.Results[0].Eligibility.IsEligible)
+		require.Equal(t, "invalid address format", response.Results[0].Eligibility.Reason)
+		require.True(t, response.Results[1].Eligibility.IsEligible)
+	})
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.QueryStakingEligibilityBatchRequest
+		err     error
+	}{
+		{
+			desc:    "too many addresses",
+			request: &types.QueryStakingEligibilityBatchRequest{Addresses: append(addresses, addresses[0])},
+			err:     status.Error(codes.InvalidArgument, "too many addresses: got 4, max 3"),
+		},
+		{
+			desc:    "empty",
+			request: &types.QueryStakingEligibilityBatchRequest{},
+			err:     status.Error(codes.InvalidArgument, "addresses cannot be empty"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := qs.StakingEligibilityBatch(f.ctx, tc.request)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
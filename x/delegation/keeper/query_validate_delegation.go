@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmos-weighted-governance-sdk/x/delegation/types"
+)
+
+func (q queryServer) ValidateDelegation(ctx context.Context, req *types.QueryValidateDelegationRequest) (*types.QueryValidateDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+
+	if req.ValidatorAddress != "" {
+		if _, err := sdk.ValAddressFromBech32(req.ValidatorAddress); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %s", err)
+		}
+	}
+
+	if err := req.Amount.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %s", err)
+	}
+
+	msgTypeURL := req.MsgTypeUrl
+	switch msgTypeURL {
+	case "":
+		msgTypeURL = sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+	case sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}):
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported staking message type: %s", msgTypeURL)
+	}
+
+	// same path the ante handler takes
+	decision, err := q.k.EvaluateStakingTransaction(ctx, req.DelegatorAddress, msgTypeURL, req.Amount)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &types.QueryValidateDelegationResponse{
+		Allowed:          decision.Allowed,
+		Reason:           decision.Reason,
+		MaxAllowedAmount: decision.MaxAllowed,
+		Policy:           decision.Policy,
+	}
+	if decision.Err != nil {
+		resp.Message = decision.Err.Error()
+		resp.Codespace, resp.Code, _ = errorsmod.ABCIInfo(decision.Err, false)
+	} else if decision.Reason == types.DelegationCheckReason_DELEGATION_CHECK_REASON_DENOM_NOT_PROTECTED {
+		resp.Codespace, resp.Code = types.ErrDenomNotProtected.Codespace(), types.ErrDenomNotProtected.ABCICode()
+	}
+
+	return resp, nil
+}
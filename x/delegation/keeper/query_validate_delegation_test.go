@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmos-weighted-governance-sdk/x/delegation/keeper"
+	"cosmos-weighted-governance-sdk/x/delegation/types"
+)
+
+func TestValidateDelegationQuery(t *testing.T) {
+	f := initFixture(t)
+	now := time.Unix(1_700_000_000, 0)
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+	restricted, _, regular := setupVestingAccounts(t, f, now)
+
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	restrictedStr, err := f.addressCodec.BytesToString(restricted)
+	require.NoError(t, err)
+	regularStr, err := f.addressCodec.BytesToString(regular)
+	require.NoError(t, err)
+	unknownStr, err := f.addressCodec.BytesToString(sdk.AccAddress("unknown_____________"))
+	require.NoError(t, err)
+
+	stake := func(amount int64) sdk.Coin { return sdk.NewInt64Coin(types.DefaultStakeDenom, amount) }
+	maxAllowed := stake(500)
+
+	tests := []struct {
+		desc     string
+		request  *types.QueryValidateDelegationRequest
+		response *types.QueryValidateDelegationResponse
+		err      error
+	}{
+		{
+			desc:    "within vested",
+			request: &types.QueryValidateDelegationRequest{DelegatorAddress: restrictedStr, Amount: stake(500)},
+			response: &types.QueryValidateDelegationResponse{
+				Allowed:          true,
+				Reason:           types.DelegationCheckReason_DELEGATION_CHECK_REASON_WITHIN_VESTED,
+				MaxAllowedAmount: &maxAllowed,
+				Policy:           keeper.PolicyVestedOnly,
+			},
+		},
+		{
+			desc: "unvested redelegation",
+			request: &types.QueryValidateDelegationRequest{
+				DelegatorAddress: restrictedStr,
+				Amount:           stake(501),
+				MsgTypeUrl:       sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}),
+			},
+			response: &types.QueryValidateDelegationResponse{
+				Allowed:          false,
+				Reason:           types.DelegationCheckReason_DELEGATION_CHECK_REASON_UNVESTED_STAKE,
+				MaxAllowedAmount: &maxAllowed,
+				Policy:           keeper.PolicyVestedOnly,
+				Message:          "requested 501, vested 500 stake: cannot redelegate unvested tokens",
+				Codespace:        types.ModuleName,
+				Code:             types.ErrRedelegationDenied.ABCICode(),
+			},
+		},
+		{
+			desc: "undelegation",
+			request: &types.QueryValidateDelegationRequest{
+				DelegatorAddress: restrictedStr,
+				Amount:           stake(1000),
+				MsgTypeUrl:       sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+			},
+			response: &types.QueryValidateDelegationResponse{
+				Allowed: true,
+				Reason:  types.DelegationCheckReason_DELEGATION_CHECK_REASON_UNRESTRICTED_MSG,
+				Policy:  keeper.PolicyUnrestricted,
+			},
+		},
+		{
+			desc:    "other denom",
+			request: &types.QueryValidateDelegationRequest{DelegatorAddress: restrictedStr, Amount: sdk.NewCoin("other", sdkmath.NewInt(1000))},
+			response: &types.QueryValidateDelegationResponse{
+				Allowed:   true,
+				Reason:    types.DelegationCheckReason_DELEGATION_CHECK_REASON_DENOM_NOT_PROTECTED,
+				Policy:    keeper.PolicyUnrestricted,
+				Codespace: types.ModuleName,
+				Code:      types.ErrDenomNotProtected.ABCICode(),
+			},
+		},
+		{
+			desc:    "non-vesting account",
+			request: &types.QueryValidateDelegationRequest{DelegatorAddress: regularStr, Amount: stake(1000)},
+			response: &types.QueryValidateDelegationResponse{
+				Allowed: true,
+				Reason:  types.DelegationCheckReason_DELEGATION_CHECK_REASON_NON_VESTING_ACCOUNT,
+				Policy:  keeper.PolicyUnrestricted,
+			},
+		},
+		{
+			desc:    "account not found",
+			request: &types.QueryValidateDelegationRequest{DelegatorAddress: unknownStr, Amount: stake(1)},
+			response: &types.QueryValidateDelegationResponse{
+				Allowed:   false,
+				Reason:    types.DelegationCheckReason_DELEGATION_CHECK_REASON_ACCOUNT_NOT_FOUND,
+				Policy:    keeper.PolicyNone,
+				Message:   "delegator " + unknownStr + ": account not found",
+				Codespace: types.ModuleName,
+				Code:      types.ErrAccountNotFound.ABCICode(),
+			},
+		},
+		{
+			desc:    "unsupported message",
+			request: &types.QueryValidateDelegationRequest{DelegatorAddress: restrictedStr, Amount: stake(1), MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend"},
+			err:     status.Error(codes.InvalidArgument, "unsupported staking message type: /cosmos.bank.v1beta1.MsgSend"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := qs.ValidateDelegation(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
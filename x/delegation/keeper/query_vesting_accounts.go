@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmos-weighted-governance-sdk/x/delegation/types"
+)
+
+func (q queryServer) VestingAccounts(ctx context.Context, req *types.QueryVestingAccountsRequest) (*types.QueryVestingAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	// the transform runs right after the predicate accepts an entry, so it reuses the
+	// info the predicate loaded
+	var info types.VestingAccountInfo
+	accounts, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.VestingAccounts,
+		req.Pagination,
+		func(addr sdk.AccAddress, _ collections.NoValue) (bool, error) {
+			var found bool
+			info, found, err = q.k.GetVestingAccountInfo(ctx, addr, params.StakeDenom)
+			if err != nil || !found {
+				return false, err
+			}
+
+			switch req.Filter {
+			case types.VestingAccountFilter_VESTING_ACCOUNT_FILTER_FULLY_VESTED:
+				return info.IsEligible, nil
+			case types.VestingAccountFilter_VESTING_ACCOUNT_FILTER_RESTRICTED:
+				return !info.IsEligible, nil
+			default:
+				return true, nil
+			}
+		},
+		func(sdk.AccAddress, collections.NoValue) (types.VestingAccountInfo, error) {
+			return info, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVestingAccountsResponse{VestingAccounts: accounts, Pagination: pageRes}, nil
+}
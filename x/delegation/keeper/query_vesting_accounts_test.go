@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmos-weighted-governance-sdk/x/delegation/keeper"
+	"cosmos-weighted-governance-sdk/x/delegation/types"
+)
+
+// setupVestingAccounts registers a restricted continuous vesting account, a fully
+// vested delayed vesting account and a regular account, and returns their addresses.
+func setupVestingAccounts(t *testing.T, f *fixture, now time.Time) (restricted, vested, regular sdk.AccAddress) {
+	t.Helper()
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(types.DefaultStakeDenom, 1000))
+
+	restricted = sdk.AccAddress("restricted__________")
+	continuous, err := vestingtypes.NewContinuousVestingAccount(
+		authtypes.NewBaseAccountWithAddress(restricted), coins, now.Add(-time.Hour).Unix(), now.Add(time.Hour).Unix())
+	require.NoError(t, err)
+	f.authKeeper.SetAccount(continuous)
+
+	vested = sdk.AccAddress("vested______________")
+	delayed, err := vestingtypes.NewDelayedVestingAccount(
+		authtypes.NewBaseAccountWithAddress(vested), coins, now.Add(-time.Hour).Unix())
+	require.NoError(t, err)
+	f.authKeeper.SetAccount(delayed)
+
+	regular = sdk.AccAddress("regular_____________")
+	f.authKeeper.SetAccount(authtypes.NewBaseAccountWithAddress(regular))
+
+	return restricted, vested, regular
+}
+
+func TestVestingAccountIndex(t *testing.T) {
+	f := initFixture(t)
+	now := time.Unix(1_700_000_000, 0)
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+	restricted, vested, regular := setupVestingAccounts(t, f, now)
+
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, *types.DefaultGenesis()))
+
+	for _, addr := range []sdk.AccAddress{restricted, vested} {
+		has, err := f.keeper.VestingAccounts.Has(f.ctx, addr)
+		require.NoError(t, err)
+		require.True(t, has)
+	}
+	has, err := f.keeper.VestingAccounts.Has(f.ctx, regular)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// accounts created after genesis are indexed individually
+	late := sdk.AccAddress("late________________")
+	locked, err := vestingtypes.NewPermanentLockedAccount(
+		authtypes.NewBaseAccountWithAddress(late), sdk.NewCoins(sdk.NewInt64Coin(types.DefaultStakeDenom, 5)))
+	require.NoError(t, err)
+	f.authKeeper.SetAccount(locked)
+	require.NoError(t, f.keeper.IndexVestingAccount(f.ctx, late))
+	require.NoError(t, f.keeper.IndexVestingAccount(f.ctx, regular))
+
+	has, err = f.keeper.VestingAccounts.Has(f.ctx, late)
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = f.keeper.VestingAccounts.Has(f.ctx, regular)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the send restriction indexes vesting recipients and passes every recipient through
+	funded := sdk.AccAddress("funded______________")
+	delayed, err := vestingtypes.NewDelayedVestingAccount(
+		authtypes.NewBaseAccountWithAddress(funded), sdk.NewCoins(sdk.NewInt64Coin(types.DefaultStakeDenom, 5)), now.Add(time.Hour).Unix())
+	require.NoError(t, err)
+	f.authKeeper.SetAccount(delayed)
+	for _, addr := range []sdk.AccAddress{funded, regular} {
+		to, err := f.keeper.IndexVestingRecipient(f.ctx, vested, addr, nil)
+		require.NoError(t, err)
+		require.Equal(t, addr, to)
+	}
+
+	has, err = f.keeper.VestingAccounts.Has(f.ctx, funded)
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = f.keeper.VestingEndQueue.Has(f.ctx, collections.Join(now.Add(time.Hour).Unix(), funded))
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = f.keeper.VestingAccounts.Has(f.ctx, regular)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestVestingAccountsQuery(t *testing.T) {
+	f := initFixture(t)
+	now := time.Unix(1_700_000_000, 0)
+	f.ctx = sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+	restricted, vested, _ := setupVestingAccounts(t, f, now)
+	require.NoError(t, f.keeper.IndexAllVestingAccounts(f.ctx))
+
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	restrictedStr, err := f.addressCodec.BytesToString(restricted)
+	require.NoError(t, err)
+	vestedStr, err := f.addressCodec.BytesToString(vested)
+	require.NoError(t, err)
+
+	t.Run("All", func(t *testing.T) {
+		resp, err := qs.VestingAccounts(f.ctx, &types.QueryVestingAccountsRequest{
+			Pagination: &query.PageRequest{CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.VestingAccounts, 2)
+		require.Equal(t, uint64(2), resp.Pagination.Total)
+	})
+	t.Run("Restricted", func(t *testing.T) {
+		resp, err := qs.VestingAccounts(f.ctx, &types.QueryVestingAccountsRequest{
+			Filter: types.VestingAccountFilter_VESTING_ACCOUNT_FILTER_RESTRICTED,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.VestingAccounts, 1)
+
+		info := resp.VestingAccounts[0]
+		require.Equal(t, restrictedStr, info.Address)
+		require.Equal(t, keeper.VestingTypeContinuous, info.VestingType)
+		require.False(t, info.IsEligible)
+		require.Equal(t, sdkmath.NewInt(500), info.VestedAmount)
+		require.Equal(t, sdkmath.NewInt(500), info.VestingAmount)
+		require.True(t, info.DelegatedVesting.IsZero())
+	})
+	t.Run("FullyVested", func(t *testing.T) {
+		resp, err := qs.VestingAccounts(f.ctx, &types.QueryVestingAccountsRequest{
+			Filter: types.VestingAccountFilter_VESTING_ACCOUNT_FILTER_FULLY_VESTED,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.VestingAccounts, 1)
+		require.Equal(t, vestedStr, resp.VestingAccounts[0].Address)
+		require.Equal(t, keeper.VestingTypeDelayed, resp.VestingAccounts[0].VestingType)
+		require.True(t, resp.VestingAccounts[0].IsEligible)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := qs.VestingAccounts(f.ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"cosmos-weighted-governance-sdk/x/delegation/types"
+)
+
+// Vesting account types reported by the VestingAccounts query.
+const (
+	VestingTypeContinuous      = "continuous"
+	VestingTypeDelayed         = "delayed"
+	VestingTypePeriodic        = "periodic"
+	VestingTypePermanentLocked = "permanent_locked"
+	VestingTypeUnknown         = "unknown"
+)
+
+// IndexVestingAccount adds the account to the vesting account index if it is
+// a vesting account. Non-vesting and unknown accounts are ignored.
+func (k Keeper) IndexVestingAccount(ctx context.Context, addr sdk.AccAddress) error {
+	account := k.authKeeper.GetAccount(ctx, addr)
+	if account == nil {
+		return nil
+	}
+
+	vestingAcc, isVesting := account.(types.VestingAccount)
+	if !isVesting {
+		return nil
+	}
+
+	if err := k.VestingAccounts.Set(ctx, addr); err != nil {
+		return err
+	}
+
+	return k.enqueueVestingAccount(ctx, addr, vestingAcc)
+}
+
+// IndexVestingRecipient is a bank send restriction that adds the recipient to the vesting
+// account index if it is a vesting account. The vesting msg server stores a new vesting
+// account before funding it, so accounts are indexed however the creation message was
+// delivered, including authz, interchain accounts and governance proposals.
+func (k Keeper) IndexVestingRecipient(ctx context.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+	indexed, err := k.VestingAccounts.Has(ctx, toAddr)
+	if err != nil || indexed {
+		return toAddr, err
+	}
+
+	return toAddr, k.IndexVestingAccount(ctx, toAddr)
+}
+
+// IndexAllVestingAccounts scans the auth store and indexes every vesting account.
+func (k Keeper) IndexAllVestingAccounts(ctx context.Context) error {
+	var err error
+	k.authKeeper.IterateAccounts(ctx, func(account sdk.AccountI) bool {
+		vestingAcc, isVesting := account.(types.VestingAccount)
+		if !isVesting {
+			return false
+		}
+		if err = k.VestingAccounts.Set(ctx, account.GetAddress()); err != nil {
+			return true
+		}
+		err = k.enqueueVestingAccount(ctx, account.GetAddress(), vestingAcc)
+		return err != nil
+	})
+
+	return err
+}
+
+// GetVestingAccountInfo returns the staking status of an indexed vesting account.
+// It returns false if the account no longer exists or is no longer a vesting account.
+func (k Keeper) GetVestingAccountInfo(ctx context.Context, addr sdk.AccAddress, stakeDenom string) (types.VestingAccountInfo, bool, error) {
+	account := k.authKeeper.GetAccount(ctx, addr)
+	if account == nil {
+		return types.VestingAccountInfo{}, false, nil
+	}
+
+	vestingAcc, isVesting := account.(types.VestingAccount)
+	if !isVesting {
+		return types.VestingAccountInfo{}, false, nil
+	}
+
+	address, err := k.addressCodec.BytesToString(addr)
+	if err != nil {
+		return types.VestingAccountInfo{}, false, err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	return types.VestingAccountInfo{
+		Address:          address,
+		VestingType:      vestingType(vestingAcc),
+		VestedAmount:     vestingAcc.GetVestedCoins(blockTime).AmountOf(stakeDenom),
+		VestingAmount:    vestingAcc.GetVestingCoins(blockTime).AmountOf(stakeDenom),
+		DelegatedFree:    vestingAcc.GetDelegatedFree().AmountOf(stakeDenom),
+		DelegatedVesting: vestingAcc.GetDelegatedVesting().AmountOf(stakeDenom),
+		IsEligible:       isFullyVested(vestingAcc, blockTime),
+	}, true, nil
+}
+
+// isFullyVested reports whether nothing is left vesting at the given time.
+func isFullyVested(vestingAcc types.VestingAccount, blockTime time.Time) bool {
+	vestingCoins := vestingAcc.GetVestingCoins(blockTime)
+	return vestingCoins.IsZero() ||
+		vestingAcc.GetVestedCoins(blockTime).IsAllGTE(vestingAcc.GetOriginalVesting())
+}
+
+func vestingType(vestingAcc types.VestingAccount) string {
+	switch vestingAcc.(type) {
+	case *vestingtypes.ContinuousVestingAccount:
+		return VestingTypeContinuous
+	case *vestingtypes.DelayedVestingAccount:
+		return VestingTypeDelayed
+	case *vestingtypes.PeriodicVestingAccount:
+		return VestingTypePeriodic
+	case *vestingtypes.PermanentLockedAccount:
+		return VestingTypePermanentLocked
+	default:
+		return VestingTypeUnknown
+	}
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"cosmos-weighted-governance-sdk/x/delegation/types"
+)
+
+// enqueueVestingAccount marks a vesting account as restricted and queues its
+// vesting end time (and period end times for periodic accounts). Accounts that
+// are already fully vested are left out, permanently locked accounts never leave
+// the restricted set.
+func (k Keeper) enqueueVestingAccount(ctx context.Context, addr sdk.AccAddress, vestingAcc types.VestingAccount) error {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if isFullyVested(vestingAcc, blockTime) {
+		return nil
+	}
+
+	if err := k.RestrictedAccounts.Set(ctx, addr); err != nil {
+		return err
+	}
+
+	endTime := vestingAcc.GetEndTime()
+	if endTime <= 0 {
+		return nil
+	}
+
+	if err := k.VestingEndQueue.Set(ctx, collections.Join(endTime, addr)); err != nil {
+		return err
+	}
+
+	periodicAcc, isPeriodic := vestingAcc.(*vestingtypes.PeriodicVestingAccount)
+	if !isPeriodic {
+		return nil
+	}
+
+	periodEnd := periodicAcc.GetStartTime()
+	for i, period := range periodicAcc.GetVestingPeriods() {
+		periodEnd += period.Length
+		if periodEnd <= blockTime.Unix() {
+			continue
+		}
+		if err := k.VestingPeriodQueue.Set(ctx, collections.Join3(periodEnd, addr, uint64(i))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ProcessVestingQueues pops every vesting period and vesting end time that has
+// been reached by the current block time, emitting the matching events and
+// removing fully vested accounts from the restricted set.
+func (k Keeper) ProcessVestingQueues(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	periodRng := new(collections.Range[collections.Triple[int64, sdk.AccAddress, uint64]]).
+		EndExclusive(collections.TriplePrefix[int64, sdk.AccAddress, uint64](now + 1))
+	var unlockedPeriods []collections.Triple[int64, sdk.AccAddress, uint64]
+	if err := k.VestingPeriodQueue.Walk(ctx, periodRng, func(key collections.Triple[int64, sdk.AccAddress, uint64]) (bool, error) {
+		unlockedPeriods = append(unlockedPeriods, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range unlockedPeriods {
+		if err := k.VestingPeriodQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+		if !params.EmitPeriodEvents {
+			continue
+		}
+
+		account, ok := k.authKeeper.GetAccount(ctx, key.K2()).(*vestingtypes.PeriodicVestingAccount)
+		if !ok || key.K3() >= uint64(len(account.VestingPeriods)) {
+			continue
+		}
+
+		address, err := k.addressCodec.BytesToString(key.K2())
+		if err != nil {
+			return err
+		}
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeVestingPeriodUnlocked,
+				sdk.NewAttribute(types.AttributeKeyAddress, address),
+				sdk.NewAttribute(types.AttributeKeyPeriodIndex, fmt.Sprintf("%d", key.K3())),
+				sdk.NewAttribute(types.AttributeKeyAmount, account.VestingPeriods[key.K3()].Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", key.K1())),
+			),
+		)
+	}
+
+	endRng := new(collections.Range[collections.Pair[int64, sdk.AccAddress]]).
+		EndExclusive(collections.PairPrefix[int64, sdk.AccAddress](now + 1))
+	var matured []collections.Pair[int64, sdk.AccAddress]
+	if err := k.VestingEndQueue.Walk(ctx, endRng, func(key collections.Pair[int64, sdk.AccAddress]) (bool, error) {
+		matured = append(matured, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range matured {
+		if err := k.VestingEndQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+		if err := k.RestrictedAccounts.Remove(ctx, key.K2()); err != nil {
+			return err
+		}
+
+		vestingAcc, ok := k.authKeeper.GetAccount(ctx, key.K2()).(types.VestingAccount)
+		if !ok {
+			continue
+		}
+
+		address, err := k.addressCodec.BytesToString(key.K2())
+		if err != nil {
+			return err
+		}
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAccountFullyVested,
+				sdk.NewAttribute(types.AttributeKeyAddress, address),
+				sdk.NewAttribute(types.AttributeKeyVestingType, vestingType(vestingAcc)),
+				sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", key.K1())),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmos-weighted-governance-sdk/x/delegation/types"
+)
+
+func countEvents(ctx sdk.Context, eventType string) int {
+	n := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			n++
+		}
+	}
+	return n
+}
+
+func TestProcessVestingQueues(t *testing.T) {
+	f := initFixture(t)
+	now := time.Unix(1_700_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	params := types.DefaultParams()
+	params.EmitPeriodEvents = true
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	restricted, vested, _ := setupVestingAccounts(t, f, now)
+
+	periodic := sdk.AccAddress("periodic____________")
+	periods := vestingtypes.Periods{
+		{Length: 600, Amount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultStakeDenom, 100))},
+		{Length: 600, Amount: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultStakeDenom, 100))},
+	}
+	periodicAcc, err := vestingtypes.NewPeriodicVestingAccount(
+		authtypes.NewBaseAccountWithAddress(periodic), periods.TotalAmount(), now.Unix(), periods)
+	require.NoError(t, err)
+	f.authKeeper.SetAccount(periodicAcc)
+
+	require.NoError(t, f.keeper.IndexAllVestingAccounts(ctx))
+
+	for addr, expected := range map[string]bool{restricted.String(): true, vested.String(): false, periodic.String(): true} {
+		has, err := f.keeper.RestrictedAccounts.Has(ctx, sdk.MustAccAddressFromBech32(addr))
+		require.NoError(t, err)
+		require.Equal(t, expected, has, addr)
+	}
+
+	// first period of the periodic account unlocks
+	ctx = ctx.WithBlockTime(now.Add(10 * time.Minute)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.ProcessVestingQueues(ctx))
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeVestingPeriodUnlocked))
+	require.Equal(t, 0, countEvents(ctx, types.EventTypeAccountFullyVested))
+
+	// the periodic account finishes vesting
+	ctx = ctx.WithBlockTime(now.Add(20 * time.Minute)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.ProcessVestingQueues(ctx))
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeVestingPeriodUnlocked))
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeAccountFullyVested))
+	has, err := f.keeper.RestrictedAccounts.Has(ctx, periodic)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the continuous account finishes vesting, queues are drained afterwards
+	ctx = ctx.WithBlockTime(now.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.ProcessVestingQueues(ctx))
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeAccountFullyVested))
+	has, err = f.keeper.RestrictedAccounts.Has(ctx, restricted)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.ProcessVestingQueues(ctx))
+	require.Empty(t, ctx.EventManager().Events())
+}
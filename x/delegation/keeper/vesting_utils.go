@@ -7,7 +7,10 @@ import (
 
 	"cosmos-weighted-governance-sdk/x/delegation/types"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // CheckStakingEligibility checks if an account is eligible to stake
@@ -45,7 +48,6 @@ func (k Keeper) CheckStakingEligibility(ctx context.Context, address string) (*t
 	
 	vestedCoins := vestingAcc.GetVestedCoins(blockTime)
 	vestingCoins := vestingAcc.GetVestingCoins(blockTime)
-	originalVesting := vestingAcc.GetOriginalVesting()
 
 	var vestedAmount, vestingAmount int64
 	
@@ -69,10 +71,7 @@ func (k Keeper) CheckStakingEligibility(ctx context.Context, address string) (*t
 	}
 
 	// check if fully vested
-	allVested := vestingCoins.IsZero() || 
-		vestedCoins.IsAllGTE(originalVesting)
-
-	if allVested {
+	if isFullyVested(vestingAcc, blockTime) {
 		return &types.QueryStakingEligibilityResponse{
 			IsEligible:    true,
 			Reason:        "all tokens are vested",
@@ -108,44 +107,74 @@ func (k Keeper) IsVestingAccount(ctx context.Context, address string) bool {
 	return isVesting
 }
 
-// ValidateStakingTransaction validates a staking transaction for vesting restrictions
+// ValidateStakingTransaction validates a delegation for vesting restrictions. Rejections
+// are returned as the module's registered errors.
 func (k Keeper) ValidateStakingTransaction(ctx context.Context, delegatorAddr string, amount sdk.Coin) error {
+	decision, err := k.EvaluateStakingTransaction(ctx, delegatorAddr, sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}), amount)
+	if err != nil {
+		return err
+	}
+
+	return decision.Err
+}
+
+// EvaluateStakingTransaction runs the vesting guard for a staking message and
+// reports the decision, including why it was taken. The returned error is only
+// set when the guard itself could not run.
+func (k Keeper) EvaluateStakingTransaction(ctx context.Context, delegatorAddr, msgTypeURL string, amount sdk.Coin) (StakingDecision, error) {
 	accAddr, err := k.authKeeper.AddressCodec().StringToBytes(delegatorAddr)
 	if err != nil {
-		return fmt.Errorf("invalid delegator address: %s", err)
+		return deny(types.DelegationCheckReason_DELEGATION_CHECK_REASON_INVALID_ADDRESS, PolicyNone,
+			errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address: %s", err)), nil
 	}
 
 	account := k.authKeeper.GetAccount(ctx, accAddr)
 	if account == nil {
-		return fmt.Errorf("account not found")
+		return deny(types.DelegationCheckReason_DELEGATION_CHECK_REASON_ACCOUNT_NOT_FOUND, PolicyNone,
+			errorsmod.Wrapf(types.ErrAccountNotFound, "delegator %s", delegatorAddr)), nil
 	}
 
 	vestingAcc, isVesting := account.(types.VestingAccount)
 	if !isVesting {
-		return nil // regular accounts have no restrictions
+		// regular accounts have no restrictions
+		return allow(types.DelegationCheckReason_DELEGATION_CHECK_REASON_NON_VESTING_ACCOUNT, PolicyUnrestricted), nil
+	}
+
+	// undelegations only ever free tokens up
+	if msgTypeURL == sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}) {
+		return allow(types.DelegationCheckReason_DELEGATION_CHECK_REASON_UNRESTRICTED_MSG, PolicyUnrestricted), nil
 	}
 
 	params, err := k.Params.Get(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get module params: %s", err)
+		return StakingDecision{}, fmt.Errorf("failed to get module params: %s", err)
 	}
 
 	if amount.Denom != params.StakeDenom {
-		return nil // not staking native token, who cares
+		// not staking native token, who cares
+		return allow(types.DelegationCheckReason_DELEGATION_CHECK_REASON_DENOM_NOT_PROTECTED, PolicyUnrestricted), nil
 	}
 
 	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
-	vestedCoins := vestingAcc.GetVestedCoins(blockTime)
-	
-	vestedAmount := vestedCoins.AmountOf(params.StakeDenom)
-	
+	vestedAmount := vestingAcc.GetVestedCoins(blockTime).AmountOf(params.StakeDenom)
+	maxAllowed := sdk.NewCoin(params.StakeDenom, vestedAmount)
+
 	// trying to stake more than they've vested? nice try
 	if amount.Amount.GT(vestedAmount) {
-		return fmt.Errorf("cannot stake unvested tokens: requested %s, vested %s %s", 
-			amount.Amount.String(), vestedAmount.String(), params.StakeDenom)
-	}
-
-	return nil
+		rejection := types.ErrUnvestedStake
+		if msgTypeURL == sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}) {
+			rejection = types.ErrRedelegationDenied
+		}
+		decision := deny(types.DelegationCheckReason_DELEGATION_CHECK_REASON_UNVESTED_STAKE, PolicyVestedOnly,
+			errorsmod.Wrapf(rejection, "requested %s, vested %s %s",
+				amount.Amount.String(), vestedAmount.String(), params.StakeDenom))
+		decision.MaxAllowed = &maxAllowed
+		return decision, nil
+	}
+
+	decision := allow(types.DelegationCheckReason_DELEGATION_CHECK_REASON_WITHIN_VESTED, PolicyVestedOnly)
+	decision.MaxAllowed = &maxAllowed
+	return decision, nil
 }
 
 // GetVestingInfo returns detailed vesting information for an account
@@ -157,7 +186,7 @@ func (k Keeper) GetVestingInfo(ctx context.Context, address string) (*VestingInf
 
 	account := k.authKeeper.GetAccount(ctx, accAddr)
 	if account == nil {
-		return nil, fmt.Errorf("account not found")
+		return nil, errorsmod.Wrapf(types.ErrAccountNotFound, "address %s", address)
 	}
 
 	vestingAcc, isVesting := account.(types.VestingAccount)
@@ -191,4 +220,33 @@ type VestingInfo struct {
 	VestingCoins    sdk.Coins
 	OriginalVesting sdk.Coins
 	BlockTime       time.Time
-}
\ No newline at end of file
+}
+
+// Vesting guard policies reported with a StakingDecision
+const (
+	// PolicyNone is reported when the delegator could not be resolved
+	PolicyNone = "none"
+	// PolicyUnrestricted is reported when the guard does not limit the message
+	PolicyUnrestricted = "unrestricted"
+	// PolicyVestedOnly is reported when only vested stake denom tokens may be staked
+	PolicyVestedOnly = "vested_only"
+)
+
+// StakingDecision is the outcome of running the vesting guard on a staking message
+type StakingDecision struct {
+	Allowed bool
+	Reason  types.DelegationCheckReason
+	Policy  string
+	// MaxAllowed is the largest amount the guard permits, nil when it imposes no limit
+	MaxAllowed *sdk.Coin
+	// Err explains why the message was denied
+	Err error
+}
+
+func allow(reason types.DelegationCheckReason, policy string) StakingDecision {
+	return StakingDecision{Allowed: true, Reason: reason, Policy: policy}
+}
+
+func deny(reason types.DelegationCheckReason, policy string, err error) StakingDecision {
+	return StakingDecision{Allowed: false, Reason: reason, Policy: policy, Err: err}
+}
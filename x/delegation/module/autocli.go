@@ -10,7 +10,8 @@ import (
 func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 	return &autocliv1.ModuleOptions{
 		Query: &autocliv1.ServiceCommandDescriptor{
-			Service: types.Query_serviceDesc.ServiceName,
+			Service:              types.Query_serviceDesc.ServiceName,
+			EnhanceCustomCommand: true, // staking-eligibility-batch-file is a custom command
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{
 					RpcMethod: "Params",
@@ -23,6 +24,28 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query staking-eligibility",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
 				},
+				{
+					RpcMethod: "VestingAccounts",
+					Use:       "vesting-accounts",
+					Short:     "List indexed vesting accounts with their staking status",
+					Long:      "List indexed vesting accounts. Use --filter VESTING_ACCOUNT_FILTER_FULLY_VESTED or VESTING_ACCOUNT_FILTER_RESTRICTED to narrow the result.",
+				},
+
+				{
+					RpcMethod:      "ValidateDelegation",
+					Use:            "validate-delegation [delegator-address] [validator-address] [amount]",
+					Short:          "Dry-run the vesting guard for a delegation",
+					Long:           "Dry-run the vesting guard for a staking message. Use --msg-type-url to check a redelegation or undelegation instead of a delegation.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegator_address"}, {ProtoField: "validator_address"}, {ProtoField: "amount"}},
+				},
+
+				{
+					RpcMethod:      "StakingEligibilityBatch",
+					Use:            "staking-eligibility-batch [address]...",
+					Short:          "Query staking eligibility for several addresses",
+					Long:           "Query staking eligibility for several addresses at once. The number of addresses is limited by the max_batch_addresses module parameter. Use staking-eligibility-batch-file to read the addresses from a file.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "addresses", Varargs: true}},
+				},
 
 				// this line is used by ignite scaffolding # autocli/query
 			},
@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/depinject/appconfig"
 	"github.com/cosmos/cosmos-sdk/codec"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"cosmos-weighted-governance-sdk/x/delegation/keeper"
 	"cosmos-weighted-governance-sdk/x/delegation/types"
@@ -40,8 +41,9 @@ type ModuleInputs struct {
 type ModuleOutputs struct {
 	depinject.Out
 
-	DelegationKeeper keeper.Keeper
-	Module           appmodule.AppModule
+	DelegationKeeper  keeper.Keeper
+	Module            appmodule.AppModule
+	SendRestrictionFn banktypes.SendRestrictionFn
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -60,5 +62,5 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
-	return ModuleOutputs{DelegationKeeper: k, Module: m}
+	return ModuleOutputs{DelegationKeeper: k, Module: m, SendRestrictionFn: k.IndexVestingRecipient}
 }
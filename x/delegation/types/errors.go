@@ -10,4 +10,12 @@ import (
 var (
 	ErrInvalidSigner     = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
 	ErrInvalidStakeDenom = errors.Register(ModuleName, 1101, "invalid stake denomination")
+
+	// vesting guard rejections
+	ErrUnvestedStake      = errors.Register(ModuleName, 1102, "cannot stake unvested tokens")
+	ErrAccountNotFound    = errors.Register(ModuleName, 1103, "account not found")
+	ErrDenomNotProtected  = errors.Register(ModuleName, 1105, "denomination is not protected by the vesting guard")
+	ErrRedelegationDenied = errors.Register(ModuleName, 1106, "cannot redelegate unvested tokens")
+
+	ErrInvalidParams = errors.Register(ModuleName, 1107, "invalid params")
 )
@@ -0,0 +1,13 @@
+package types
+
+// Event types
+const (
+	EventTypeAccountFullyVested    = "account_fully_vested"
+	EventTypeVestingPeriodUnlocked = "vesting_period_unlocked"
+
+	AttributeKeyAddress     = "address"
+	AttributeKeyVestingType = "vesting_type"
+	AttributeKeyEndTime     = "end_time"
+	AttributeKeyPeriodIndex = "period_index"
+	AttributeKeyAmount      = "amount"
+)
@@ -12,6 +12,7 @@ import (
 type AuthKeeper interface {
 	AddressCodec() address.Codec
 	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI // only used for simulation
+	IterateAccounts(context.Context, func(sdk.AccountI) (stop bool))
 	// Methods imported from account should be defined here
 }
 
@@ -23,6 +24,7 @@ type VestingAccount interface {
 	GetOriginalVesting() sdk.Coins
 	GetDelegatedFree() sdk.Coins
 	GetDelegatedVesting() sdk.Coins
+	GetEndTime() int64
 }
 
 // BankKeeper defines the expected interface for the Bank module.
@@ -23,11 +23,21 @@ func TestGenesisState_Validate(t *testing.T) {
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
 				Params: types.Params{
-					StakeDenom: "stake",
+					StakeDenom:        "stake",
+					MaxBatchAddresses: 10,
 				},
 			},
 			valid: true,
 		},
+		{
+			desc: "zero max batch addresses",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					StakeDenom: "stake",
+				},
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -17,3 +17,17 @@ const (
 
 // ParamsKey is the prefix to retrieve all Params
 var ParamsKey = collections.NewPrefix("p_delegation")
+
+var (
+	// VestingAccountKey is the prefix of the vesting account index.
+	VestingAccountKey = collections.NewPrefix("vestingaccount/value/")
+
+	// RestrictedAccountKey is the prefix of the set of vesting accounts that are not yet fully vested.
+	RestrictedAccountKey = collections.NewPrefix("vestingaccount/restricted/")
+
+	// VestingEndQueueKey is the prefix of the queue of vesting end times.
+	VestingEndQueueKey = collections.NewPrefix("vestingaccount/endqueue/")
+
+	// VestingPeriodQueueKey is the prefix of the queue of periodic vesting period end times.
+	VestingPeriodQueueKey = collections.NewPrefix("vestingaccount/periodqueue/")
+)
@@ -1,20 +1,32 @@
 package types
 
+import (
+	"cosmossdk.io/errors"
+)
+
 const (
 	// DefaultStakeDenom is the default denomination for staking
 	DefaultStakeDenom = "stake"
+
+	// DefaultEmitPeriodEvents is the default for emitting per-period vesting events
+	DefaultEmitPeriodEvents = false
+
+	// DefaultMaxBatchAddresses is the default number of addresses accepted by a batch eligibility query
+	DefaultMaxBatchAddresses uint32 = 100
 )
 
 // NewParams creates a new Params instance.
-func NewParams(stakeDenom string) Params {
+func NewParams(stakeDenom string, emitPeriodEvents bool, maxBatchAddresses uint32) Params {
 	return Params{
-		StakeDenom: stakeDenom,
+		StakeDenom:        stakeDenom,
+		EmitPeriodEvents:  emitPeriodEvents,
+		MaxBatchAddresses: maxBatchAddresses,
 	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams(DefaultStakeDenom)
+	return NewParams(DefaultStakeDenom, DefaultEmitPeriodEvents, DefaultMaxBatchAddresses)
 }
 
 // Validate validates the set of params.
@@ -22,5 +34,8 @@ func (p Params) Validate() error {
 	if p.StakeDenom == "" {
 		return ErrInvalidStakeDenom
 	}
+	if p.MaxBatchAddresses == 0 {
+		return errors.Wrap(ErrInvalidParams, "max batch addresses must be positive")
+	}
 	return nil
 }
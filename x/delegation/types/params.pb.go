@@ -28,6 +28,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// stake_denom is the denomination of the staking token
 	StakeDenom string `protobuf:"bytes,1,opt,name=stake_denom,json=stakeDenom,proto3" json:"stake_denom,omitempty"`
+	// emit_period_events enables vesting_period_unlocked events for periodic vesting accounts
+	EmitPeriodEvents bool `protobuf:"varint,2,opt,name=emit_period_events,json=emitPeriodEvents,proto3" json:"emit_period_events,omitempty"`
+	// max_batch_addresses is the maximum number of addresses accepted by StakingEligibilityBatch
+	MaxBatchAddresses uint32 `protobuf:"varint,3,opt,name=max_batch_addresses,json=maxBatchAddresses,proto3" json:"max_batch_addresses,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,6 +74,20 @@ func (m *Params) GetStakeDenom() string {
 	return ""
 }
 
+func (m *Params) GetEmitPeriodEvents() bool {
+	if m != nil {
+		return m.EmitPeriodEvents
+	}
+	return false
+}
+
+func (m *Params) GetMaxBatchAddresses() uint32 {
+	if m != nil {
+		return m.MaxBatchAddresses
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmosweightedgovernancesdk.delegation.v1.Params")
 }
@@ -79,21 +97,26 @@ func init() {
 }
 
 var fileDescriptor_8a898dcf428bc97d = []byte{
-	// 220 bytes of a gzipped FileDescriptorProto
+	// 299 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4b, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0x2e, 0x4f, 0xcd, 0x4c, 0xcf, 0x28, 0x49, 0x4d, 0x49, 0xcf, 0x2f, 0x4b, 0x2d, 0xca,
 	0x4b, 0xcc, 0x4b, 0x4e, 0x2d, 0x4e, 0xc9, 0xd6, 0x4f, 0x49, 0xcd, 0x49, 0x4d, 0x4f, 0x2c, 0xc9,
 	0xcc, 0xcf, 0xd3, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca,
 	0x2f, 0xc9, 0x17, 0xd2, 0xc4, 0xa3, 0x4f, 0x0f, 0xa1, 0x4f, 0xaf, 0xcc, 0x50, 0x4a, 0x30, 0x31,
 	0x37, 0x33, 0x2f, 0x5f, 0x1f, 0x4c, 0x42, 0x74, 0x4b, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99,
-	0xfa, 0x20, 0x16, 0x44, 0x54, 0x29, 0x99, 0x8b, 0x2d, 0x00, 0x6c, 0x87, 0x90, 0x3c, 0x17, 0x77,
-	0x71, 0x49, 0x62, 0x76, 0x6a, 0x7c, 0x4a, 0x6a, 0x5e, 0x7e, 0xae, 0x04, 0xa3, 0x02, 0xa3, 0x06,
-	0x67, 0x10, 0x17, 0x58, 0xc8, 0x05, 0x24, 0x62, 0x65, 0xf1, 0x62, 0x81, 0x3c, 0x63, 0xd7, 0xf3,
-	0x0d, 0x5a, 0xfa, 0xf8, 0xdc, 0x5f, 0x81, 0xec, 0x03, 0x88, 0xd1, 0x4e, 0xde, 0x27, 0x1e, 0xc9,
-	0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e,
-	0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x08, 0x31, 0x4a, 0x17, 0x66, 0x96, 0x2e, 0xc2,
-	0x30, 0x5d, 0x0c, 0xd3, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x0e, 0x37, 0x06, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x96, 0x44, 0x44, 0xf1, 0x46, 0x01, 0x00, 0x00,
+	0xfa, 0x20, 0x16, 0x44, 0x54, 0xe9, 0x20, 0x23, 0x17, 0x5b, 0x00, 0xd8, 0x12, 0x21, 0x79, 0x2e,
+	0xee, 0xe2, 0x92, 0xc4, 0xec, 0xd4, 0xf8, 0x94, 0xd4, 0xbc, 0xfc, 0x5c, 0x09, 0x46, 0x05, 0x46,
+	0x0d, 0xce, 0x20, 0x2e, 0xb0, 0x90, 0x0b, 0x48, 0x44, 0x48, 0x87, 0x4b, 0x28, 0x35, 0x37, 0xb3,
+	0x24, 0xbe, 0x20, 0xb5, 0x28, 0x33, 0x3f, 0x25, 0x3e, 0xb5, 0x2c, 0x35, 0xaf, 0xa4, 0x58, 0x82,
+	0x49, 0x81, 0x51, 0x83, 0x23, 0x48, 0x00, 0x24, 0x13, 0x00, 0x96, 0x70, 0x05, 0x8b, 0x0b, 0xe9,
+	0x71, 0x09, 0xe7, 0x26, 0x56, 0xc4, 0x27, 0x25, 0x96, 0x24, 0x67, 0xc4, 0x27, 0xa6, 0xa4, 0x14,
+	0xa5, 0x16, 0x17, 0xa7, 0x16, 0x4b, 0x30, 0x2b, 0x30, 0x6a, 0xf0, 0x06, 0x09, 0xe6, 0x26, 0x56,
+	0x38, 0x81, 0x64, 0x1c, 0x61, 0x12, 0x56, 0x16, 0x2f, 0x16, 0xc8, 0x33, 0x76, 0x3d, 0xdf, 0xa0,
+	0xa5, 0x8f, 0x2f, 0x78, 0x2a, 0x90, 0x03, 0x08, 0xe2, 0x70, 0x27, 0xef, 0x13, 0x8f, 0xe4, 0x18,
+	0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5,
+	0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x84, 0x18, 0xa5, 0x0b, 0x33, 0x4b, 0x17, 0x61, 0x98,
+	0x2e, 0x86, 0x69, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x70, 0x31, 0x06, 0x04, 0x00,
+	0x00, 0xff, 0xff, 0x1e, 0x88, 0x2e, 0x78, 0xa5, 0x01, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -118,6 +141,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.StakeDenom != that1.StakeDenom {
 		return false
 	}
+	if this.EmitPeriodEvents != that1.EmitPeriodEvents {
+		return false
+	}
+	if this.MaxBatchAddresses != that1.MaxBatchAddresses {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -140,6 +169,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxBatchAddresses != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBatchAddresses))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EmitPeriodEvents {
+		i--
+		if m.EmitPeriodEvents {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.StakeDenom) > 0 {
 		i -= len(m.StakeDenom)
 		copy(dAtA[i:], m.StakeDenom)
@@ -171,6 +215,12 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.EmitPeriodEvents {
+		n += 2
+	}
+	if m.MaxBatchAddresses != 0 {
+		n += 1 + sovParams(uint64(m.MaxBatchAddresses))
+	}
 	return n
 }
 
@@ -241,6 +291,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.StakeDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitPeriodEvents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EmitPeriodEvents = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchAddresses", wireType)
+			}
+			m.MaxBatchAddresses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchAddresses |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6,7 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -236,11 +238,437 @@ func (m *QueryStakingEligibilityResponse) GetVestingAmount() int64 {
 	return 0
 }
 
+// QueryVestingAccountsRequest defines the QueryVestingAccountsRequest message.
+type QueryVestingAccountsRequest struct {
+	Filter     VestingAccountFilter `protobuf:"varint,1,opt,name=filter,proto3,enum=cosmosweightedgovernancesdk.delegation.v1.VestingAccountFilter" json:"filter,omitempty"`
+	Pagination *query.PageRequest   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVestingAccountsRequest) Reset()         { *m = QueryVestingAccountsRequest{} }
+func (m *QueryVestingAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingAccountsRequest) ProtoMessage()    {}
+func (*QueryVestingAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af039e53996b72a6, []int{4}
+}
+func (m *QueryVestingAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingAccountsRequest.Merge(m, src)
+}
+func (m *QueryVestingAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryVestingAccountsRequest) GetFilter() VestingAccountFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return VestingAccountFilter_VESTING_ACCOUNT_FILTER_UNSPECIFIED
+}
+
+func (m *QueryVestingAccountsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVestingAccountsResponse defines the QueryVestingAccountsResponse message.
+type QueryVestingAccountsResponse struct {
+	VestingAccounts []VestingAccountInfo `protobuf:"bytes,1,rep,name=vesting_accounts,json=vestingAccounts,proto3" json:"vesting_accounts"`
+	Pagination      *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVestingAccountsResponse) Reset()         { *m = QueryVestingAccountsResponse{} }
+func (m *QueryVestingAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingAccountsResponse) ProtoMessage()    {}
+func (*QueryVestingAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af039e53996b72a6, []int{5}
+}
+func (m *QueryVestingAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingAccountsResponse.Merge(m, src)
+}
+func (m *QueryVestingAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryVestingAccountsResponse) GetVestingAccounts() []VestingAccountInfo {
+	if m != nil {
+		return m.VestingAccounts
+	}
+	return nil
+}
+
+func (m *QueryVestingAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryValidateDelegationRequest defines the QueryValidateDelegationRequest message.
+type QueryValidateDelegationRequest struct {
+	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string     `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Amount           types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// msg_type_url is the staking message to check, defaults to /cosmos.staking.v1beta1.MsgDelegate.
+	MsgTypeUrl string `protobuf:"bytes,4,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *QueryValidateDelegationRequest) Reset()         { *m = QueryValidateDelegationRequest{} }
+func (m *QueryValidateDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateDelegationRequest) ProtoMessage()    {}
+func (*QueryValidateDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af039e53996b72a6, []int{6}
+}
+func (m *QueryValidateDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidateDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateDelegationRequest.Merge(m, src)
+}
+func (m *QueryValidateDelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateDelegationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidateDelegationRequest proto.InternalMessageInfo
+
+func (m *QueryValidateDelegationRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *QueryValidateDelegationRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *QueryValidateDelegationRequest) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *QueryValidateDelegationRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// QueryValidateDelegationResponse defines the QueryValidateDelegationResponse message.
+type QueryValidateDelegationResponse struct {
+	Allowed bool                  `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Reason  DelegationCheckReason `protobuf:"varint,2,opt,name=reason,proto3,enum=cosmosweightedgovernancesdk.delegation.v1.DelegationCheckReason" json:"reason,omitempty"`
+	// max_allowed_amount is the largest amount the guard permits, unset when the guard imposes no limit.
+	MaxAllowedAmount *types.Coin `protobuf:"bytes,3,opt,name=max_allowed_amount,json=maxAllowedAmount,proto3" json:"max_allowed_amount,omitempty"`
+	// policy names the guard policy that was applied.
+	Policy string `protobuf:"bytes,4,opt,name=policy,proto3" json:"policy,omitempty"`
+	// message explains a denial.
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// codespace and code identify the registered error a transaction would fail with,
+	// or ErrDenomNotProtected when the guard does not apply to the denomination.
+	Codespace string `protobuf:"bytes,6,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	Code      uint32 `protobuf:"varint,7,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (m *QueryValidateDelegationResponse) Reset()         { *m = QueryValidateDelegationResponse{} }
+func (m *QueryValidateDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateDelegationResponse) ProtoMessage()    {}
+func (*QueryValidateDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af039e53996b72a6, []int{7}
+}
+func (m *QueryValidateDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidateDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateDelegationResponse.Merge(m, src)
+}
+func (m *QueryValidateDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidateDelegationResponse proto.InternalMessageInfo
+
+func (m *QueryValidateDelegationResponse) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *QueryValidateDelegationResponse) GetReason() DelegationCheckReason {
+	if m != nil {
+		return m.Reason
+	}
+	return DelegationCheckReason_DELEGATION_CHECK_REASON_UNSPECIFIED
+}
+
+func (m *QueryValidateDelegationResponse) GetMaxAllowedAmount() *types.Coin {
+	if m != nil {
+		return m.MaxAllowedAmount
+	}
+	return nil
+}
+
+func (m *QueryValidateDelegationResponse) GetPolicy() string {
+	if m != nil {
+		return m.Policy
+	}
+	return ""
+}
+
+func (m *QueryValidateDelegationResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *QueryValidateDelegationResponse) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *QueryValidateDelegationResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+// QueryStakingEligibilityBatchRequest defines the QueryStakingEligibilityBatchRequest message.
+type QueryStakingEligibilityBatchRequest struct {
+	// addresses may hold at most max_batch_addresses entries.
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *QueryStakingEligibilityBatchRequest) Reset()         { *m = QueryStakingEligibilityBatchRequest{} }
+func (m *QueryStakingEligibilityBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingEligibilityBatchRequest) ProtoMessage()    {}
+func (*QueryStakingEligibilityBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af039e53996b72a6, []int{8}
+}
+func (m *QueryStakingEligibilityBatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingEligibilityBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingEligibilityBatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakingEligibilityBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingEligibilityBatchRequest.Merge(m, src)
+}
+func (m *QueryStakingEligibilityBatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingEligibilityBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingEligibilityBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakingEligibilityBatchRequest proto.InternalMessageInfo
+
+func (m *QueryStakingEligibilityBatchRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// StakingEligibilityResult is the staking eligibility of a single address in a batch.
+type StakingEligibilityResult struct {
+	Address     string                          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Eligibility QueryStakingEligibilityResponse `protobuf:"bytes,2,opt,name=eligibility,proto3" json:"eligibility"`
+}
+
+func (m *StakingEligibilityResult) Reset()         { *m = StakingEligibilityResult{} }
+func (m *StakingEligibilityResult) String() string { return proto.CompactTextString(m) }
+func (*StakingEligibilityResult) ProtoMessage()    {}
+func (*StakingEligibilityResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af039e53996b72a6, []int{9}
+}
+func (m *StakingEligibilityResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakingEligibilityResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakingEligibilityResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakingEligibilityResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingEligibilityResult.Merge(m, src)
+}
+func (m *StakingEligibilityResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakingEligibilityResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingEligibilityResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingEligibilityResult proto.InternalMessageInfo
+
+func (m *StakingEligibilityResult) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *StakingEligibilityResult) GetEligibility() QueryStakingEligibilityResponse {
+	if m != nil {
+		return m.Eligibility
+	}
+	return QueryStakingEligibilityResponse{}
+}
+
+// QueryStakingEligibilityBatchResponse defines the QueryStakingEligibilityBatchResponse message.
+type QueryStakingEligibilityBatchResponse struct {
+	// results are returned in the order of the requested addresses.
+	Results []StakingEligibilityResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *QueryStakingEligibilityBatchResponse) Reset()         { *m = QueryStakingEligibilityBatchResponse{} }
+func (m *QueryStakingEligibilityBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingEligibilityBatchResponse) ProtoMessage()    {}
+func (*QueryStakingEligibilityBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af039e53996b72a6, []int{10}
+}
+func (m *QueryStakingEligibilityBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingEligibilityBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingEligibilityBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakingEligibilityBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingEligibilityBatchResponse.Merge(m, src)
+}
+func (m *QueryStakingEligibilityBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingEligibilityBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingEligibilityBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakingEligibilityBatchResponse proto.InternalMessageInfo
+
+func (m *QueryStakingEligibilityBatchResponse) GetResults() []StakingEligibilityResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmosweightedgovernancesdk.delegation.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmosweightedgovernancesdk.delegation.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryStakingEligibilityRequest)(nil), "cosmosweightedgovernancesdk.delegation.v1.QueryStakingEligibilityRequest")
 	proto.RegisterType((*QueryStakingEligibilityResponse)(nil), "cosmosweightedgovernancesdk.delegation.v1.QueryStakingEligibilityResponse")
+	proto.RegisterType((*QueryVestingAccountsRequest)(nil), "cosmosweightedgovernancesdk.delegation.v1.QueryVestingAccountsRequest")
+	proto.RegisterType((*QueryVestingAccountsResponse)(nil), "cosmosweightedgovernancesdk.delegation.v1.QueryVestingAccountsResponse")
+	proto.RegisterType((*QueryValidateDelegationRequest)(nil), "cosmosweightedgovernancesdk.delegation.v1.QueryValidateDelegationRequest")
+	proto.RegisterType((*QueryValidateDelegationResponse)(nil), "cosmosweightedgovernancesdk.delegation.v1.QueryValidateDelegationResponse")
+	proto.RegisterType((*QueryStakingEligibilityBatchRequest)(nil), "cosmosweightedgovernancesdk.delegation.v1.QueryStakingEligibilityBatchRequest")
+	proto.RegisterType((*StakingEligibilityResult)(nil), "cosmosweightedgovernancesdk.delegation.v1.StakingEligibilityResult")
+	proto.RegisterType((*QueryStakingEligibilityBatchResponse)(nil), "cosmosweightedgovernancesdk.delegation.v1.QueryStakingEligibilityBatchResponse")
 }
 
 func init() {
@@ -248,41 +676,77 @@ func init() {
 }
 
 var fileDescriptor_af039e53996b72a6 = []byte{
-	// 530 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x4f, 0x6b, 0x13, 0x4f,
-	0x18, 0xce, 0x34, 0xbf, 0xe6, 0x67, 0xa6, 0x56, 0x70, 0x2c, 0x12, 0x82, 0x6e, 0xca, 0x8a, 0x50,
-	0x0b, 0xd9, 0x61, 0x5b, 0x14, 0x14, 0x14, 0x2c, 0x14, 0xb4, 0x5e, 0xea, 0x2a, 0x1e, 0xbc, 0x84,
-	0x49, 0xf7, 0x65, 0x3a, 0x64, 0x33, 0xb3, 0xdd, 0x99, 0xac, 0x06, 0xf1, 0xe2, 0x27, 0x10, 0xfc,
-	0x12, 0x1e, 0xfd, 0x0c, 0x82, 0xd0, 0x83, 0x87, 0x82, 0x17, 0x4f, 0x22, 0x89, 0xe0, 0xa7, 0x10,
-	0x24, 0x33, 0x13, 0x6a, 0xa9, 0x95, 0x46, 0xbc, 0x2c, 0x33, 0xcf, 0xbc, 0xcf, 0xf3, 0x3e, 0xef,
-	0x9f, 0xc5, 0xd7, 0x77, 0x94, 0xee, 0x2b, 0xfd, 0x0c, 0x04, 0xdf, 0x35, 0x90, 0x72, 0x55, 0x42,
-	0x21, 0x99, 0xdc, 0x01, 0x9d, 0xf6, 0x68, 0x0a, 0x19, 0x70, 0x66, 0x84, 0x92, 0xb4, 0x8c, 0xe9,
-	0xde, 0x00, 0x8a, 0x61, 0x94, 0x17, 0xca, 0x28, 0x72, 0xed, 0x0f, 0xb4, 0xe8, 0x90, 0x16, 0x95,
-	0x71, 0xf3, 0x3c, 0xeb, 0x0b, 0xa9, 0xa8, 0xfd, 0x3a, 0x76, 0x73, 0xd5, 0xb1, 0x69, 0x97, 0x69,
-	0x70, 0xb2, 0xb4, 0x8c, 0xbb, 0x60, 0x58, 0x4c, 0x73, 0xc6, 0x85, 0x74, 0x5c, 0x17, 0x7b, 0xe3,
-	0xf4, 0x06, 0x73, 0x56, 0xb0, 0xbe, 0xf6, 0xbc, 0x25, 0xae, 0xb8, 0xb2, 0x47, 0x3a, 0x39, 0x79,
-	0xf4, 0x12, 0x57, 0x8a, 0x67, 0x40, 0x59, 0x2e, 0x28, 0x93, 0x52, 0x19, 0x4b, 0xf6, 0x9c, 0x70,
-	0x09, 0x93, 0x87, 0x13, 0x37, 0xdb, 0x56, 0x28, 0x81, 0xbd, 0x01, 0x68, 0x13, 0xf6, 0xf0, 0x85,
-	0x23, 0xa8, 0xce, 0x95, 0xd4, 0x40, 0x1e, 0xe3, 0x9a, 0x4b, 0xd8, 0x40, 0xcb, 0x68, 0x65, 0x61,
-	0x2d, 0x8e, 0x4e, 0xdd, 0x93, 0xc8, 0x49, 0x6d, 0xd4, 0xf7, 0xbf, 0xb4, 0x2a, 0x6f, 0xbf, 0xbf,
-	0x5b, 0x45, 0x89, 0xd7, 0x0a, 0x6f, 0xe1, 0xc0, 0x26, 0x7b, 0x64, 0x58, 0x4f, 0x48, 0xbe, 0x99,
-	0x09, 0x2e, 0xba, 0x22, 0x13, 0x66, 0xe8, 0xed, 0x90, 0x06, 0xfe, 0x9f, 0xa5, 0x69, 0x01, 0xda,
-	0x25, 0xae, 0x27, 0xd3, 0x6b, 0xf8, 0x01, 0xe1, 0xd6, 0x89, 0x64, 0xef, 0xba, 0x85, 0x17, 0x84,
-	0xee, 0x80, 0x7d, 0xc9, 0xc0, 0x2a, 0x9c, 0x49, 0xb0, 0xd0, 0x9b, 0x1e, 0x21, 0x17, 0x71, 0xad,
-	0x00, 0xa6, 0x95, 0x6c, 0xcc, 0x59, 0x75, 0x7f, 0x23, 0x97, 0x31, 0x16, 0xba, 0x53, 0x82, 0x36,
-	0x42, 0xf2, 0x46, 0xd5, 0xf2, 0xea, 0x42, 0x3f, 0x71, 0x00, 0xb9, 0x82, 0x17, 0x27, 0x6f, 0x90,
-	0x76, 0x58, 0x5f, 0x0d, 0xa4, 0x69, 0xfc, 0xb7, 0x8c, 0x56, 0xaa, 0xc9, 0x59, 0x07, 0xde, 0xb5,
-	0x18, 0xb9, 0x8a, 0xcf, 0x79, 0x81, 0x69, 0xd4, 0xbc, 0x8d, 0x5a, 0xf4, 0xa8, 0x0b, 0x5b, 0xfb,
-	0x58, 0xc5, 0xf3, 0xb6, 0x0e, 0xf2, 0x1e, 0xe1, 0x9a, 0xeb, 0x15, 0xb9, 0x3d, 0x43, 0x7b, 0x8f,
-	0x0f, 0xb1, 0x79, 0xe7, 0x6f, 0xe9, 0xae, 0x6f, 0xe1, 0xcd, 0x57, 0x9f, 0xbe, 0xbd, 0x99, 0x5b,
-	0x27, 0x31, 0x05, 0xb9, 0x3b, 0xa1, 0xa5, 0xed, 0x43, 0x89, 0xb6, 0x76, 0x0d, 0xff, 0xed, 0x3e,
-	0x92, 0x1f, 0x08, 0x93, 0xe3, 0x13, 0x21, 0xf7, 0x67, 0x75, 0x74, 0xe2, 0x4a, 0x34, 0xb7, 0xfe,
-	0x85, 0x94, 0x2f, 0x74, 0xdb, 0x16, 0xba, 0x45, 0xee, 0xcd, 0x50, 0xa8, 0x47, 0xfd, 0x5a, 0x59,
-	0x3d, 0xfa, 0xc2, 0x6f, 0xe5, 0xcb, 0x8d, 0x07, 0xfb, 0xa3, 0x00, 0x1d, 0x8c, 0x02, 0xf4, 0x75,
-	0x14, 0xa0, 0xd7, 0xe3, 0xa0, 0x72, 0x30, 0x0e, 0x2a, 0x9f, 0xc7, 0x41, 0xe5, 0x69, 0xec, 0x6c,
-	0xb7, 0xa7, 0xbe, 0x8f, 0x64, 0x4a, 0x7b, 0xf4, 0xf9, 0xaf, 0x79, 0xcc, 0x30, 0x07, 0xdd, 0xad,
-	0xd9, 0x3f, 0x75, 0xfd, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x64, 0x5d, 0x55, 0xc1, 0xb8, 0x04,
-	0x00, 0x00,
+	// 1107 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x4f, 0x1b, 0xc7,
+	0x17, 0x67, 0x0d, 0x31, 0x5f, 0x3f, 0x02, 0x81, 0xf9, 0xa2, 0xd4, 0xa1, 0xc4, 0xd0, 0x4d, 0x7f,
+	0xd0, 0x48, 0x78, 0x65, 0x47, 0x6d, 0xd5, 0x56, 0x34, 0xc5, 0x04, 0x68, 0xd2, 0x2a, 0xa5, 0x1b,
+	0x4a, 0xab, 0x5c, 0x56, 0xc3, 0xee, 0x64, 0x19, 0xb1, 0xde, 0x71, 0x76, 0x16, 0x07, 0x14, 0xe5,
+	0xd2, 0x4b, 0x2b, 0xf5, 0x52, 0xb5, 0xff, 0x44, 0x8e, 0x3d, 0xe4, 0xdc, 0x43, 0xa4, 0x4a, 0x91,
+	0x7a, 0x89, 0xd2, 0x43, 0x7b, 0xaa, 0x2a, 0xa8, 0x94, 0xbf, 0xa2, 0x52, 0xb5, 0x33, 0x6f, 0xb1,
+	0x0d, 0x36, 0x61, 0x03, 0x17, 0xe4, 0x79, 0x33, 0xef, 0xf3, 0xde, 0xe7, 0xfd, 0x5c, 0xe0, 0x1d,
+	0x57, 0xc8, 0xba, 0x90, 0xf7, 0x18, 0xf7, 0x37, 0x62, 0xe6, 0xf9, 0xa2, 0xc9, 0xa2, 0x90, 0x86,
+	0x2e, 0x93, 0xde, 0xa6, 0xe5, 0xb1, 0x80, 0xf9, 0x34, 0xe6, 0x22, 0xb4, 0x9a, 0x15, 0xeb, 0xee,
+	0x16, 0x8b, 0x76, 0xca, 0x8d, 0x48, 0xc4, 0x82, 0xbc, 0x7d, 0x84, 0x5a, 0xb9, 0xa5, 0x56, 0x6e,
+	0x56, 0x26, 0xc6, 0x68, 0x9d, 0x87, 0xc2, 0x52, 0x7f, 0xb5, 0xf6, 0xc4, 0x65, 0xad, 0x6d, 0xad,
+	0x53, 0xc9, 0x34, 0xac, 0xd5, 0xac, 0xac, 0xb3, 0x98, 0x56, 0xac, 0x06, 0xf5, 0x79, 0xa8, 0x75,
+	0xf5, 0xdb, 0x52, 0xfb, 0xdb, 0xf4, 0x95, 0x2b, 0x78, 0x7a, 0x7f, 0x41, 0xdf, 0x3b, 0xea, 0x64,
+	0xe9, 0x03, 0x5e, 0xbd, 0x7b, 0x7c, 0x6e, 0x0d, 0x1a, 0xd1, 0x7a, 0xaa, 0x37, 0x77, 0x7c, 0x3d,
+	0x19, 0xd3, 0x4d, 0x1e, 0xfa, 0x8e, 0xbb, 0xc1, 0xdc, 0x4d, 0x54, 0xbf, 0x7a, 0x7c, 0xf5, 0x26,
+	0x93, 0x71, 0xa2, 0x4e, 0x5d, 0x57, 0x6c, 0x85, 0x31, 0x02, 0x8c, 0xfb, 0xc2, 0x17, 0x9a, 0x4f,
+	0xf2, 0x0b, 0xa5, 0x93, 0xbe, 0x10, 0x7e, 0xc0, 0x2c, 0xda, 0xe0, 0x16, 0x0d, 0x43, 0x11, 0x2b,
+	0x14, 0xf4, 0xd9, 0x1c, 0x07, 0xf2, 0x45, 0x12, 0xc8, 0x15, 0x45, 0xc4, 0x66, 0x77, 0xb7, 0x98,
+	0x8c, 0xcd, 0x4d, 0xf8, 0x7f, 0x87, 0x54, 0x36, 0x44, 0x28, 0x19, 0x59, 0x85, 0xbc, 0x26, 0x5c,
+	0x34, 0xa6, 0x8d, 0x99, 0xa1, 0x6a, 0xa5, 0x7c, 0xec, 0x74, 0x96, 0x35, 0x54, 0xad, 0xf0, 0xe4,
+	0xaf, 0xa9, 0xbe, 0x87, 0xcf, 0x7f, 0xbe, 0x6c, 0xd8, 0x88, 0x65, 0x7e, 0x00, 0x25, 0x65, 0xec,
+	0x96, 0x8e, 0xc9, 0x62, 0xc0, 0x7d, 0xbe, 0xce, 0x03, 0x1e, 0xef, 0xa0, 0x3b, 0xa4, 0x08, 0x83,
+	0xd4, 0xf3, 0x22, 0x26, 0xb5, 0xe1, 0x82, 0x9d, 0x1e, 0xcd, 0x5f, 0x0d, 0x98, 0xea, 0xa9, 0x8c,
+	0x5e, 0x4f, 0xc1, 0x10, 0x97, 0x0e, 0x53, 0x37, 0x01, 0x53, 0x08, 0xff, 0xb3, 0x81, 0xcb, 0x45,
+	0x94, 0x90, 0xf3, 0x90, 0x8f, 0x18, 0x95, 0x22, 0x2c, 0xe6, 0x14, 0x3a, 0x9e, 0xc8, 0x45, 0x00,
+	0x2e, 0x1d, 0x8c, 0x75, 0xb1, 0x5f, 0xe9, 0x15, 0xb8, 0x5c, 0xd3, 0x02, 0x72, 0x09, 0x86, 0x93,
+	0x3b, 0xe6, 0x39, 0xb4, 0x9e, 0x64, 0xa1, 0x38, 0x30, 0x6d, 0xcc, 0xf4, 0xdb, 0x67, 0xb5, 0x70,
+	0x5e, 0xc9, 0xc8, 0x1b, 0x30, 0xb2, 0x9f, 0x2c, 0xfd, 0xea, 0x8c, 0x7a, 0x35, 0x8c, 0x52, 0xfd,
+	0xcc, 0xfc, 0xc5, 0x80, 0x57, 0x15, 0x0f, 0x04, 0x9f, 0xd7, 0x89, 0x4d, 0x13, 0x42, 0xbe, 0x82,
+	0xfc, 0x1d, 0x1e, 0xc4, 0x2c, 0x52, 0xee, 0x8f, 0x54, 0xaf, 0x66, 0x88, 0x7c, 0x27, 0xe4, 0x92,
+	0x82, 0xb1, 0x11, 0x8e, 0x2c, 0x01, 0xb4, 0x5a, 0x47, 0xf1, 0x1f, 0xaa, 0xbe, 0x89, 0xe0, 0xe5,
+	0xa4, 0x77, 0xca, 0xba, 0x7d, 0xb1, 0x83, 0xca, 0x2b, 0xd4, 0x67, 0xe8, 0x94, 0xdd, 0xa6, 0x69,
+	0xfe, 0x61, 0xc0, 0x64, 0x77, 0x02, 0x98, 0x85, 0x10, 0x46, 0x0f, 0x54, 0x6d, 0x92, 0xcc, 0xfe,
+	0x99, 0xa1, 0xea, 0xdc, 0x4b, 0x73, 0xb9, 0x1e, 0xde, 0x11, 0xb5, 0x81, 0xa4, 0xa2, 0xec, 0x73,
+	0xcd, 0x4e, 0xbb, 0x64, 0xb9, 0x0b, 0xb1, 0xb7, 0x5e, 0x48, 0x4c, 0x3b, 0xdb, 0xc1, 0xec, 0xc7,
+	0x1c, 0xd6, 0xe7, 0x1a, 0x0d, 0xb8, 0x47, 0x63, 0x76, 0x6d, 0xdf, 0xa3, 0x34, 0x3b, 0x8b, 0x30,
+	0x86, 0x6e, 0x8a, 0xc8, 0xe9, 0xa8, 0xd4, 0x5a, 0xf1, 0xd9, 0xa3, 0xd9, 0x71, 0xb4, 0x3a, 0xaf,
+	0x6f, 0x6e, 0xc5, 0x11, 0x0f, 0x7d, 0x7b, 0x74, 0x5f, 0x05, 0xe5, 0xe4, 0x26, 0x8c, 0x35, 0xb5,
+	0x8d, 0x36, 0x18, 0x55, 0x92, 0xb5, 0xd7, 0x9e, 0x3d, 0x9a, 0xbd, 0x88, 0x30, 0x6b, 0xe9, 0x9b,
+	0x03, 0x78, 0xcd, 0x03, 0x72, 0xf2, 0x1e, 0xe4, 0xb1, 0xe6, 0xfa, 0x15, 0xfd, 0x0b, 0x1d, 0xf4,
+	0x53, 0xe2, 0x0b, 0x82, 0x87, 0x18, 0x44, 0x7c, 0x4e, 0xa6, 0xe1, 0x6c, 0x5d, 0xfa, 0x4e, 0xbc,
+	0xd3, 0x60, 0xce, 0x56, 0x14, 0xa8, 0xc2, 0x2e, 0xd8, 0x50, 0x97, 0xfe, 0xea, 0x4e, 0x83, 0x7d,
+	0x19, 0x05, 0xe6, 0xe3, 0x1c, 0xf6, 0x5d, 0xb7, 0xa0, 0x60, 0xc6, 0x93, 0xae, 0x0d, 0x02, 0x71,
+	0x8f, 0x79, 0xd8, 0x73, 0xe9, 0x91, 0x7c, 0xdd, 0xd1, 0x70, 0x23, 0xd5, 0x8f, 0x33, 0x54, 0x40,
+	0xcb, 0xd0, 0x42, 0x32, 0x3b, 0x6d, 0x85, 0xb3, 0xdf, 0xb2, 0xcb, 0x40, 0xea, 0x74, 0xdb, 0x41,
+	0x43, 0xce, 0x31, 0xe9, 0xdb, 0xa3, 0x75, 0xba, 0x3d, 0xaf, 0x75, 0xb0, 0x6f, 0xcf, 0x43, 0xbe,
+	0x21, 0x02, 0xee, 0xee, 0x20, 0x79, 0x3c, 0x25, 0xa4, 0xea, 0x4c, 0x4a, 0xea, 0x33, 0xd5, 0xc8,
+	0x05, 0x3b, 0x3d, 0x92, 0x49, 0x28, 0xb8, 0xc2, 0x63, 0xb2, 0x41, 0x5d, 0x56, 0xcc, 0xab, 0xbb,
+	0x96, 0x80, 0x10, 0x18, 0x48, 0x0e, 0xc5, 0xc1, 0x69, 0x63, 0x66, 0xd8, 0x56, 0xbf, 0xcd, 0x05,
+	0xb8, 0xd4, 0x63, 0x76, 0xd5, 0x68, 0xec, 0x6e, 0xa4, 0xd5, 0x35, 0x09, 0x05, 0x2c, 0x06, 0xa6,
+	0x5b, 0xa6, 0x60, 0xb7, 0x04, 0xe6, 0x43, 0x03, 0x8a, 0x5d, 0x87, 0xdf, 0x56, 0x70, 0xc4, 0xe0,
+	0x24, 0x11, 0x0c, 0xb1, 0xd6, 0x73, 0xec, 0x8f, 0x1b, 0x19, 0xf2, 0xf0, 0x82, 0xa9, 0x8b, 0x15,
+	0xd5, 0x6e, 0xc4, 0xfc, 0xde, 0x80, 0xd7, 0x8f, 0x26, 0x8c, 0x95, 0xe3, 0xc2, 0x60, 0xa4, 0x08,
+	0xa4, 0x23, 0x62, 0x21, 0x83, 0x63, 0xbd, 0x82, 0x81, 0x1e, 0xa5, 0xc8, 0xd5, 0xdf, 0x0a, 0x70,
+	0x46, 0x79, 0x43, 0x1e, 0x1b, 0x90, 0xd7, 0xeb, 0x89, 0xcc, 0x65, 0x8d, 0x40, 0xc7, 0xde, 0x9c,
+	0xf8, 0xe8, 0x65, 0xd5, 0x35, 0x71, 0xf3, 0xfd, 0x6f, 0x7e, 0xff, 0xe7, 0xa7, 0xdc, 0x15, 0x52,
+	0xb1, 0x58, 0xb8, 0x91, 0xa8, 0x79, 0xb3, 0x2d, 0x88, 0x59, 0xfc, 0x68, 0xe8, 0xfa, 0x09, 0x42,
+	0xfe, 0x35, 0x80, 0x1c, 0xa6, 0x4e, 0xae, 0x9f, 0x46, 0x4a, 0x35, 0xb9, 0x53, 0xac, 0x0e, 0x73,
+	0x45, 0x11, 0xbd, 0x41, 0x3e, 0xc9, 0x40, 0x34, 0xfd, 0x66, 0x6a, 0x2b, 0x29, 0xeb, 0x3e, 0xd6,
+	0xf3, 0x03, 0xf2, 0xdc, 0x80, 0x73, 0x07, 0x76, 0x0f, 0x59, 0xca, 0xea, 0x71, 0xf7, 0xed, 0x3b,
+	0xb1, 0x7c, 0x62, 0x1c, 0xa4, 0xbd, 0xa0, 0x68, 0xcf, 0x91, 0x0f, 0x33, 0xd0, 0x3e, 0xb8, 0x35,
+	0xc9, 0xb7, 0x39, 0x20, 0x87, 0xc7, 0x6e, 0xf6, 0x4c, 0xf7, 0xdc, 0x67, 0xd9, 0x33, 0xdd, 0x7b,
+	0x0b, 0x98, 0xb7, 0x15, 0xe5, 0x55, 0x62, 0x67, 0xa1, 0x8c, 0x70, 0x4e, 0x9b, 0xf8, 0xfe, 0xa1,
+	0x0d, 0xfb, 0x80, 0x7c, 0x97, 0x83, 0x57, 0x7a, 0xcc, 0x12, 0x72, 0xf3, 0xe4, 0xd5, 0xda, 0x3e,
+	0x85, 0x27, 0x3e, 0x3f, 0x35, 0x3c, 0x0c, 0xcc, 0x67, 0x2a, 0x30, 0x4b, 0xe4, 0xda, 0xc9, 0x5a,
+	0xc0, 0x59, 0x4f, 0x50, 0x6b, 0x9f, 0x3e, 0xd9, 0x2d, 0x19, 0x4f, 0x77, 0x4b, 0xc6, 0xdf, 0xbb,
+	0x25, 0xe3, 0x87, 0xbd, 0x52, 0xdf, 0xd3, 0xbd, 0x52, 0xdf, 0x9f, 0x7b, 0xa5, 0xbe, 0xdb, 0x15,
+	0xed, 0xf7, 0x6c, 0xea, 0x78, 0x87, 0x15, 0x6f, 0xd3, 0xda, 0x6e, 0xb7, 0x91, 0xac, 0x7c, 0xb9,
+	0x9e, 0x57, 0xff, 0x1b, 0x5c, 0xf9, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xdb, 0xe6, 0x1c, 0xb6, 0xe5,
+	0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -301,6 +765,12 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// StakingEligibility Queries a list of StakingEligibility items.
 	StakingEligibility(ctx context.Context, in *QueryStakingEligibilityRequest, opts ...grpc.CallOption) (*QueryStakingEligibilityResponse, error)
+	// VestingAccounts lists the indexed vesting accounts with their staking status.
+	VestingAccounts(ctx context.Context, in *QueryVestingAccountsRequest, opts ...grpc.CallOption) (*QueryVestingAccountsResponse, error)
+	// ValidateDelegation dry-runs the vesting guard for a staking message.
+	ValidateDelegation(ctx context.Context, in *QueryValidateDelegationRequest, opts ...grpc.CallOption) (*QueryValidateDelegationResponse, error)
+	// StakingEligibilityBatch checks staking eligibility for several addresses at once.
+	StakingEligibilityBatch(ctx context.Context, in *QueryStakingEligibilityBatchRequest, opts ...grpc.CallOption) (*QueryStakingEligibilityBatchResponse, error)
 }
 
 type queryClient struct {
@@ -329,16 +799,49 @@ func (c *queryClient) StakingEligibility(ctx context.Context, in *QueryStakingEl
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// StakingEligibility Queries a list of StakingEligibility items.
-	StakingEligibility(context.Context, *QueryStakingEligibilityRequest) (*QueryStakingEligibilityResponse, error)
+func (c *queryClient) VestingAccounts(ctx context.Context, in *QueryVestingAccountsRequest, opts ...grpc.CallOption) (*QueryVestingAccountsResponse, error) {
+	out := new(QueryVestingAccountsResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.delegation.v1.Query/VestingAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (c *queryClient) ValidateDelegation(ctx context.Context, in *QueryValidateDelegationRequest, opts ...grpc.CallOption) (*QueryValidateDelegationResponse, error) {
+	out := new(QueryValidateDelegationResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.delegation.v1.Query/ValidateDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StakingEligibilityBatch(ctx context.Context, in *QueryStakingEligibilityBatchRequest, opts ...grpc.CallOption) (*QueryStakingEligibilityBatchResponse, error) {
+	out := new(QueryStakingEligibilityBatchResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.delegation.v1.Query/StakingEligibilityBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// StakingEligibility Queries a list of StakingEligibility items.
+	StakingEligibility(context.Context, *QueryStakingEligibilityRequest) (*QueryStakingEligibilityResponse, error)
+	// VestingAccounts lists the indexed vesting accounts with their staking status.
+	VestingAccounts(context.Context, *QueryVestingAccountsRequest) (*QueryVestingAccountsResponse, error)
+	// ValidateDelegation dry-runs the vesting guard for a staking message.
+	ValidateDelegation(context.Context, *QueryValidateDelegationRequest) (*QueryValidateDelegationResponse, error)
+	// StakingEligibilityBatch checks staking eligibility for several addresses at once.
+	StakingEligibilityBatch(context.Context, *QueryStakingEligibilityBatchRequest) (*QueryStakingEligibilityBatchResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
@@ -347,6 +850,15 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) StakingEligibility(ctx context.Context, req *QueryStakingEligibilityRequest) (*QueryStakingEligibilityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StakingEligibility not implemented")
 }
+func (*UnimplementedQueryServer) VestingAccounts(ctx context.Context, req *QueryVestingAccountsRequest) (*QueryVestingAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingAccounts not implemented")
+}
+func (*UnimplementedQueryServer) ValidateDelegation(ctx context.Context, req *QueryValidateDelegationRequest) (*QueryValidateDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateDelegation not implemented")
+}
+func (*UnimplementedQueryServer) StakingEligibilityBatch(ctx context.Context, req *QueryStakingEligibilityBatchRequest) (*QueryStakingEligibilityBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakingEligibilityBatch not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -388,6 +900,60 @@ func _Query_StakingEligibility_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VestingAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.delegation.v1.Query/VestingAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingAccounts(ctx, req.(*QueryVestingAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidateDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidateDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidateDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.delegation.v1.Query/ValidateDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidateDelegation(ctx, req.(*QueryValidateDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StakingEligibilityBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakingEligibilityBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakingEligibilityBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.delegation.v1.Query/StakingEligibilityBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakingEligibilityBatch(ctx, req.(*QueryStakingEligibilityBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmosweightedgovernancesdk.delegation.v1.Query",
@@ -401,6 +967,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StakingEligibility",
 			Handler:    _Query_StakingEligibility_Handler,
 		},
+		{
+			MethodName: "VestingAccounts",
+			Handler:    _Query_VestingAccounts_Handler,
+		},
+		{
+			MethodName: "ValidateDelegation",
+			Handler:    _Query_ValidateDelegation_Handler,
+		},
+		{
+			MethodName: "StakingEligibilityBatch",
+			Handler:    _Query_StakingEligibilityBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmosweightedgovernancesdk/delegation/v1/query.proto",
@@ -552,110 +1130,1544 @@ func (m *QueryStakingEligibilityResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryVestingAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryVestingAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Filter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Filter))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVestingAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VestingAccounts) > 0 {
+		for iNdEx := len(m.VestingAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStakingEligibilityRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidateDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStakingEligibilityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidateDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.IsEligible {
-		n += 2
+	if m.Code != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.IsVesting {
-		n += 2
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.VestedAmount != 0 {
-		n += 1 + sovQuery(uint64(m.VestedAmount))
+	if len(m.Policy) > 0 {
+		i -= len(m.Policy)
+		copy(dAtA[i:], m.Policy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Policy)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.VestingAmount != 0 {
-		n += 1 + sovQuery(uint64(m.VestingAmount))
+	if m.MaxAllowedAmount != nil {
+		{
+			size, err := m.MaxAllowedAmount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.Reason != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryStakingEligibilityBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryStakingEligibilityBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (m *QueryStakingEligibilityBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakingEligibilityResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakingEligibilityResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakingEligibilityResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Eligibility.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingEligibilityBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingEligibilityBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingEligibilityBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStakingEligibilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakingEligibilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsEligible {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IsVesting {
+		n += 2
+	}
+	if m.VestedAmount != 0 {
+		n += 1 + sovQuery(uint64(m.VestedAmount))
+	}
+	if m.VestingAmount != 0 {
+		n += 1 + sovQuery(uint64(m.VestingAmount))
+	}
+	return n
+}
+
+func (m *QueryVestingAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Filter != 0 {
+		n += 1 + sovQuery(uint64(m.Filter))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VestingAccounts) > 0 {
+		for _, e := range m.VestingAccounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowed {
+		n += 2
+	}
+	if m.Reason != 0 {
+		n += 1 + sovQuery(uint64(m.Reason))
+	}
+	if m.MaxAllowedAmount != nil {
+		l = m.MaxAllowedAmount.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Policy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovQuery(uint64(m.Code))
+	}
+	return n
+}
+
+func (m *QueryStakingEligibilityBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StakingEligibilityResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Eligibility.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStakingEligibilityBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingEligibilityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingEligibilityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingEligibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingEligibilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingEligibilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingEligibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsEligible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsEligible = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsVesting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsVesting = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestedAmount", wireType)
+			}
+			m.VestedAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VestedAmount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAmount", wireType)
+			}
+			m.VestingAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VestingAmount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			m.Filter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Filter |= VestingAccountFilter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAccounts = append(m.VestingAccounts, VestingAccountInfo{})
+			if err := m.VestingAccounts[len(m.VestingAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidateDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidateDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidateDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidateDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidateDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidateDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= DelegationCheckReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAllowedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxAllowedAmount == nil {
+				m.MaxAllowedAmount = &types.Coin{}
+			}
+			if err := m.MaxAllowedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Policy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -677,7 +2689,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStakingEligibilityBatchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -700,17 +2712,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakingEligibilityBatchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakingEligibilityBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -720,24 +2732,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -760,7 +2771,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakingEligibilityRequest) Unmarshal(dAtA []byte) error {
+func (m *StakingEligibilityResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -783,10 +2794,10 @@ func (m *QueryStakingEligibilityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakingEligibilityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StakingEligibilityResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakingEligibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StakingEligibilityResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -821,6 +2832,39 @@ func (m *QueryStakingEligibilityRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eligibility", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Eligibility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -842,7 +2886,7 @@ func (m *QueryStakingEligibilityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakingEligibilityResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStakingEligibilityBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -865,37 +2909,17 @@ func (m *QueryStakingEligibilityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakingEligibilityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakingEligibilityBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakingEligibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakingEligibilityBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsEligible", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsEligible = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -905,82 +2929,26 @@ func (m *QueryStakingEligibilityResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsVesting", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsVesting = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestedAmount", wireType)
-			}
-			m.VestedAmount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VestedAmount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingAmount", wireType)
-			}
-			m.VestingAmount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VestingAmount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Results = append(m.Results, StakingEligibilityResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
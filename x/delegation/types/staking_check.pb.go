@@ -0,0 +1,107 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmosweightedgovernancesdk/delegation/v1/staking_check.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DelegationCheckReason is the typed reason behind a vesting guard decision.
+type DelegationCheckReason int32
+
+const (
+	// DELEGATION_CHECK_REASON_UNSPECIFIED is never returned.
+	DelegationCheckReason_DELEGATION_CHECK_REASON_UNSPECIFIED DelegationCheckReason = 0
+	// DELEGATION_CHECK_REASON_NON_VESTING_ACCOUNT means the delegator is not a vesting account.
+	DelegationCheckReason_DELEGATION_CHECK_REASON_NON_VESTING_ACCOUNT DelegationCheckReason = 1
+	// DELEGATION_CHECK_REASON_DENOM_NOT_PROTECTED means the amount is not in the stake denom.
+	DelegationCheckReason_DELEGATION_CHECK_REASON_DENOM_NOT_PROTECTED DelegationCheckReason = 2
+	// DELEGATION_CHECK_REASON_UNRESTRICTED_MSG means the message type is not restricted by the guard.
+	DelegationCheckReason_DELEGATION_CHECK_REASON_UNRESTRICTED_MSG DelegationCheckReason = 3
+	// DELEGATION_CHECK_REASON_WITHIN_VESTED means the amount does not exceed the vested amount.
+	DelegationCheckReason_DELEGATION_CHECK_REASON_WITHIN_VESTED DelegationCheckReason = 4
+	// DELEGATION_CHECK_REASON_UNVESTED_STAKE means the amount exceeds the vested amount.
+	DelegationCheckReason_DELEGATION_CHECK_REASON_UNVESTED_STAKE DelegationCheckReason = 5
+	// DELEGATION_CHECK_REASON_ACCOUNT_NOT_FOUND means the delegator account does not exist.
+	DelegationCheckReason_DELEGATION_CHECK_REASON_ACCOUNT_NOT_FOUND DelegationCheckReason = 6
+	// DELEGATION_CHECK_REASON_INVALID_ADDRESS means the delegator address could not be decoded.
+	DelegationCheckReason_DELEGATION_CHECK_REASON_INVALID_ADDRESS DelegationCheckReason = 7
+)
+
+var DelegationCheckReason_name = map[int32]string{
+	0: "DELEGATION_CHECK_REASON_UNSPECIFIED",
+	1: "DELEGATION_CHECK_REASON_NON_VESTING_ACCOUNT",
+	2: "DELEGATION_CHECK_REASON_DENOM_NOT_PROTECTED",
+	3: "DELEGATION_CHECK_REASON_UNRESTRICTED_MSG",
+	4: "DELEGATION_CHECK_REASON_WITHIN_VESTED",
+	5: "DELEGATION_CHECK_REASON_UNVESTED_STAKE",
+	6: "DELEGATION_CHECK_REASON_ACCOUNT_NOT_FOUND",
+	7: "DELEGATION_CHECK_REASON_INVALID_ADDRESS",
+}
+
+var DelegationCheckReason_value = map[string]int32{
+	"DELEGATION_CHECK_REASON_UNSPECIFIED":         0,
+	"DELEGATION_CHECK_REASON_NON_VESTING_ACCOUNT": 1,
+	"DELEGATION_CHECK_REASON_DENOM_NOT_PROTECTED": 2,
+	"DELEGATION_CHECK_REASON_UNRESTRICTED_MSG":    3,
+	"DELEGATION_CHECK_REASON_WITHIN_VESTED":       4,
+	"DELEGATION_CHECK_REASON_UNVESTED_STAKE":      5,
+	"DELEGATION_CHECK_REASON_ACCOUNT_NOT_FOUND":   6,
+	"DELEGATION_CHECK_REASON_INVALID_ADDRESS":     7,
+}
+
+func (x DelegationCheckReason) String() string {
+	return proto.EnumName(DelegationCheckReason_name, int32(x))
+}
+
+func (DelegationCheckReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fb8a5118c36216e4, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("cosmosweightedgovernancesdk.delegation.v1.DelegationCheckReason", DelegationCheckReason_name, DelegationCheckReason_value)
+}
+
+func init() {
+	proto.RegisterFile("cosmosweightedgovernancesdk/delegation/v1/staking_check.proto", fileDescriptor_fb8a5118c36216e4)
+}
+
+var fileDescriptor_fb8a5118c36216e4 = []byte{
+	// 346 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x4f, 0xe2, 0x40,
+	0x1c, 0xc5, 0xdb, 0x65, 0x97, 0x4d, 0xe6, 0x34, 0x99, 0x64, 0xaf, 0xbd, 0x6c, 0x14, 0x01, 0x4b,
+	0x43, 0x3c, 0x7b, 0xa8, 0x9d, 0x01, 0x26, 0xc0, 0x0c, 0xe9, 0x0c, 0x98, 0x78, 0x99, 0xd4, 0x32,
+	0x29, 0x04, 0xed, 0x10, 0xda, 0xa0, 0x9e, 0xfd, 0x02, 0x7e, 0x2c, 0x8f, 0x1c, 0x3d, 0x1a, 0xf8,
+	0x22, 0x06, 0x50, 0xf1, 0x52, 0xae, 0xff, 0xfc, 0xde, 0xcb, 0xfb, 0xe7, 0x3d, 0x70, 0x19, 0x9b,
+	0xec, 0xde, 0x64, 0x0f, 0x7a, 0x9a, 0x4c, 0x72, 0x3d, 0x4e, 0xcc, 0x52, 0x2f, 0xd2, 0x28, 0x8d,
+	0x75, 0x36, 0x9e, 0x79, 0x63, 0x7d, 0xa7, 0x93, 0x28, 0x9f, 0x9a, 0xd4, 0x5b, 0x36, 0xbd, 0x2c,
+	0x8f, 0x66, 0xd3, 0x34, 0x51, 0xf1, 0x44, 0xc7, 0xb3, 0xc6, 0x7c, 0x61, 0x72, 0x83, 0xaa, 0x47,
+	0xe4, 0x8d, 0x83, 0xbc, 0xb1, 0x6c, 0xd6, 0x9e, 0x4b, 0xe0, 0x1f, 0xfe, 0xbe, 0x04, 0x5b, 0x93,
+	0x50, 0x47, 0x99, 0x49, 0x51, 0x05, 0xfc, 0xc7, 0xa4, 0x47, 0xda, 0xbe, 0xa4, 0x9c, 0xa9, 0xa0,
+	0x43, 0x82, 0xae, 0x0a, 0x89, 0x2f, 0x38, 0x53, 0x43, 0x26, 0x06, 0x24, 0xa0, 0x2d, 0x4a, 0x30,
+	0xb4, 0x90, 0x07, 0xea, 0x45, 0x20, 0xe3, 0x4c, 0x8d, 0x88, 0x90, 0x94, 0xb5, 0x95, 0x1f, 0x04,
+	0x7c, 0xc8, 0x24, 0xb4, 0x8f, 0x09, 0x30, 0x61, 0xbc, 0xaf, 0x18, 0x97, 0x6a, 0x10, 0x72, 0x49,
+	0x02, 0x49, 0x30, 0xfc, 0x85, 0xce, 0xc1, 0x59, 0x71, 0x94, 0x90, 0x08, 0x19, 0xd2, 0x2d, 0xa9,
+	0xfa, 0xa2, 0x0d, 0x4b, 0xa8, 0x0a, 0x4e, 0x8a, 0xe8, 0x6b, 0x2a, 0x3b, 0x74, 0x1f, 0x89, 0x60,
+	0xf8, 0x1b, 0xd5, 0xc0, 0x69, 0xb1, 0xf1, 0x9e, 0x52, 0x42, 0xfa, 0x5d, 0x02, 0xff, 0x20, 0x17,
+	0x54, 0x8b, 0xd8, 0xcf, 0xd7, 0x76, 0xb9, 0x5b, 0x7c, 0xc8, 0x30, 0x2c, 0xa3, 0x3a, 0xa8, 0x14,
+	0xe1, 0x94, 0x8d, 0xfc, 0x1e, 0xc5, 0xca, 0xc7, 0x38, 0x24, 0x42, 0xc0, 0xbf, 0x57, 0xdd, 0xd7,
+	0xb5, 0x63, 0xaf, 0xd6, 0x8e, 0xfd, 0xbe, 0x76, 0xec, 0x97, 0x8d, 0x63, 0xad, 0x36, 0x8e, 0xf5,
+	0xb6, 0x71, 0xac, 0x9b, 0xe6, 0xbe, 0x4a, 0xf7, 0xab, 0x4b, 0xf7, 0x50, 0xa6, 0xbb, 0x1d, 0xc3,
+	0xe3, 0xcf, 0x39, 0xe4, 0x4f, 0x73, 0x9d, 0xdd, 0x96, 0x77, 0x23, 0xb8, 0xf8, 0x08, 0x00, 0x00,
+	0xff, 0xff, 0xb9, 0x06, 0xb3, 0xed, 0x45, 0x02, 0x00, 0x00,
+}
@@ -0,0 +1,659 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmosweightedgovernancesdk/delegation/v1/vesting_account.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// VestingAccountFilter selects which indexed vesting accounts are returned.
+type VestingAccountFilter int32
+
+const (
+	// VESTING_ACCOUNT_FILTER_UNSPECIFIED returns every indexed vesting account.
+	VestingAccountFilter_VESTING_ACCOUNT_FILTER_UNSPECIFIED VestingAccountFilter = 0
+	// VESTING_ACCOUNT_FILTER_FULLY_VESTED returns accounts that can stake freely.
+	VestingAccountFilter_VESTING_ACCOUNT_FILTER_FULLY_VESTED VestingAccountFilter = 1
+	// VESTING_ACCOUNT_FILTER_RESTRICTED returns accounts still restricted by the guard.
+	VestingAccountFilter_VESTING_ACCOUNT_FILTER_RESTRICTED VestingAccountFilter = 2
+)
+
+var VestingAccountFilter_name = map[int32]string{
+	0: "VESTING_ACCOUNT_FILTER_UNSPECIFIED",
+	1: "VESTING_ACCOUNT_FILTER_FULLY_VESTED",
+	2: "VESTING_ACCOUNT_FILTER_RESTRICTED",
+}
+
+var VestingAccountFilter_value = map[string]int32{
+	"VESTING_ACCOUNT_FILTER_UNSPECIFIED":  0,
+	"VESTING_ACCOUNT_FILTER_FULLY_VESTED": 1,
+	"VESTING_ACCOUNT_FILTER_RESTRICTED":   2,
+}
+
+func (x VestingAccountFilter) String() string {
+	return proto.EnumName(VestingAccountFilter_name, int32(x))
+}
+
+func (VestingAccountFilter) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ad020ac309ae8cd3, []int{0}
+}
+
+// VestingAccountInfo describes the staking status of an indexed vesting account.
+type VestingAccountInfo struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// vesting_type is one of continuous, delayed, periodic or permanent_locked.
+	VestingType string `protobuf:"bytes,2,opt,name=vesting_type,json=vestingType,proto3" json:"vesting_type,omitempty"`
+	// vested_amount is the vested amount of the stake denom.
+	VestedAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=vested_amount,json=vestedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"vested_amount"`
+	// vesting_amount is the still-vesting amount of the stake denom.
+	VestingAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=vesting_amount,json=vestingAmount,proto3,customtype=cosmossdk.io/math.Int" json:"vesting_amount"`
+	// delegated_free is the delegated amount of the stake denom that was vested at delegation time.
+	DelegatedFree cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=delegated_free,json=delegatedFree,proto3,customtype=cosmossdk.io/math.Int" json:"delegated_free"`
+	// delegated_vesting is the delegated amount of the stake denom that was vesting at delegation time.
+	DelegatedVesting cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=delegated_vesting,json=delegatedVesting,proto3,customtype=cosmossdk.io/math.Int" json:"delegated_vesting"`
+	// is_eligible is true once the account is fully vested and may stake without restriction.
+	IsEligible bool `protobuf:"varint,7,opt,name=is_eligible,json=isEligible,proto3" json:"is_eligible,omitempty"`
+}
+
+func (m *VestingAccountInfo) Reset()         { *m = VestingAccountInfo{} }
+func (m *VestingAccountInfo) String() string { return proto.CompactTextString(m) }
+func (*VestingAccountInfo) ProtoMessage()    {}
+func (*VestingAccountInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad020ac309ae8cd3, []int{0}
+}
+func (m *VestingAccountInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingAccountInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingAccountInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingAccountInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingAccountInfo.Merge(m, src)
+}
+func (m *VestingAccountInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingAccountInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingAccountInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingAccountInfo proto.InternalMessageInfo
+
+func (m *VestingAccountInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VestingAccountInfo) GetVestingType() string {
+	if m != nil {
+		return m.VestingType
+	}
+	return ""
+}
+
+func (m *VestingAccountInfo) GetIsEligible() bool {
+	if m != nil {
+		return m.IsEligible
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("cosmosweightedgovernancesdk.delegation.v1.VestingAccountFilter", VestingAccountFilter_name, VestingAccountFilter_value)
+	proto.RegisterType((*VestingAccountInfo)(nil), "cosmosweightedgovernancesdk.delegation.v1.VestingAccountInfo")
+}
+
+func init() {
+	proto.RegisterFile("cosmosweightedgovernancesdk/delegation/v1/vesting_account.proto", fileDescriptor_ad020ac309ae8cd3)
+}
+
+var fileDescriptor_ad020ac309ae8cd3 = []byte{
+	// 471 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0x93, 0x0d, 0x36, 0xf0, 0x36, 0x54, 0xac, 0x22, 0x85, 0x1d, 0xd2, 0x6d, 0x08, 0x18,
+	0xa0, 0x34, 0x2a, 0x3c, 0x00, 0xea, 0xba, 0x04, 0x45, 0x54, 0x65, 0x4a, 0xd3, 0x09, 0xb8, 0x58,
+	0x59, 0xf3, 0x5f, 0x66, 0xd1, 0xda, 0x55, 0x6c, 0x0a, 0x7b, 0x09, 0xc4, 0xc3, 0xec, 0x21, 0x76,
+	0x9c, 0x76, 0x42, 0x1c, 0xa6, 0xa9, 0x7d, 0x11, 0xe4, 0xda, 0x6d, 0xe1, 0x30, 0xa4, 0xee, 0x16,
+	0x7f, 0xfe, 0xfc, 0xfb, 0x62, 0xfb, 0x33, 0x7a, 0xdb, 0xe5, 0xa2, 0xcf, 0xc5, 0x37, 0xa0, 0xf9,
+	0x89, 0x84, 0x2c, 0xe7, 0x43, 0x28, 0x58, 0xca, 0xba, 0x20, 0xb2, 0x2f, 0x7e, 0x06, 0x3d, 0xc8,
+	0x53, 0x49, 0x39, 0xf3, 0x87, 0x35, 0x7f, 0x08, 0x42, 0x52, 0x96, 0x93, 0xb4, 0xdb, 0xe5, 0x5f,
+	0x99, 0xac, 0x0e, 0x0a, 0x2e, 0x39, 0x7e, 0xf1, 0x1f, 0x40, 0x75, 0x0e, 0xa8, 0x0e, 0x6b, 0x9b,
+	0x8f, 0xb5, 0x95, 0x4c, 0x16, 0xfa, 0x7a, 0xa0, 0x29, 0x9b, 0xe5, 0x9c, 0xe7, 0x5c, 0xeb, 0xea,
+	0x4b, 0xab, 0x3b, 0xd7, 0xcb, 0x08, 0x1f, 0xea, 0xd4, 0xba, 0x0e, 0x8d, 0xd8, 0x31, 0xc7, 0xaf,
+	0xd1, 0x6a, 0x9a, 0x65, 0x05, 0x08, 0xe1, 0xd8, 0x5b, 0xf6, 0xee, 0xfd, 0x3d, 0xe7, 0xf2, 0xcc,
+	0x2b, 0x1b, 0x5e, 0x5d, 0xcf, 0xb4, 0x65, 0x41, 0x59, 0x1e, 0x4f, 0x8d, 0x78, 0x1b, 0xad, 0x4f,
+	0xff, 0x5f, 0x9e, 0x0e, 0xc0, 0x59, 0x52, 0x0b, 0xe3, 0x35, 0xa3, 0x25, 0xa7, 0x03, 0xc0, 0x07,
+	0x68, 0x43, 0x0d, 0x21, 0x23, 0x69, 0x5f, 0x65, 0x39, 0xcb, 0x13, 0xf8, 0xab, 0xf3, 0xab, 0x8a,
+	0xf5, 0xfb, 0xaa, 0xf2, 0x48, 0x07, 0xa8, 0x6d, 0x51, 0xee, 0xf7, 0x53, 0x79, 0x52, 0x8d, 0x98,
+	0xbc, 0x3c, 0xf3, 0x90, 0x49, 0x8e, 0x98, 0x8c, 0xd7, 0x35, 0xa1, 0x3e, 0x01, 0xe0, 0x18, 0x3d,
+	0x98, 0x1d, 0x9a, 0x46, 0xde, 0x59, 0x1c, 0xb9, 0x61, 0x10, 0x73, 0xa6, 0x39, 0x55, 0xc8, 0xc8,
+	0x71, 0x01, 0xe0, 0xdc, 0xbd, 0x05, 0x73, 0x86, 0x08, 0x0b, 0x00, 0xfc, 0x11, 0x3d, 0x9c, 0x33,
+	0x4d, 0x9c, 0xb3, 0xb2, 0x38, 0xb6, 0x34, 0xa3, 0x98, 0x5b, 0xc3, 0x15, 0xb4, 0x46, 0x05, 0x81,
+	0x1e, 0xcd, 0xe9, 0x51, 0x0f, 0x9c, 0xd5, 0x2d, 0x7b, 0xf7, 0x5e, 0x8c, 0xa8, 0x08, 0x8c, 0xf2,
+	0xf2, 0x87, 0x8d, 0xca, 0xff, 0x5e, 0x71, 0x48, 0x7b, 0x12, 0x0a, 0xfc, 0x0c, 0xed, 0x1c, 0x06,
+	0xed, 0x24, 0x6a, 0xbd, 0x23, 0xf5, 0x46, 0xe3, 0x43, 0xa7, 0x95, 0x90, 0x30, 0x6a, 0x26, 0x41,
+	0x4c, 0x3a, 0xad, 0xf6, 0x41, 0xd0, 0x88, 0xc2, 0x28, 0xd8, 0x2f, 0x59, 0xf8, 0x39, 0x7a, 0x72,
+	0x83, 0x2f, 0xec, 0x34, 0x9b, 0x9f, 0x88, 0x9a, 0x0c, 0xf6, 0x4b, 0x36, 0x7e, 0x8a, 0xb6, 0x6f,
+	0x30, 0xc6, 0x41, 0x3b, 0x89, 0xa3, 0x86, 0xb2, 0x2d, 0xed, 0xbd, 0x3f, 0x1f, 0xb9, 0xf6, 0xc5,
+	0xc8, 0xb5, 0xaf, 0x47, 0xae, 0xfd, 0x73, 0xec, 0x5a, 0x17, 0x63, 0xd7, 0xfa, 0x35, 0x76, 0xad,
+	0xcf, 0x35, 0xbd, 0x53, 0x6f, 0x5a, 0x75, 0x6f, 0xde, 0x75, 0x4f, 0xbd, 0x96, 0xef, 0x7f, 0xbf,
+	0x17, 0x55, 0x32, 0x71, 0xb4, 0x32, 0xe9, 0xf1, 0x9b, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6e,
+	0x6d, 0xe9, 0xc2, 0x66, 0x03, 0x00, 0x00,
+}
+
+func (m *VestingAccountInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingAccountInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingAccountInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsEligible {
+		i--
+		if m.IsEligible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.DelegatedVesting.Size()
+		i -= size
+		if _, err := m.DelegatedVesting.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVestingAccount(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.DelegatedFree.Size()
+		i -= size
+		if _, err := m.DelegatedFree.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVestingAccount(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.VestingAmount.Size()
+		i -= size
+		if _, err := m.VestingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVestingAccount(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.VestedAmount.Size()
+		i -= size
+		if _, err := m.VestedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVestingAccount(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VestingType) > 0 {
+		i -= len(m.VestingType)
+		copy(dAtA[i:], m.VestingType)
+		i = encodeVarintVestingAccount(dAtA, i, uint64(len(m.VestingType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintVestingAccount(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVestingAccount(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVestingAccount(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VestingAccountInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVestingAccount(uint64(l))
+	}
+	l = len(m.VestingType)
+	if l > 0 {
+		n += 1 + l + sovVestingAccount(uint64(l))
+	}
+	l = m.VestedAmount.Size()
+	n += 1 + l + sovVestingAccount(uint64(l))
+	l = m.VestingAmount.Size()
+	n += 1 + l + sovVestingAccount(uint64(l))
+	l = m.DelegatedFree.Size()
+	n += 1 + l + sovVestingAccount(uint64(l))
+	l = m.DelegatedVesting.Size()
+	n += 1 + l + sovVestingAccount(uint64(l))
+	if m.IsEligible {
+		n += 2
+	}
+	return n
+}
+
+func sovVestingAccount(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVestingAccount(x uint64) (n int) {
+	return sovVestingAccount(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VestingAccountInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVestingAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingAccountInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingAccountInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VestedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VestingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedFree", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatedFree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedVesting", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatedVesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsEligible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsEligible = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVestingAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVestingAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVestingAccount(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVestingAccount
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVestingAccount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVestingAccount
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVestingAccount
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVestingAccount
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVestingAccount        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVestingAccount          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVestingAccount = fmt.Errorf("proto: unexpected end of group")
+)
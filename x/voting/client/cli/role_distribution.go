@@ -0,0 +1,203 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// RoleDistributionClaims is the output of build-role-distribution: the root to publish
+// with MsgCreateRoleDistribution and the leaf and proof of every listed address.
+type RoleDistributionClaims struct {
+	MerkleRoot []byte           `json:"merkle_root"`
+	Claims     []RoleClaimProof `json:"claims"`
+}
+
+// RoleClaimProof holds what an address submits with MsgClaimVoterRole.
+type RoleClaimProof struct {
+	Address    string   `json:"address"`
+	Role       string   `json:"role"`
+	Multiplier string   `json:"multiplier"`
+	ExpiresAt  int64    `json:"expires_at"`
+	Proof      [][]byte `json:"proof"`
+}
+
+// CmdBuildRoleDistribution builds the Merkle tree of a role distribution from a CSV file.
+func CmdBuildRoleDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "build-role-distribution [csv-file]",
+		Short: "Build the Merkle root and claim proofs of a role distribution from a CSV file, offline",
+		Long: `Build the Merkle root and claim proofs of a role distribution from a CSV file with
+one address,role,multiplier,expires_at row per address. expires_at is a unix time and may
+be left out for roles that do not expire. A header row and lines starting with # are
+ignored. The output holds the merkle_root to publish with MsgCreateRoleDistribution and
+the claims file the listed addresses pass to claim-voter-role.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			claims, err := BuildRoleDistribution(file)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(claims, "", "  ")
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+	}
+
+	return cmd
+}
+
+// CmdClaimVoterRole claims the role of the sender from a claims file built by
+// build-role-distribution.
+func CmdClaimVoterRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-voter-role [distribution-id] [claims-file]",
+		Short: "Claim the voter role of the sender listed in a role distribution",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			distributionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid distribution id: %w", err)
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			var claims RoleDistributionClaims
+			if err := json.Unmarshal(bz, &claims); err != nil {
+				return fmt.Errorf("invalid claims file: %w", err)
+			}
+
+			claimant := clientCtx.GetFromAddress().String()
+			for _, claim := range claims.Claims {
+				if claim.Address != claimant {
+					continue
+				}
+
+				msg := types.NewMsgClaimVoterRole(claimant, distributionID, claim.Role, claim.Multiplier, claim.ExpiresAt, claim.Proof)
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			}
+
+			return fmt.Errorf("no claim for %s in %s", claimant, args[1])
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// BuildRoleDistribution reads the address,role,multiplier,expires_at rows of a role
+// distribution and builds its tree. An address can only be listed once, as it claims
+// a single role.
+func BuildRoleDistribution(r io.Reader) (RoleDistributionClaims, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var (
+		claims RoleDistributionClaims
+		leaves [][]byte
+		seen   = make(map[string]bool)
+	)
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return RoleDistributionClaims{}, err
+		}
+		if first && strings.EqualFold(strings.TrimSpace(record[0]), "address") {
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+
+		claim, err := parseRoleClaim(record)
+		if err != nil {
+			return RoleDistributionClaims{}, fmt.Errorf("line %d: %w", line, err)
+		}
+		if seen[claim.Address] {
+			return RoleDistributionClaims{}, fmt.Errorf("line %d: duplicated address %s", line, claim.Address)
+		}
+		seen[claim.Address] = true
+
+		claims.Claims = append(claims.Claims, claim)
+		leaves = append(leaves, types.RoleLeafHash(claim.Address, claim.Role, claim.Multiplier, claim.ExpiresAt))
+	}
+
+	if len(leaves) == 0 {
+		return RoleDistributionClaims{}, fmt.Errorf("no roles found")
+	}
+
+	tree := types.NewRoleMerkleTree(leaves)
+	claims.MerkleRoot = tree.Root()
+	for i := range claims.Claims {
+		claims.Claims[i].Proof = tree.Proof(i)
+	}
+
+	return claims, nil
+}
+
+// parseRoleClaim parses an address,role,multiplier[,expires_at] row.
+func parseRoleClaim(record []string) (RoleClaimProof, error) {
+	if len(record) != 3 && len(record) != 4 {
+		return RoleClaimProof{}, fmt.Errorf("expected address,role,multiplier[,expires_at], got %d fields", len(record))
+	}
+	for i := range record {
+		record[i] = strings.TrimSpace(record[i])
+	}
+
+	if _, err := sdk.AccAddressFromBech32(record[0]); err != nil {
+		return RoleClaimProof{}, fmt.Errorf("invalid address %s: %w", record[0], err)
+	}
+	if record[1] == "" {
+		return RoleClaimProof{}, fmt.Errorf("role cannot be empty")
+	}
+	if multiplier, err := math.LegacyNewDecFromStr(record[2]); err != nil || !multiplier.IsPositive() {
+		return RoleClaimProof{}, fmt.Errorf("invalid multiplier %q", record[2])
+	}
+
+	claim := RoleClaimProof{Address: record[0], Role: record[1], Multiplier: record[2]}
+	if len(record) == 4 && record[3] != "" {
+		expiresAt, err := strconv.ParseInt(record[3], 10, 64)
+		if err != nil || expiresAt < 0 {
+			return RoleClaimProof{}, fmt.Errorf("invalid expires_at %q", record[3])
+		}
+		claim.ExpiresAt = expiresAt
+	}
+
+	return claim, nil
+}
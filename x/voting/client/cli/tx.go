@@ -25,6 +25,8 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(CmdBuildRoleDistribution())
+	cmd.AddCommand(CmdClaimVoterRole())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
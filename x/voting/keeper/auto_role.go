@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// validatorRole is the role granted to validators when auto_validator_role is enabled.
+const validatorRole = "validator"
+
+// AssignValidatorRole grants the validator role to the operator account of a validator,
+// unless auto_validator_role is disabled or the account already holds a role.
+func (k Keeper) AssignValidatorRole(ctx context.Context, valAddr sdk.ValAddress) error {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		// validators bonded by gentxs before the module genesis are handled by InitGenesis
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !params.AutoValidatorRole {
+		return nil
+	}
+
+	address, err := k.addressCodec.BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.assignAutoRole(ctx, address, validatorRole, stakingtypes.ModuleName)
+}
+
+// RevokeValidatorRole revokes the validator role of the operator account of a validator
+// if it was assigned automatically. Roles set by governance are kept.
+func (k Keeper) RevokeValidatorRole(ctx context.Context, valAddr sdk.ValAddress) error {
+	address, err := k.addressCodec.BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.revokeAutoRole(ctx, address, validatorRole, stakingtypes.ModuleName)
+}
+
+// AssignBondedValidatorRoles grants the validator role to the operator accounts of all
+// bonded validators, for when auto_validator_role is enabled at genesis or by governance.
+func (k Keeper) AssignBondedValidatorRoles(ctx context.Context) error {
+	var valAddrs []sdk.ValAddress
+	var iterErr error
+	err := k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.ValidatorI) (stop bool) {
+		valBz, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+		if err != nil {
+			iterErr = err
+			return true
+		}
+		valAddrs = append(valAddrs, valBz)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	if iterErr != nil {
+		return iterErr
+	}
+
+	for _, valAddr := range valAddrs {
+		if err := k.AssignValidatorRole(ctx, valAddr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// assignAutoRole grants a role with its default multiplier to an address that holds no
+// role, marked as auto assigned. Roles that require a bond are never granted automatically,
+// as their holders must post the bond themselves.
+func (k Keeper) assignAutoRole(ctx context.Context, address, role, addedBy string) error {
+	if k.HasVoterRole(ctx, address) {
+		return nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.RoleBond.Required(role).IsZero() {
+		return nil
+	}
+
+	id, err := k.VoterRoleSeq.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	authority, err := k.addressCodec.BytesToString(k.GetAuthority())
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	voterRole := types.VoterRole{
+		Id:           id,
+		Creator:      authority,
+		Address:      address,
+		Role:         role,
+		Multiplier:   k.GetDefaultMultipliers()[role],
+		AddedAt:      sdkCtx.BlockTime().Unix(),
+		AddedBy:      addedBy,
+		AutoAssigned: true,
+	}
+	if err := k.VoterRole.Set(ctx, id, voterRole); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoterRoleCreated,
+			sdk.NewAttribute(types.AttributeKeyRoleID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyAddress, address),
+			sdk.NewAttribute(types.AttributeKeyRole, role),
+			sdk.NewAttribute(types.AttributeKeyMultiplier, voterRole.Multiplier),
+			sdk.NewAttribute(types.AttributeKeyAddedBy, addedBy),
+			sdk.NewAttribute(types.AttributeKeyAddedAt, fmt.Sprintf("%d", voterRole.AddedAt)),
+		),
+	)
+
+	return nil
+}
+
+// revokeAutoRole deletes the role of an address if it is the given auto assigned role.
+func (k Keeper) revokeAutoRole(ctx context.Context, address, role, deletedBy string) error {
+	voterRole, err := k.GetVoterRoleByAddress(ctx, address)
+	if errors.Is(err, sdkerrors.ErrKeyNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !voterRole.AutoAssigned || voterRole.Role != role {
+		return nil
+	}
+
+	if err := k.VoterRole.Remove(ctx, voterRole.Id); err != nil {
+		return err
+	}
+
+	// vote delegations only exist between role holders
+	if err := k.RemoveVoteDelegationsFor(ctx, address); err != nil {
+		return err
+	}
+
+	if err := k.clearDowngrade(ctx, address); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoterRoleDeleted,
+			sdk.NewAttribute(types.AttributeKeyRoleID, fmt.Sprintf("%d", voterRole.Id)),
+			sdk.NewAttribute(types.AttributeKeyAddress, address),
+			sdk.NewAttribute(types.AttributeKeyDeletedBy, deletedBy),
+		),
+	)
+
+	return nil
+}
+
+// replaceAutoRole deletes the auto assigned role of an address, so that governance can
+// grant it a role of its own.
+func (k Keeper) replaceAutoRole(ctx context.Context, address string) error {
+	voterRole, err := k.GetVoterRoleByAddress(ctx, address)
+	if errors.Is(err, sdkerrors.ErrKeyNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !voterRole.AutoAssigned {
+		return nil
+	}
+
+	if err := k.clearDowngrade(ctx, address); err != nil {
+		return err
+	}
+
+	return k.VoterRole.Remove(ctx, voterRole.Id)
+}
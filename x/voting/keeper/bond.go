@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// releaseBond returns the bond of a removed voter role to its holder. A role revoked for
+// cause first loses the slash fraction of its bond to the community pool. It returns the
+// slashed amount.
+func (k Keeper) releaseBond(ctx context.Context, role types.VoterRole, forCause bool) (sdk.Coins, error) {
+	if role.Bond.IsZero() {
+		return nil, nil
+	}
+
+	refund := role.Bond
+	var slashed sdk.Coins
+	if forCause {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, coin := range role.Bond {
+			amount := params.RoleBond.SlashFraction.MulInt(coin.Amount).TruncateInt()
+			slashed = slashed.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+		refund = refund.Sub(slashed...)
+
+		if !slashed.IsZero() {
+			if err := k.distrKeeper.FundCommunityPool(ctx, slashed, authtypes.NewModuleAddress(types.ModuleName)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if refund.IsZero() {
+		return slashed, nil
+	}
+
+	holder, err := k.addressCodec.StringToBytes(role.Address)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, holder, refund); err != nil {
+		return nil, err
+	}
+
+	return slashed, nil
+}
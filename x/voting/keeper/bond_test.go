@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmos-weighted-governance-sdk/x/voting/keeper"
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+func TestRoleBond(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	authority := authorityMsg(t, f).Authority
+	ctx := withBlockTime(f, 1000)
+
+	alice := setRoleHolder(t, f, 9, "alice", "community_member", "1.0")
+	bob, err := f.addressCodec.BytesToString(testAccAddress("bob"))
+	require.NoError(t, err)
+	carol, err := f.addressCodec.BytesToString(testAccAddress("carol"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.RoleCreationCooldown = 0
+	params.Nomination = types.NewNominationParams(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 500)
+	params.RoleBond = types.NewRoleBondParams("stake", math.LegacyNewDecWithPrec(5, 1),
+		[]types.RoleBond{{Role: "core_contributor", Amount: math.NewInt(1000)}})
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	bond := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	for _, name := range []string{"alice", "bob", "carol"} {
+		f.bankKeeper.balances[string(testAccAddress(name))] = sdk.NewCoins(sdk.NewInt64Coin("stake", 2000))
+	}
+	balance := func(name string) sdk.Coins {
+		return f.bankKeeper.balances[string(testAccAddress(name))]
+	}
+	invariant := func() {
+		msg, broken := keeper.EscrowedFundsInvariant(f.keeper)(ctx)
+		require.False(t, broken, msg)
+	}
+
+	// bonded roles cannot be granted without a bond, nor promoted to
+	_, err = srv.CreateVoterRole(ctx, &types.MsgCreateVoterRole{Creator: authority, Address: bob, Role: "core_contributor", Multiplier: "2.0"})
+	require.ErrorIs(t, err, types.ErrRoleBondRequired)
+	_, err = srv.UpdateVoterRole(ctx, &types.MsgUpdateVoterRole{Creator: authority, Id: 9, Address: alice, Role: "core_contributor", Multiplier: "2.0"})
+	require.ErrorIs(t, err, types.ErrRoleBondRequired)
+	_, err = srv.NominateVoterRole(ctx, types.NewMsgNominateVoterRole(alice, bob, "core_contributor", "active", deposit))
+	require.ErrorIs(t, err, types.ErrRoleBondRequired)
+
+	// the nominee posts the bond with the deposit
+	nominate := func(address string) uint64 {
+		res, err := srv.NominateVoterRole(ctx, types.NewMsgNominateVoterRole(address, address, "core_contributor", "active", deposit))
+		require.NoError(t, err)
+		return res.Id
+	}
+	first := nominate(bob)
+	second := nominate(carol)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 900)), balance("bob"))
+	require.Equal(t, deposit.Add(bond...).MulInt(math.NewInt(2)), f.bankKeeper.moduleBalance(types.ModuleName))
+	invariant()
+
+	// the approved role keeps the bond, and the deposit is refunded
+	res, err := srv.ApproveNomination(ctx, types.NewMsgApproveNomination(authority, first, "2.0"))
+	require.NoError(t, err)
+	role, err := f.keeper.VoterRole.Get(ctx, res.VoterRoleId)
+	require.NoError(t, err)
+	require.Equal(t, bond, role.Bond)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), balance("bob"))
+	invariant()
+
+	// the holder of a bonded role cannot change
+	_, err = srv.UpdateVoterRole(ctx, &types.MsgUpdateVoterRole{Creator: authority, Id: role.Id, Address: carol, Role: "core_contributor", Multiplier: "2.0"})
+	require.Error(t, err)
+	_, err = srv.UpdateVoterRole(ctx, &types.MsgUpdateVoterRole{Creator: authority, Id: role.Id, Address: bob, Role: "core_contributor", Multiplier: "3.0"})
+	require.NoError(t, err)
+	role, err = f.keeper.VoterRole.Get(ctx, role.Id)
+	require.NoError(t, err)
+	require.Equal(t, bond, role.Bond)
+
+	// a rejected nomination returns the bond, the burned deposit aside
+	_, err = srv.RejectNomination(ctx, types.NewMsgRejectNomination(authority, second, true))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1900)), balance("carol"))
+	invariant()
+
+	// an expired nomination returns both
+	third := nominate(carol)
+	require.NoError(t, f.keeper.ProcessNominations(withBlockTime(f, 1500)))
+	has, err := f.keeper.Nominations.Has(ctx, third)
+	require.NoError(t, err)
+	require.False(t, has)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1900)), balance("carol"))
+	invariant()
+
+	// a revocation for cause slashes half of the bond to the community pool
+	_, err = srv.DeleteVoterRole(ctx, &types.MsgDeleteVoterRole{Creator: authority, Id: role.Id, ForCause: true})
+	require.NoError(t, err)
+	require.Equal(t, "500stake", eventAttribute(t, ctx, types.EventTypeVoterRoleDeleted, types.AttributeKeySlashed))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1500)), balance("bob"))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)), f.bankKeeper.moduleBalance(distrtypes.ModuleName))
+	invariant()
+
+	// a plain revocation returns the whole bond
+	res, err = srv.ApproveNomination(ctx, types.NewMsgApproveNomination(authority, nominate(carol), "2.0"))
+	require.NoError(t, err)
+	_, err = srv.DeleteVoterRole(ctx, &types.MsgDeleteVoterRole{Creator: authority, Id: res.VoterRoleId})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1900)), balance("carol"))
+	require.True(t, f.bankKeeper.moduleBalance(types.ModuleName).IsZero())
+	invariant()
+
+	// funds leaving the module account outside of the registry break the invariant
+	f.bankKeeper.balances[string(authtypes.NewModuleAddress(types.ModuleName))] = sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+	_, broken := keeper.EscrowedFundsInvariant(f.keeper)(ctx)
+	require.True(t, broken)
+}
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// communityMemberRole is the role granted to accounts that keep the community role min stake.
+const communityMemberRole = "community_member"
+
+// secondsPerDay converts the community role min days to seconds.
+const secondsPerDay = 24 * 60 * 60
+
+// UpdateCommunityRoles checks at most batch_size community role candidates, resuming after
+// the candidate the previous epoch stopped at. A candidate that fell below the min stake
+// loses its stake record and its community_member role, and a candidate that kept the min
+// stake bonded for the min days is granted the role. Once the last candidate is checked,
+// the next epoch starts over from the first one.
+func (k Keeper) UpdateCommunityRoles(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	communityRole := params.CommunityRole
+
+	var ranger collections.Ranger[string]
+	cursor, err := k.CommunityRoleCursor.Get(ctx)
+	if err == nil {
+		ranger = new(collections.Range[string]).StartExclusive(cursor)
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	batchSize := int(communityRole.BatchSize)
+	candidates := make([]string, 0, batchSize)
+	err = k.CommunityRoleCandidates.Walk(ctx, ranger, func(address string) (bool, error) {
+		candidates = append(candidates, address)
+		return len(candidates) == batchSize, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(candidates) < batchSize {
+		err = k.CommunityRoleCursor.Remove(ctx)
+	} else {
+		err = k.CommunityRoleCursor.Set(ctx, candidates[len(candidates)-1])
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, address := range candidates {
+		if err := k.updateCommunityRole(ctx, communityRole, address); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateCommunityRole refreshes the stake record and the community_member role of a
+// candidate. Candidates without any delegation left are dropped until they delegate again.
+func (k Keeper) updateCommunityRole(ctx context.Context, params types.CommunityRoleParams, address string) error {
+	delegator, err := k.addressCodec.StringToBytes(address)
+	if err != nil {
+		return err
+	}
+	stake, delegated, err := k.bondedStake(ctx, delegator)
+	if err != nil {
+		return err
+	}
+
+	if stake.TruncateInt().LT(params.MinStake) {
+		if !delegated {
+			if err := k.CommunityRoleCandidates.Remove(ctx, address); err != nil {
+				return err
+			}
+		}
+		if err := k.StakeRecords.Remove(ctx, address); err != nil {
+			return err
+		}
+		return k.revokeAutoRole(ctx, address, communityMemberRole, epochstypes.ModuleName)
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	record, err := k.StakeRecords.Get(ctx, address)
+	if errors.Is(err, collections.ErrNotFound) {
+		record = types.StakeRecord{Address: address, Since: now}
+		if err := k.StakeRecords.Set(ctx, address, record); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+
+	if record.Since+int64(params.MinDays)*secondsPerDay > now {
+		return nil
+	}
+
+	return k.assignAutoRole(ctx, address, communityMemberRole, epochstypes.ModuleName)
+}
+
+// AddCommunityRoleCandidate records a delegator to be checked for the community role.
+func (k Keeper) AddCommunityRoleCandidate(ctx context.Context, delegator sdk.AccAddress) error {
+	address, err := k.addressCodec.BytesToString(delegator)
+	if err != nil {
+		return err
+	}
+
+	return k.CommunityRoleCandidates.Set(ctx, address)
+}
+
+// addAllCommunityRoleCandidates records every delegator, stake record and automatic
+// community role holder as a community role candidate, for state the staking hooks did
+// not see. It walks all delegations, so it only runs at genesis and in migrations.
+func (k Keeper) addAllCommunityRoleCandidates(ctx context.Context) error {
+	var candidates []string
+	err := k.stakingKeeper.IterateAllDelegations(ctx, func(delegation stakingtypes.Delegation) (stop bool) {
+		candidates = append(candidates, delegation.DelegatorAddress)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	err = k.StakeRecords.Walk(ctx, nil, func(address string, _ types.StakeRecord) (bool, error) {
+		candidates = append(candidates, address)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = k.VoterRole.Walk(ctx, nil, func(_ uint64, role types.VoterRole) (bool, error) {
+		if role.AutoAssigned && role.Role == communityMemberRole {
+			candidates = append(candidates, role.Address)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, address := range candidates {
+		if err := k.CommunityRoleCandidates.Set(ctx, address); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"sort"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmos-weighted-governance-sdk/x/voting/keeper"
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+func TestEpochHooksCommunityRole(t *testing.T) {
+	f := initFixture(t)
+	hooks := keeper.NewEpochHooks(f.keeper)
+	stakingHooks := keeper.NewStakingHooks(f.keeper)
+
+	valAddr := f.stakingKeeper.SetValidator(sdk.ValAddress(testAccAddress("validator")), 1000)
+	delegate := func(name string, shares int64) {
+		f.stakingKeeper.Delegate(testAccAddress(name), valAddr, shares)
+		require.NoError(t, stakingHooks.AfterDelegationModified(f.ctx, testAccAddress(name), nil))
+	}
+	delegate("alice", 100)
+	delegate("bob", 60)
+	delegate("bob", 60)
+	delegate("carol", 50)
+	delegate("dave", 500)
+	dave := setRoleHolder(t, f, 9, "dave", "core_contributor", "2.0")
+
+	params := types.DefaultParams()
+	params.CommunityRole = types.NewCommunityRoleParams(true, "day", math.NewInt(100), 2, 2)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	addressOf := func(name string) string {
+		address, err := f.addressCodec.BytesToString(testAccAddress(name))
+		require.NoError(t, err)
+		return address
+	}
+	roleOf := func(name string) *types.VoterRole {
+		if !f.keeper.HasVoterRole(f.ctx, addressOf(name)) {
+			return nil
+		}
+		role, err := f.keeper.GetVoterRoleByAddress(f.ctx, addressOf(name))
+		require.NoError(t, err)
+		return role
+	}
+	hasRecord := func(name string) bool {
+		has, err := f.keeper.StakeRecords.Has(f.ctx, addressOf(name))
+		require.NoError(t, err)
+		return has
+	}
+	// candidates are checked in address order
+	names := []string{"alice", "bob", "carol", "dave"}
+	sort.Slice(names, func(i, j int) bool { return addressOf(names[i]) < addressOf(names[j]) })
+	// sweep runs epochs until the last candidate was checked, batch_size at a time
+	sweep := func(unix int64) {
+		for epoch := 0; epoch < 3; epoch++ {
+			require.NoError(t, hooks.AfterEpochEnd(withBlockTime(f, unix), "day", 1))
+			has, err := f.keeper.CommunityRoleCursor.Has(f.ctx)
+			require.NoError(t, err)
+			if !has {
+				return
+			}
+		}
+		t.Fatal("candidates were not all checked in three epochs")
+	}
+
+	// other epochs are ignored
+	require.NoError(t, hooks.AfterEpochEnd(withBlockTime(f, 0), "week", 1))
+	require.False(t, hasRecord("alice"))
+
+	// an epoch checks batch_size candidates and resumes after the last one
+	require.NoError(t, hooks.AfterEpochEnd(withBlockTime(f, 0), "day", 1))
+	cursor, err := f.keeper.CommunityRoleCursor.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, addressOf(names[1]), cursor)
+	for i, name := range names {
+		require.Equal(t, i < 2 && name != "carol", hasRecord(name), name)
+	}
+
+	// the min stake is recorded, but it was not kept for the min days yet
+	sweep(0)
+	record, err := f.keeper.StakeRecords.Get(f.ctx, addressOf("bob"))
+	require.NoError(t, err)
+	require.Equal(t, int64(0), record.Since)
+	require.False(t, hasRecord("carol"))
+	require.Nil(t, roleOf("alice"))
+
+	sweep(2 * 86400)
+	role := roleOf("alice")
+	require.NotNil(t, role)
+	require.Equal(t, "community_member", role.Role)
+	require.Equal(t, "1.0", role.Multiplier)
+	require.True(t, role.AutoAssigned)
+	require.True(t, roleOf("bob").AutoAssigned)
+	require.Nil(t, roleOf("carol"))
+	// roles set by governance are kept
+	require.Equal(t, dave, roleOf("dave").Address)
+	require.Equal(t, "core_contributor", roleOf("dave").Role)
+
+	// falling below the min stake revokes the role and resets the record
+	f.stakingKeeper.delegations[string(testAccAddress("alice"))][0].Shares = math.LegacyNewDec(99)
+	sweep(4 * 86400)
+	require.Nil(t, roleOf("alice"))
+	require.False(t, hasRecord("alice"))
+
+	// delegators without any delegation left are no longer checked
+	delete(f.stakingKeeper.delegations, string(testAccAddress("carol")))
+	sweep(4 * 86400)
+	has, err := f.keeper.CommunityRoleCandidates.Has(f.ctx, addressOf("carol"))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.CommunityRoleCandidates.Has(f.ctx, addressOf("alice"))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// a community role that requires a bond is not granted automatically
+	params.RoleBond = types.NewRoleBondParams("stake", types.DefaultRoleBondSlashFraction,
+		[]types.RoleBond{{Role: "community_member", Amount: math.NewInt(1000)}})
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	f.stakingKeeper.delegations[string(testAccAddress("alice"))][0].Shares = math.LegacyNewDec(100)
+	sweep(6 * 86400)
+	require.True(t, hasRecord("alice"))
+	sweep(8 * 86400)
+	require.Nil(t, roleOf("alice"))
+}
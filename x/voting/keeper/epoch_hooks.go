@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks recompute the automatic community roles at the end of the configured epoch
+type EpochHooks struct {
+	k Keeper
+}
+
+// NewEpochHooks creates the epoch hooks of the voting module
+func NewEpochHooks(k Keeper) EpochHooks {
+	return EpochHooks{k: k}
+}
+
+// AfterEpochEnd grants and revokes community roles when the configured epoch ends
+func (h EpochHooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, _ int64) error {
+	params, err := h.k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.CommunityRole.Enabled || params.CommunityRole.EpochIdentifier != epochIdentifier {
+		return nil
+	}
+
+	return h.k.UpdateCommunityRoles(ctx)
+}
+
+func (h EpochHooks) BeforeEpochStart(context.Context, string, int64) error {
+	return nil
+}
@@ -15,7 +15,7 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		return err
 	}
 	for _, elem := range genState.VoterRoleList {
-		if err := k.VoterRole.Set(ctx, elem.Id, elem); err != nil {
+		if err := k.SetVoterRole(ctx, elem); err != nil {
 			return err
 		}
 	}
@@ -24,7 +24,89 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		return err
 	}
 
-	return k.Params.Set(ctx, genState.Params)
+	for _, elem := range genState.VoteDelegationList {
+		if err := k.SetVoteDelegation(ctx, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.ChamberTallyList {
+		if err := k.ChamberTallies.Set(ctx, elem.ProposalId, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.TimelockList {
+		if err := k.SetTimelock(ctx, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.ProposalRoleTallyList {
+		if err := k.ProposalRoleTallies.Set(ctx, elem.ProposalId, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.StakeRecordList {
+		if err := k.StakeRecords.Set(ctx, elem.Address, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.ParticipationList {
+		if err := k.Participation.Set(ctx, elem.Address, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.ProposalVoterList {
+		if err := k.ProposalVoters.Set(ctx, collections.Join(elem.ProposalId, elem.Voter)); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.NominationList {
+		if err := k.SetNomination(ctx, elem); err != nil {
+			return err
+		}
+	}
+
+	if err := k.NominationSeq.Set(ctx, genState.NominationCount); err != nil {
+		return err
+	}
+
+	for _, elem := range genState.RoleDistributionList {
+		if err := k.RoleDistributions.Set(ctx, elem.Id, elem); err != nil {
+			return err
+		}
+	}
+
+	if err := k.RoleDistributionSeq.Set(ctx, genState.RoleDistributionCount); err != nil {
+		return err
+	}
+
+	for _, elem := range genState.RoleClaimList {
+		if err := k.RoleClaims.Set(ctx, collections.Join(elem.DistributionId, elem.Address)); err != nil {
+			return err
+		}
+	}
+
+	if err := k.Params.Set(ctx, genState.Params); err != nil {
+		return err
+	}
+
+	// delegations imported by x/staking precede the voting genesis and missed the staking hooks
+	if err := k.addAllCommunityRoleCandidates(ctx); err != nil {
+		return err
+	}
+
+	// validators bonded by gentxs precede the voting genesis and missed the staking hooks
+	if genState.Params.AutoValidatorRole {
+		return k.AssignBondedValidatorRoles(ctx)
+	}
+
+	return nil
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -53,5 +135,95 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	err = k.VoteDelegations.Walk(ctx, nil, func(_ string, elem types.VoteDelegation) (bool, error) {
+		genesis.VoteDelegationList = append(genesis.VoteDelegationList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.ChamberTallies.Walk(ctx, nil, func(_ uint64, elem types.ChamberTally) (bool, error) {
+		genesis.ChamberTallyList = append(genesis.ChamberTallyList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Timelocks.Walk(ctx, nil, func(_ uint64, elem types.Timelock) (bool, error) {
+		genesis.TimelockList = append(genesis.TimelockList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.ProposalRoleTallies.Walk(ctx, nil, func(_ uint64, elem types.ProposalRoleTally) (bool, error) {
+		genesis.ProposalRoleTallyList = append(genesis.ProposalRoleTallyList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.StakeRecords.Walk(ctx, nil, func(_ string, elem types.StakeRecord) (bool, error) {
+		genesis.StakeRecordList = append(genesis.StakeRecordList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Participation.Walk(ctx, nil, func(_ string, elem types.Participation) (bool, error) {
+		genesis.ParticipationList = append(genesis.ParticipationList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.ProposalVoters.Walk(ctx, nil, func(key collections.Pair[uint64, string]) (bool, error) {
+		genesis.ProposalVoterList = append(genesis.ProposalVoterList, types.ProposalVoter{ProposalId: key.K1(), Voter: key.K2()})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Nominations.Walk(ctx, nil, func(_ uint64, elem types.Nomination) (bool, error) {
+		genesis.NominationList = append(genesis.NominationList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genesis.NominationCount, err = k.NominationSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.RoleDistributions.Walk(ctx, nil, func(_ uint64, elem types.RoleDistribution) (bool, error) {
+		genesis.RoleDistributionList = append(genesis.RoleDistributionList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genesis.RoleDistributionCount, err = k.RoleDistributionSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.RoleClaims.Walk(ctx, nil, func(key collections.Pair[uint64, string]) (bool, error) {
+		genesis.RoleClaimList = append(genesis.RoleClaimList, types.RoleClaim{DistributionId: key.K1(), Address: key.K2()})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return genesis, nil
 }
@@ -5,15 +5,40 @@ import (
 
 	"cosmos-weighted-governance-sdk/x/voting/types"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
-		Params:         types.DefaultParams(),
-		PortId:         types.PortID,
-		VoterRoleList:  []types.VoterRole{{Id: 0}, {Id: 1}},
-		VoterRoleCount: 2,
+		Params: types.DefaultParams(),
+		PortId: types.PortID,
+		VoterRoleList: []types.VoterRole{
+			{Id: 0},
+			{Id: 1, Suspension: &types.RoleSuspension{Reason: "investigation", SuspendedAt: 50, ReinstateAt: 100}},
+			{Id: 2, ExpiresAt: 300},
+		},
+		VoterRoleCount:   3,
+		ChamberTallyList: []types.ChamberTally{{ProposalId: 1, TokenHousePassed: true}, {ProposalId: 2, RoleHousePassed: true}},
+		TimelockList:     []types.Timelock{{ProposalId: 1, ExecuteAfter: 100}, {ProposalId: 2, ExecuteAfter: 50, Vetoes: []string{"voter"}}},
+		ProposalRoleTallyList: []types.ProposalRoleTally{
+			{ProposalId: 1, RoleTallies: []types.RoleVoteTally{{Role: "validator", Voters: 2, Multiplier: math.LegacyNewDec(2)}}},
+			{ProposalId: 3},
+		},
+		StakeRecordList: []types.StakeRecord{{Address: "alice", Since: 100}, {Address: "bob", Since: 50}},
+		ParticipationList: []types.Participation{
+			{Address: "alice", ProposalsEnded: 3, ProposalsVoted: 1, Recent: []bool{true, false, false}, DowngradedFrom: "2.0"},
+			{Address: "bob"},
+		},
+		ProposalVoterList: []types.ProposalVoter{{ProposalId: 1, Voter: "alice"}, {ProposalId: 2, Voter: "bob"}},
+		NominationList:    []types.Nomination{{Id: 0, Address: "alice", ExpiresAt: 100}, {Id: 1, Address: "bob", ExpiresAt: 200}},
+		NominationCount:   2,
+		RoleDistributionList: []types.RoleDistribution{
+			{Id: 0, MerkleRoot: make([]byte, types.MerkleHashSize), ClaimStart: 100, ClaimEnd: 200, Claimed: 1},
+		},
+		RoleDistributionCount: 1,
+		RoleClaimList:         []types.RoleClaim{{DistributionId: 0, Address: "alice"}},
 	}
 	f := initFixture(t)
 	err := f.keeper.InitGenesis(f.ctx, genesisState)
@@ -26,5 +51,25 @@ func TestGenesis(t *testing.T) {
 	require.EqualExportedValues(t, genesisState.Params, got.Params)
 	require.EqualExportedValues(t, genesisState.VoterRoleList, got.VoterRoleList)
 	require.Equal(t, genesisState.VoterRoleCount, got.VoterRoleCount)
+	suspended, err := f.keeper.SuspensionQueue.Has(f.ctx, collections.Join(int64(100), uint64(1)))
+	require.NoError(t, err)
+	require.True(t, suspended)
+	require.EqualExportedValues(t, genesisState.ChamberTallyList, got.ChamberTallyList)
+	require.EqualExportedValues(t, genesisState.TimelockList, got.TimelockList)
+	require.EqualExportedValues(t, genesisState.ProposalRoleTallyList, got.ProposalRoleTallyList)
+	require.EqualExportedValues(t, genesisState.StakeRecordList, got.StakeRecordList)
+	require.EqualExportedValues(t, genesisState.ParticipationList, got.ParticipationList)
+	require.EqualExportedValues(t, genesisState.ProposalVoterList, got.ProposalVoterList)
+	require.EqualExportedValues(t, genesisState.NominationList, got.NominationList)
+	require.Equal(t, genesisState.NominationCount, got.NominationCount)
+	queued, err := f.keeper.NominationQueue.Has(f.ctx, collections.Join(int64(200), uint64(1)))
+	require.NoError(t, err)
+	require.True(t, queued)
+	expiring, err := f.keeper.RoleExpiryQueue.Has(f.ctx, collections.Join(int64(300), uint64(2)))
+	require.NoError(t, err)
+	require.True(t, expiring)
+	require.EqualExportedValues(t, genesisState.RoleDistributionList, got.RoleDistributionList)
+	require.Equal(t, genesisState.RoleDistributionCount, got.RoleDistributionCount)
+	require.EqualExportedValues(t, genesisState.RoleClaimList, got.RoleClaimList)
 
 }
@@ -25,6 +25,21 @@ func NewGovHooksWrapper(k Keeper, originalHooks govtypes.GovHooks) GovHooksWrapp
 
 // AfterProposalSubmission is called after a proposal is submitted
 func (h GovHooksWrapper) AfterProposalSubmission(ctx context.Context, proposalID uint64) error {
+	proposal, err := h.k.getProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	// while the veto window is enabled, proposal messages must be timelocked
+	if err := h.k.ValidateTimelockedProposal(ctx, proposal); err != nil {
+		return err
+	}
+
+	// registry changes are tallied with elevated params and cannot be mixed with other messages
+	if err := h.k.ValidateRegistryProposal(proposal); err != nil {
+		return err
+	}
+
 	if h.originalHooks != nil {
 		return h.originalHooks.AfterProposalSubmission(ctx, proposalID)
 	}
@@ -47,6 +62,11 @@ func (h GovHooksWrapper) AfterProposalVote(ctx context.Context, proposalID uint6
 		return fmt.Errorf("failed to convert voter address: %w", err)
 	}
 
+	// track the participation of role holders
+	if err := h.k.RecordVote(ctx, proposalID, voterStr); err != nil {
+		return err
+	}
+
 	// Get the voting multiplier for this address
 	multiplier, err := h.k.GetVotingMultiplier(ctx, voterStr)
 	if err != nil {
@@ -84,6 +104,21 @@ func (h GovHooksWrapper) AfterProposalFailedMinDeposit(ctx context.Context, prop
 
 // AfterProposalVotingPeriodEnded is called after the voting period ends
 func (h GovHooksWrapper) AfterProposalVotingPeriodEnded(ctx context.Context, proposalID uint64) error {
+	// keep how each role voted once gov removed the votes
+	if err := h.k.StoreProposalRoleTally(ctx, proposalID); err != nil {
+		return err
+	}
+
+	// role holders that keep missing proposals may be downgraded
+	if err := h.k.RecordParticipation(ctx, proposalID); err != nil {
+		return err
+	}
+
+	// the messages of the proposal ran, so nothing can be timelocked under it anymore
+	if err := h.k.TimelockProposal.Remove(ctx); err != nil {
+		return err
+	}
+
 	if h.originalHooks != nil {
 		return h.originalHooks.AfterProposalVotingPeriodEnded(ctx, proposalID)
 	}
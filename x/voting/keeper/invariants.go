@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// RegisterInvariants registers the voting module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) { //nolint:staticcheck // invariants are deprecated with x/crisis
+	ir.RegisterRoute(types.ModuleName, "escrowed-funds", EscrowedFundsInvariant(k))
+}
+
+// AllInvariants runs all the invariants of the voting module.
+func AllInvariants(k Keeper) sdk.Invariant { //nolint:staticcheck // invariants are deprecated with x/crisis
+	return func(ctx sdk.Context) (string, bool) {
+		return EscrowedFundsInvariant(k)(ctx)
+	}
+}
+
+// EscrowedFundsInvariant checks that the module account holds exactly the bonds of the
+// voter roles and the deposits and bonds of the pending nominations.
+func EscrowedFundsInvariant(k Keeper) sdk.Invariant { //nolint:staticcheck // invariants are deprecated with x/crisis
+	return func(ctx sdk.Context) (string, bool) {
+		var bonds, nominations sdk.Coins
+		if err := k.VoterRole.Walk(ctx, nil, func(_ uint64, role types.VoterRole) (bool, error) {
+			bonds = bonds.Add(role.Bond...)
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "escrowed-funds", err.Error()), true
+		}
+		if err := k.Nominations.Walk(ctx, nil, func(_ uint64, nomination types.Nomination) (bool, error) {
+			nominations = nominations.Add(nomination.Deposit...).Add(nomination.Bond...)
+			return false, nil
+		}); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "escrowed-funds", err.Error()), true
+		}
+
+		expected := bonds.Add(nominations...)
+		balance := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+		broken := !balance.Equal(expected)
+
+		return sdk.FormatInvariant(types.ModuleName, "escrowed-funds", fmt.Sprintf(
+			"\tvoting module account balance: %s\n\tvoter role bonds: %s\n\tnomination deposits and bonds: %s\n",
+			balance, bonds, nominations)), broken
+	}
+}
@@ -1,12 +1,16 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 
 	"cosmos-weighted-governance-sdk/x/voting/types"
@@ -20,6 +24,16 @@ type Keeper struct {
 	// Typically, this should be the x/gov module account.
 	authority []byte
 
+	stakingKeeper types.StakingKeeper
+	// bankKeeper moves the nomination deposits and the role bonds
+	bankKeeper types.BankKeeper
+	// distrKeeper receives the role bonds slashed for cause
+	distrKeeper types.DistrKeeper
+	// router executes the messages of timelocked proposals
+	router baseapp.MessageRouter
+	// govKeeper is set after construction, since x/gov depends on this module's tally
+	govKeeper *govKeeperRef
+
 	Schema collections.Schema
 	Params collections.Item[types.Params]
 
@@ -27,9 +41,61 @@ type Keeper struct {
 
 	ibcKeeperFn  func() *ibckeeper.Keeper
 	VoterRoleSeq collections.Sequence
-	VoterRole    collections.Map[uint64, types.VoterRole]
+	VoterRole    *collections.IndexedMap[uint64, types.VoterRole, VoterRoleIndexes]
+	// SuspensionQueue indexes suspended roles by (reinstate at, role id)
+	SuspensionQueue collections.KeySet[collections.Pair[int64, uint64]]
+	// RoleExpiryQueue indexes expiring roles by (expires at, role id)
+	RoleExpiryQueue collections.KeySet[collections.Pair[int64, uint64]]
 	// LastRoleCreationTime tracks the last time a role was created (for rate limiting)
 	LastRoleCreationTime collections.Item[int64]
+
+	// VoteDelegations maps a delegator to its vote delegation
+	VoteDelegations collections.Map[string, types.VoteDelegation]
+	// VoteDelegationsByRepresentative indexes vote delegations by (representative, delegator)
+	VoteDelegationsByRepresentative collections.KeySet[collections.Pair[string, string]]
+
+	// ChamberTallies holds the per-chamber results of proposals tallied in bicameral mode
+	ChamberTallies collections.Map[uint64, types.ChamberTally]
+
+	// Timelocks holds the messages of passed proposals during their veto window
+	Timelocks collections.Map[uint64, types.Timelock]
+	// TimelockQueue indexes timelocks by (execute after, proposal id)
+	TimelockQueue collections.KeySet[collections.Pair[int64, uint64]]
+	// TimelockProposal holds the id of the proposal x/gov passed last, until its voting
+	// period ended hook. x/gov executes the messages of a passed proposal right after its
+	// tally, so timelocked messages are filed under this id.
+	TimelockProposal collections.Item[uint64]
+
+	// ProposalRoleTallies holds how the holders of each role voted on finished proposals
+	ProposalRoleTallies collections.Map[uint64, types.ProposalRoleTally]
+	// PendingRoleTallies holds the per-role votes of proposals x/gov tallied until their
+	// AfterProposalVotingPeriodEnded hook stores them
+	PendingRoleTallies collections.Map[uint64, types.ProposalRoleTally]
+
+	// StakeRecords tracks since when accounts have kept the min stake of the automatic
+	// community role bonded
+	StakeRecords collections.Map[string, types.StakeRecord]
+	// CommunityRoleCandidates holds the delegators checked for the automatic community role
+	CommunityRoleCandidates collections.KeySet[string]
+	// CommunityRoleCursor holds the last candidate checked, where the next epoch resumes
+	CommunityRoleCursor collections.Item[string]
+
+	// Participation tracks whether role holders voted on the proposals that ended
+	Participation collections.Map[string, types.Participation]
+	// ProposalVoters holds the (proposal id, role holder) votes of proposals in voting period
+	ProposalVoters collections.KeySet[collections.Pair[uint64, string]]
+
+	NominationSeq collections.Sequence
+	// Nominations holds the pending voter role nominations
+	Nominations collections.Map[uint64, types.Nomination]
+	// NominationQueue indexes nominations by (expires at, nomination id)
+	NominationQueue collections.KeySet[collections.Pair[int64, uint64]]
+
+	RoleDistributionSeq collections.Sequence
+	// RoleDistributions holds the Merkle roots of voter roles published by governance
+	RoleDistributions collections.Map[uint64, types.RoleDistribution]
+	// RoleClaims holds the (distribution id, address) roles claimed from role distributions
+	RoleClaims collections.KeySet[collections.Pair[uint64, string]]
 }
 
 func NewKeeper(
@@ -37,6 +103,10 @@ func NewKeeper(
 	cdc codec.Codec,
 	addressCodec address.Codec,
 	authority []byte,
+	stakingKeeper types.StakingKeeper,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistrKeeper,
+	router baseapp.MessageRouter,
 	ibcKeeperFn func() *ibckeeper.Keeper,
 
 ) Keeper {
@@ -52,12 +122,53 @@ func NewKeeper(
 		addressCodec: addressCodec,
 		authority:    authority,
 
-		ibcKeeperFn:          ibcKeeperFn,
-		Port:                 collections.NewItem(sb, types.PortKey, "port", collections.StringValue),
-		Params:               collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		VoterRole:            collections.NewMap(sb, types.VoterRoleKey, "voterRole", collections.Uint64Key, codec.CollValue[types.VoterRole](cdc)),
-		VoterRoleSeq:         collections.NewSequence(sb, types.VoterRoleCountKey, "voterRoleSequence"),
+		stakingKeeper: stakingKeeper,
+		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
+		router:        router,
+		govKeeper:     &govKeeperRef{},
+
+		ibcKeeperFn: ibcKeeperFn,
+		Port:        collections.NewItem(sb, types.PortKey, "port", collections.StringValue),
+		Params:      collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		VoterRole: collections.NewIndexedMap(sb, types.VoterRoleKey, "voterRole", collections.Uint64Key, codec.CollValue[types.VoterRole](cdc),
+			newVoterRoleIndexes(sb)),
+		VoterRoleSeq: collections.NewSequence(sb, types.VoterRoleCountKey, "voterRoleSequence"),
+		SuspensionQueue: collections.NewKeySet(sb, types.SuspensionQueueKey, "suspensionQueue",
+			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		RoleExpiryQueue: collections.NewKeySet(sb, types.RoleExpiryQueueKey, "roleExpiryQueue",
+			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
 		LastRoleCreationTime: collections.NewItem(sb, collections.NewPrefix([]byte("last_role_creation")), "lastRoleCreation", collections.Int64Value),
+		VoteDelegations:      collections.NewMap(sb, types.VoteDelegationKey, "voteDelegations", collections.StringKey, codec.CollValue[types.VoteDelegation](cdc)),
+		VoteDelegationsByRepresentative: collections.NewKeySet(sb, types.VoteDelegationByRepresentativeKey, "voteDelegationsByRepresentative",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		ChamberTallies: collections.NewMap(sb, types.ChamberTallyKey, "chamberTallies", collections.Uint64Key, codec.CollValue[types.ChamberTally](cdc)),
+		Timelocks:      collections.NewMap(sb, types.TimelockKey, "timelocks", collections.Uint64Key, codec.CollValue[types.Timelock](cdc)),
+		TimelockQueue: collections.NewKeySet(sb, types.TimelockQueueKey, "timelockQueue",
+			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		TimelockProposal: collections.NewItem(sb, types.TimelockProposalKey, "timelockProposal", collections.Uint64Value),
+		ProposalRoleTallies: collections.NewMap(sb, types.ProposalRoleTallyKey, "proposalRoleTallies", collections.Uint64Key,
+			codec.CollValue[types.ProposalRoleTally](cdc)),
+		PendingRoleTallies: collections.NewMap(sb, types.PendingRoleTallyKey, "pendingRoleTallies", collections.Uint64Key,
+			codec.CollValue[types.ProposalRoleTally](cdc)),
+		StakeRecords: collections.NewMap(sb, types.StakeRecordKey, "stakeRecords", collections.StringKey,
+			codec.CollValue[types.StakeRecord](cdc)),
+		CommunityRoleCandidates: collections.NewKeySet(sb, types.CommunityRoleCandidateKey, "communityRoleCandidates", collections.StringKey),
+		CommunityRoleCursor:     collections.NewItem(sb, types.CommunityRoleCursorKey, "communityRoleCursor", collections.StringValue),
+		Participation: collections.NewMap(sb, types.ParticipationKey, "participation", collections.StringKey,
+			codec.CollValue[types.Participation](cdc)),
+		ProposalVoters: collections.NewKeySet(sb, types.ProposalVoterKey, "proposalVoters",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+		NominationSeq: collections.NewSequence(sb, types.NominationCountKey, "nominationSequence"),
+		Nominations: collections.NewMap(sb, types.NominationKey, "nominations", collections.Uint64Key,
+			codec.CollValue[types.Nomination](cdc)),
+		NominationQueue: collections.NewKeySet(sb, types.NominationQueueKey, "nominationQueue",
+			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		RoleDistributionSeq: collections.NewSequence(sb, types.RoleDistributionCountKey, "roleDistributionSequence"),
+		RoleDistributions: collections.NewMap(sb, types.RoleDistributionKey, "roleDistributions", collections.Uint64Key,
+			codec.CollValue[types.RoleDistribution](cdc)),
+		RoleClaims: collections.NewKeySet(sb, types.RoleClaimKey, "roleClaims",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -68,7 +179,81 @@ func NewKeeper(
 	return k
 }
 
+// VoterRoleIndexes indexes the voter roles by address.
+type VoterRoleIndexes struct {
+	Address *indexes.Multi[string, uint64, types.VoterRole]
+}
+
+func (i VoterRoleIndexes) IndexesList() []collections.Index[uint64, types.VoterRole] {
+	return []collections.Index[uint64, types.VoterRole]{i.Address}
+}
+
+func newVoterRoleIndexes(sb *collections.SchemaBuilder) VoterRoleIndexes {
+	return VoterRoleIndexes{
+		Address: indexes.NewMulti(sb, types.VoterRoleByAddressKey, "voterRoleByAddress", collections.StringKey, collections.Uint64Key,
+			func(_ uint64, role types.VoterRole) (string, error) {
+				return role.Address, nil
+			}),
+	}
+}
+
 // GetAuthority returns the module's authority.
 func (k Keeper) GetAuthority() []byte {
 	return k.authority
 }
+
+// govKeeperRef is shared by every copy of the keeper so the gov keeper can be set late.
+type govKeeperRef struct {
+	types.GovKeeper
+}
+
+// SetGovKeeper wires the gov keeper once it has been built.
+func (k Keeper) SetGovKeeper(gk types.GovKeeper) {
+	k.govKeeper.GovKeeper = gk
+}
+
+// getProposal loads a proposal from x/gov.
+func (k Keeper) getProposal(ctx context.Context, proposalID uint64) (v1.Proposal, error) {
+	gk, err := k.gov()
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	return gk.GetProposal(ctx, proposalID)
+}
+
+// getVotes loads the votes of a proposal from x/gov.
+func (k Keeper) getVotes(ctx context.Context, proposalID uint64) ([]v1.Vote, error) {
+	gk, err := k.gov()
+	if err != nil {
+		return nil, err
+	}
+
+	return gk.GetVotes(ctx, proposalID)
+}
+
+func (k Keeper) getActiveProposals(ctx context.Context) ([]v1.Proposal, error) {
+	gk, err := k.gov()
+	if err != nil {
+		return nil, err
+	}
+
+	return gk.GetActiveProposals(ctx)
+}
+
+func (k Keeper) getGovParams(ctx context.Context) (v1.Params, error) {
+	gk, err := k.gov()
+	if err != nil {
+		return v1.Params{}, err
+	}
+
+	return gk.GetParams(ctx)
+}
+
+func (k Keeper) gov() (types.GovKeeper, error) {
+	if k.govKeeper == nil || k.govKeeper.GovKeeper == nil {
+		return nil, fmt.Errorf("gov keeper not set")
+	}
+
+	return k.govKeeper.GovKeeper, nil
+}
@@ -2,19 +2,28 @@ package keeper_test
 
 import (
 	"context"
+	"sort"
 	"testing"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 	ibctypes "github.com/cosmos/ibc-go/v10/modules/core/types"
@@ -25,9 +34,13 @@ import (
 )
 
 type fixture struct {
-	ctx          context.Context
-	keeper       keeper.Keeper
-	addressCodec address.Codec
+	ctx           context.Context
+	keeper        keeper.Keeper
+	addressCodec  address.Codec
+	stakingKeeper *mockStakingKeeper
+	bankKeeper    *mockBankKeeper
+	govKeeper     *mockGovKeeper
+	router        *mockRouter
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -42,26 +55,78 @@ func initFixture(t *testing.T) *fixture {
 
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
 	mockUpgradeKeeper := newMockUpgradeKeeper()
+	stakingKeeper := newMockStakingKeeper()
+	bankKeeper := newMockBankKeeper()
+	router := newMockRouter()
 
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
+		stakingKeeper,
+		bankKeeper,
+		&mockDistrKeeper{bankKeeper: bankKeeper},
+		router,
 		func() *ibckeeper.Keeper {
 			return ibckeeper.NewKeeper(encCfg.Codec, storeService, newMockParams(), mockUpgradeKeeper, authority.String())
 		},
 	)
 
+	govKeeper := newMockGovKeeper()
+	k.SetGovKeeper(govKeeper)
+
 	// Initialize params
 	if err := k.Params.Set(ctx, types.DefaultParams()); err != nil {
 		t.Fatalf("failed to set params: %v", err)
 	}
 
 	return &fixture{
-		ctx:          ctx,
-		keeper:       k,
-		addressCodec: addressCodec,
+		ctx:           ctx,
+		keeper:        k,
+		addressCodec:  addressCodec,
+		stakingKeeper: stakingKeeper,
+		bankKeeper:    bankKeeper,
+		govKeeper:     govKeeper,
+		router:        router,
+	}
+}
+
+// mockRouter routes messages to handlers registered by type URL and records the
+// messages it executed.
+type mockRouter struct {
+	handlers map[string]baseapp.MsgServiceHandler
+	executed []sdk.Msg
+}
+
+func newMockRouter() *mockRouter {
+	return &mockRouter{handlers: make(map[string]baseapp.MsgServiceHandler)}
+}
+
+// Register routes msgs of the given type URL to handler, or to a no-op handler when
+// handler is nil.
+func (m *mockRouter) Register(typeURL string, handler baseapp.MsgServiceHandler) {
+	if handler == nil {
+		handler = func(sdk.Context, sdk.Msg) (*sdk.Result, error) { return &sdk.Result{}, nil }
+	}
+	m.handlers[typeURL] = handler
+}
+
+func (m *mockRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	return m.HandlerByTypeURL(sdk.MsgTypeURL(msg))
+}
+
+func (m *mockRouter) HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler {
+	handler, ok := m.handlers[typeURL]
+	if !ok {
+		return nil
+	}
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		res, err := handler(ctx, msg)
+		if err == nil {
+			m.executed = append(m.executed, msg)
+		}
+		return res, err
 	}
 }
 
@@ -91,3 +156,222 @@ func newMockParams() *mockParams {
 
 func (mockParams) GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet) {
 }
+
+// mockBankKeeper holds balances keyed by address bytes, module accounts included.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+	burned   sdk.Coins
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+// moduleBalance returns the balance of a module account.
+func (m *mockBankKeeper) moduleBalance(module string) sdk.Coins {
+	return m.balances[string(authtypes.NewModuleAddress(module))]
+}
+
+func (m *mockBankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, negative := m.balances[string(from)].SafeSub(amt...)
+	if negative {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	m.balances[string(from)] = balance
+	m.balances[string(to)] = m.balances[string(to)].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.balances[string(addr)]
+}
+
+func (m *mockBankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.balances[string(addr)]
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, sender sdk.AccAddress, module string, amt sdk.Coins) error {
+	return m.send(sender, authtypes.NewModuleAddress(module), amt)
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, module string, recipient sdk.AccAddress, amt sdk.Coins) error {
+	return m.send(authtypes.NewModuleAddress(module), recipient, amt)
+}
+
+func (m *mockBankKeeper) BurnCoins(_ context.Context, module string, amt sdk.Coins) error {
+	if err := m.send(authtypes.NewModuleAddress(module), authtypes.NewModuleAddress("burned"), amt); err != nil {
+		return err
+	}
+	m.burned = m.burned.Add(amt...)
+	return nil
+}
+
+// mockDistrKeeper moves the funds of the community pool to the distribution module account.
+type mockDistrKeeper struct {
+	bankKeeper *mockBankKeeper
+}
+
+func (m *mockDistrKeeper) FundCommunityPool(_ context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	return m.bankKeeper.send(sender, authtypes.NewModuleAddress(distrtypes.ModuleName), amount)
+}
+
+// mockStakingKeeper holds delegations keyed by delegator address bytes.
+type mockStakingKeeper struct {
+	valAddressCodec address.Codec
+	delegations     map[string][]stakingtypes.Delegation
+	validators      map[string]stakingtypes.Validator
+}
+
+func newMockStakingKeeper() *mockStakingKeeper {
+	return &mockStakingKeeper{
+		valAddressCodec: addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
+		delegations:     make(map[string][]stakingtypes.Delegation),
+		validators:      make(map[string]stakingtypes.Validator),
+	}
+}
+
+func (m *mockStakingKeeper) ValidatorAddressCodec() address.Codec {
+	return m.valAddressCodec
+}
+
+func (m *mockStakingKeeper) IterateDelegations(_ context.Context, delegator sdk.AccAddress, fn func(index int64, delegation stakingtypes.DelegationI) (stop bool)) error {
+	for i, delegation := range m.delegations[string(delegator)] {
+		if fn(int64(i), delegation) {
+			break
+		}
+	}
+	return nil
+}
+
+func (m *mockStakingKeeper) GetValidator(_ context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+	validator, ok := m.validators[string(addr)]
+	if !ok {
+		return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+	}
+	return validator, nil
+}
+
+func (m *mockStakingKeeper) IterateBondedValidatorsByPower(_ context.Context, fn func(index int64, validator stakingtypes.ValidatorI) (stop bool)) error {
+	var i int64
+	for _, validator := range m.validators {
+		if !validator.IsBonded() {
+			continue
+		}
+		if fn(i, validator) {
+			break
+		}
+		i++
+	}
+	return nil
+}
+
+// IterateAllDelegations iterates the delegations ordered by delegator.
+func (m *mockStakingKeeper) IterateAllDelegations(_ context.Context, cb func(delegation stakingtypes.Delegation) (stop bool)) error {
+	delegators := make([]string, 0, len(m.delegations))
+	for delegator := range m.delegations {
+		delegators = append(delegators, delegator)
+	}
+	sort.Strings(delegators)
+
+	for _, delegator := range delegators {
+		for _, delegation := range m.delegations[delegator] {
+			if cb(delegation) {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// GetValidatorDelegations returns the delegations to a validator ordered by delegator.
+func (m *mockStakingKeeper) GetValidatorDelegations(ctx context.Context, valAddr sdk.ValAddress) ([]stakingtypes.Delegation, error) {
+	operator, err := m.valAddressCodec.BytesToString(valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	var delegations []stakingtypes.Delegation
+	err = m.IterateAllDelegations(ctx, func(delegation stakingtypes.Delegation) (stop bool) {
+		if delegation.ValidatorAddress == operator {
+			delegations = append(delegations, delegation)
+		}
+		return false
+	})
+	return delegations, err
+}
+
+// SetValidator records a bonded validator with equal tokens and shares.
+func (m *mockStakingKeeper) SetValidator(addr sdk.ValAddress, tokens int64) string {
+	operator, _ := m.valAddressCodec.BytesToString(addr)
+	m.validators[string(addr)] = stakingtypes.Validator{
+		OperatorAddress: operator,
+		Status:          stakingtypes.Bonded,
+		Tokens:          math.NewInt(tokens),
+		DelegatorShares: math.LegacyNewDec(tokens),
+	}
+	return operator
+}
+
+// Delegate records a delegation of shares from delegator to validator.
+func (m *mockStakingKeeper) Delegate(delegator sdk.AccAddress, validator string, shares int64) {
+	m.delegations[string(delegator)] = append(m.delegations[string(delegator)], stakingtypes.Delegation{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: validator,
+		Shares:           math.LegacyNewDec(shares),
+	})
+}
+
+// mockGovKeeper holds proposals and their votes keyed by proposal id.
+type mockGovKeeper struct {
+	proposals map[uint64]v1.Proposal
+	votes     map[uint64][]v1.Vote
+}
+
+func newMockGovKeeper() *mockGovKeeper {
+	return &mockGovKeeper{
+		proposals: make(map[uint64]v1.Proposal),
+		votes:     make(map[uint64][]v1.Vote),
+	}
+}
+
+func (m *mockGovKeeper) GetVotes(_ context.Context, proposalID uint64) ([]v1.Vote, error) {
+	return m.votes[proposalID], nil
+}
+
+// Vote records a vote on a proposal.
+func (m *mockGovKeeper) Vote(proposalID uint64, vote v1.Vote) {
+	m.votes[proposalID] = append(m.votes[proposalID], vote)
+}
+
+func (m *mockGovKeeper) GetActiveProposals(_ context.Context) ([]v1.Proposal, error) {
+	var proposals []v1.Proposal
+	for _, proposal := range m.proposals {
+		if proposal.Status == v1.StatusVotingPeriod {
+			proposals = append(proposals, proposal)
+		}
+	}
+	sort.Slice(proposals, func(i, j int) bool { return proposals[i].Id < proposals[j].Id })
+	return proposals, nil
+}
+
+func (m *mockGovKeeper) GetParams(_ context.Context) (v1.Params, error) {
+	return v1.DefaultParams(), nil
+}
+
+func (m *mockGovKeeper) GetProposal(_ context.Context, proposalID uint64) (v1.Proposal, error) {
+	proposal, ok := m.proposals[proposalID]
+	if !ok {
+		return v1.Proposal{}, collections.ErrNotFound
+	}
+	return proposal, nil
+}
+
+// SetProposal records a proposal carrying messages of the given type urls.
+func (m *mockGovKeeper) SetProposal(id uint64, msgTypeURLs ...string) v1.Proposal {
+	proposal := v1.Proposal{Id: id, Status: v1.StatusVotingPeriod}
+	for _, typeURL := range msgTypeURLs {
+		proposal.Messages = append(proposal.Messages, &codectypes.Any{TypeUrl: typeURL})
+	}
+	m.proposals[id] = proposal
+	return proposal
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the module state from version 1 to 2. Version 2 adds the params
+// after role_creation_cooldown, which are unset in version 1 state and get their defaults.
+// It also indexes the voter roles by address and checks the community role candidates
+// recorded by the staking hooks, so the roles are written again to build the index and
+// every delegator becomes a candidate.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	params = migrateParams1to2(params)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	var roles []types.VoterRole
+	err = m.keeper.VoterRole.Walk(ctx, nil, func(_ uint64, role types.VoterRole) (bool, error) {
+		roles = append(roles, role)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, role := range roles {
+		if err := m.keeper.VoterRole.Set(ctx, role.Id, role); err != nil {
+			return err
+		}
+	}
+
+	return m.keeper.addAllCommunityRoleCandidates(ctx)
+}
+
+// migrateParams1to2 sets the params added in version 2 that are unset to their defaults.
+// Decimals that are nil or zero where the params require them positive count as unset.
+func migrateParams1to2(params types.Params) types.Params {
+	defaults := types.DefaultParams()
+	unset := func(d math.LegacyDec) bool { return d.IsNil() || d.IsZero() }
+
+	if params.MaxVoteDelegationDepth == 0 {
+		params.MaxVoteDelegationDepth = defaults.MaxVoteDelegationDepth
+	}
+	if params.ValidatorMultiplierScope == "" {
+		params.ValidatorMultiplierScope = defaults.ValidatorMultiplierScope
+	}
+	if params.PowerTransform == "" {
+		params.PowerTransform = defaults.PowerTransform
+	}
+	if params.PowerCap.IsNil() {
+		params.PowerCap = defaults.PowerCap
+	}
+	if params.TallyMode == "" {
+		params.TallyMode = defaults.TallyMode
+	}
+	if params.TokenHouse.Quorum.IsNil() || unset(params.TokenHouse.Threshold) {
+		params.TokenHouse = defaults.TokenHouse
+	}
+	if params.RoleHouse.Quorum.IsNil() || unset(params.RoleHouse.Threshold) {
+		params.RoleHouse = defaults.RoleHouse
+	}
+	if unset(params.VetoThreshold) {
+		params.VetoThreshold = defaults.VetoThreshold
+	}
+	if params.RecusalMode == "" {
+		params.RecusalMode = defaults.RecusalMode
+	}
+	if params.RegistryTally.Quorum.IsNil() || unset(params.RegistryTally.Threshold) || unset(params.RegistryTally.VetoThreshold) {
+		params.RegistryTally = defaults.RegistryTally
+	}
+	if params.CommunityRole.EpochIdentifier == "" || params.CommunityRole.MinStake.IsNil() {
+		params.CommunityRole = defaults.CommunityRole
+	}
+	if params.Participation.Window == 0 || params.Participation.MinRate.IsNil() || unset(params.Participation.DowngradeMultiplier) {
+		params.Participation = defaults.Participation
+	}
+	if params.Nomination.Expiry == 0 {
+		params.Nomination = defaults.Nomination
+	}
+	if params.RoleBond.StakeDenom == "" || params.RoleBond.SlashFraction.IsNil() {
+		params.RoleBond = defaults.RoleBond
+	}
+
+	return params
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+
+	"cosmos-weighted-governance-sdk/x/voting/keeper"
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	alice := setRoleHolder(t, f, 0, "alice", "core_contributor", "2.0")
+	valAddr := f.stakingKeeper.SetValidator(sdk.ValAddress(testAccAddress("validator")), 1000)
+	f.stakingKeeper.Delegate(testAccAddress("bob"), valAddr, 100)
+
+	// version 1 params only have the max roles per address and the cooldown
+	require.NoError(t, f.keeper.Params.Set(ctx, types.Params{MaxVoterRolesPerAddress: 2, RoleCreationCooldown: 60}))
+
+	// version 1 state has no address index
+	role, err := f.keeper.VoterRole.Get(ctx, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.VoterRole.Indexes.Address.Unreference(ctx, 0, func() (types.VoterRole, error) { return role, nil }))
+	require.False(t, f.keeper.HasVoterRole(ctx, alice))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	expected := types.DefaultParams()
+	expected.MaxVoterRolesPerAddress = 2
+	expected.RoleCreationCooldown = 60
+	require.Equal(t, expected, params)
+
+	found, err := f.keeper.GetVoterRoleByAddress(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, role, *found)
+
+	// delegators made before the staking hooks recorded them are candidates
+	bob, err := f.addressCodec.BytesToString(testAccAddress("bob"))
+	require.NoError(t, err)
+	has, err := f.keeper.CommunityRoleCandidates.Has(ctx, bob)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// registry proposals are tallied with the migrated params
+	_, validators := tallyValidators(t, f)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	proposal := setMsgsProposal(t, f, 1, &types.MsgCreateVoterRole{Creator: authority, Address: bob, Role: "community_member", Multiplier: "1.0"})
+	_, results, err := f.keeper.TallyVotes(ctx, proposal, []v1.Vote{newVote(alice, v1.OptionYes)}, copyValidators(validators))
+	require.NoError(t, err)
+	require.True(t, results[v1.OptionYes].IsZero())
+
+	// a value already set is kept
+	params.PowerTransform = types.PowerTransformSqrt
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+	params, err = f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.PowerTransformSqrt, params.PowerTransform)
+}
@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) NominateVoterRole(ctx context.Context, msg *types.MsgNominateVoterRole) (*types.MsgNominateVoterRoleResponse, error) {
+	nominator, err := k.addressCodec.StringToBytes(msg.Nominator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid nominator address: %s", err))
+	}
+
+	if _, err := k.addressCodec.StringToBytes(msg.Address); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	if err := validateRole(msg.Role); err != nil {
+		return nil, err
+	}
+	if msg.Justification == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "justification cannot be empty")
+	}
+
+	if k.HasVoterRole(ctx, msg.Address) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("address %s already has a voter role", msg.Address))
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get module params")
+	}
+
+	if !msg.Deposit.IsValid() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Deposit.String())
+	}
+	if !msg.Deposit.IsAllGTE(params.Nomination.MinDeposit) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientDeposit, "got %s, expected %s", msg.Deposit, params.Nomination.MinDeposit)
+	}
+
+	// a bonded role is applied for by its future holder, who posts the bond with the deposit
+	bond := params.RoleBond.Required(msg.Role)
+	if !bond.IsZero() && msg.Nominator != msg.Address {
+		return nil, errorsmod.Wrapf(types.ErrRoleBondRequired, "role %s requires a bond and can only be nominated by the nominee", msg.Role)
+	}
+
+	if escrow := msg.Deposit.Add(bond...); !escrow.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, nominator, types.ModuleName, escrow); err != nil {
+			return nil, err
+		}
+	}
+
+	id, err := k.NominationSeq.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get next id")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
+	nomination := types.Nomination{
+		Id:            id,
+		Nominator:     msg.Nominator,
+		Address:       msg.Address,
+		Role:          msg.Role,
+		Justification: msg.Justification,
+		Deposit:       msg.Deposit,
+		SubmittedAt:   now,
+		ExpiresAt:     now + int64(params.Nomination.Expiry),
+		Bond:          bond,
+	}
+	if err := k.SetNomination(ctx, nomination); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set nomination")
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoterRoleNominated,
+			sdk.NewAttribute(types.AttributeKeyNominationID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyNominator, msg.Nominator),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Role),
+			sdk.NewAttribute(types.AttributeKeyDeposit, msg.Deposit.String()),
+			sdk.NewAttribute(types.AttributeKeyBond, bond.String()),
+		),
+	)
+
+	return &types.MsgNominateVoterRoleResponse{Id: id}, nil
+}
+
+func (k msgServer) ApproveNomination(ctx context.Context, msg *types.MsgApproveNomination) (*types.MsgApproveNominationResponse, error) {
+	nomination, err := k.getNomination(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// the role is created with the checks and the scope of MsgCreateVoterRole, and keeps
+	// the bond of the nomination
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	res, err := k.createVoterRole(ctx, &types.MsgCreateVoterRole{
+		Creator:    msg.Authority,
+		Address:    nomination.Address,
+		Role:       nomination.Role,
+		Multiplier: msg.Multiplier,
+		AddedAt:    sdkCtx.BlockTime().Unix(),
+		AddedBy:    nomination.Nominator,
+	}, nomination.Bond)
+	if err != nil {
+		return nil, err
+	}
+
+	nomination.Bond = nil
+	if err := k.settleNomination(ctx, nomination, false); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to refund nomination deposit")
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNominationApproved,
+			sdk.NewAttribute(types.AttributeKeyNominationID, fmt.Sprintf("%d", msg.Id)),
+			sdk.NewAttribute(types.AttributeKeyRoleID, fmt.Sprintf("%d", res.Id)),
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+		),
+	)
+
+	return &types.MsgApproveNominationResponse{VoterRoleId: res.Id}, nil
+}
+
+func (k msgServer) RejectNomination(ctx context.Context, msg *types.MsgRejectNomination) (*types.MsgRejectNominationResponse, error) {
+	authority, err := k.roleAuthority(ctx, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	nomination, err := k.getNomination(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// rejecting takes the same scope as approving
+	if err := authorizeRoleChange(authority, types.RoleOperationCreate, &types.VoterRole{Role: nomination.Role}, nil); err != nil {
+		return nil, err
+	}
+
+	if err := k.settleNomination(ctx, nomination, msg.BurnDeposit); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to settle nomination deposit")
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNominationRejected,
+			sdk.NewAttribute(types.AttributeKeyNominationID, fmt.Sprintf("%d", msg.Id)),
+			sdk.NewAttribute(types.AttributeKeyBurned, fmt.Sprintf("%t", msg.BurnDeposit)),
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+		),
+	)
+
+	return &types.MsgRejectNominationResponse{}, nil
+}
+
+// getNomination returns a pending nomination.
+func (k msgServer) getNomination(ctx context.Context, id uint64) (types.Nomination, error) {
+	nomination, err := k.Nominations.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Nomination{}, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("nomination %d doesn't exist", id))
+		}
+
+		return types.Nomination{}, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get nomination")
+	}
+
+	return nomination, nil
+}
@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) CreateRoleDistribution(ctx context.Context, msg *types.MsgCreateRoleDistribution) (*types.MsgCreateRoleDistributionResponse, error) {
+	authority, err := k.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	// the roles of a distribution cannot be checked against the scope of a role authority
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, msg.Authority)
+	}
+
+	if len(msg.MerkleRoot) != types.MerkleHashSize {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "merkle root must be %d bytes, got %d", types.MerkleHashSize, len(msg.MerkleRoot))
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if msg.ClaimEnd <= msg.ClaimStart || msg.ClaimEnd <= sdkCtx.BlockTime().Unix() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid claim window [%d, %d)", msg.ClaimStart, msg.ClaimEnd)
+	}
+
+	id, err := k.RoleDistributionSeq.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get next id")
+	}
+
+	distribution := types.RoleDistribution{
+		Id:         id,
+		Authority:  msg.Authority,
+		MerkleRoot: msg.MerkleRoot,
+		ClaimStart: msg.ClaimStart,
+		ClaimEnd:   msg.ClaimEnd,
+	}
+	if err := k.RoleDistributions.Set(ctx, id, distribution); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set role distribution")
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRoleDistributionCreated,
+			sdk.NewAttribute(types.AttributeKeyDistributionID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyMerkleRoot, hex.EncodeToString(msg.MerkleRoot)),
+			sdk.NewAttribute(types.AttributeKeyClaimStart, fmt.Sprintf("%d", msg.ClaimStart)),
+			sdk.NewAttribute(types.AttributeKeyClaimEnd, fmt.Sprintf("%d", msg.ClaimEnd)),
+		),
+	)
+
+	return &types.MsgCreateRoleDistributionResponse{Id: id}, nil
+}
+
+func (k msgServer) ClaimVoterRole(ctx context.Context, msg *types.MsgClaimVoterRole) (*types.MsgClaimVoterRoleResponse, error) {
+	claimant, err := k.addressCodec.StringToBytes(msg.Claimant)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid claimant address: %s", err))
+	}
+
+	distribution, err := k.RoleDistributions.Get(ctx, msg.DistributionId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("role distribution %d doesn't exist", msg.DistributionId))
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get role distribution")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
+	if now < distribution.ClaimStart || now >= distribution.ClaimEnd {
+		return nil, errorsmod.Wrapf(types.ErrClaimWindowClosed, "roles of distribution %d can be claimed in [%d, %d)",
+			distribution.Id, distribution.ClaimStart, distribution.ClaimEnd)
+	}
+
+	claimKey := collections.Join(distribution.Id, msg.Claimant)
+	claimed, err := k.RoleClaims.Has(ctx, claimKey)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get role claim")
+	}
+	if claimed {
+		return nil, errorsmod.Wrapf(types.ErrRoleAlreadyClaimed, "%s already claimed its role from distribution %d", msg.Claimant, distribution.Id)
+	}
+
+	// the leaf binds the role to the claimant, so a proof cannot be replayed by another address
+	leaf := types.RoleLeafHash(msg.Claimant, msg.Role, msg.Multiplier, msg.ExpiresAt)
+	if !types.VerifyRoleMerkleProof(distribution.MerkleRoot, leaf, msg.Proof) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMerkleProof, "no %s role for %s in distribution %d", msg.Role, msg.Claimant, distribution.Id)
+	}
+
+	if msg.ExpiresAt != 0 && msg.ExpiresAt <= now {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "role expired at %d", msg.ExpiresAt)
+	}
+
+	if err := k.ValidateVoterRole(msg.Claimant, msg.Role, msg.Multiplier); err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get module params")
+	}
+
+	// a role listed by governance replaces an automatically assigned one
+	if err := k.replaceAutoRole(ctx, msg.Claimant); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to replace auto assigned voterRole")
+	}
+
+	if k.HasVoterRole(ctx, msg.Claimant) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("address %s already has a voter role", msg.Claimant))
+	}
+	if k.CountRolesForAddress(ctx, msg.Claimant) >= params.MaxVoterRolesPerAddress {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"address %s already has maximum number of roles (%d)",
+			msg.Claimant, params.MaxVoterRolesPerAddress)
+	}
+
+	// the claimant of a bonded role posts the bond with the claim
+	bond := params.RoleBond.Required(msg.Role)
+	if !bond.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, claimant, types.ModuleName, bond); err != nil {
+			return nil, errorsmod.Wrapf(err, "role %s requires a bond of %s", msg.Role, bond)
+		}
+	}
+
+	id, err := k.VoterRoleSeq.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get next id")
+	}
+
+	voterRole := types.VoterRole{
+		Id:         id,
+		Creator:    distribution.Authority,
+		Address:    msg.Claimant,
+		Role:       msg.Role,
+		Multiplier: msg.Multiplier,
+		AddedAt:    now,
+		AddedBy:    distribution.Authority,
+		Bond:       bond,
+		ExpiresAt:  msg.ExpiresAt,
+	}
+	if err := k.SetVoterRole(ctx, voterRole); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set voterRole")
+	}
+
+	if err := k.RoleClaims.Set(ctx, claimKey); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set role claim")
+	}
+	distribution.Claimed++
+	if err := k.RoleDistributions.Set(ctx, distribution.Id, distribution); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set role distribution")
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoterRoleClaimed,
+			sdk.NewAttribute(types.AttributeKeyRoleID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyDistributionID, fmt.Sprintf("%d", distribution.Id)),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Claimant),
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Role),
+			sdk.NewAttribute(types.AttributeKeyMultiplier, msg.Multiplier),
+			sdk.NewAttribute(types.AttributeKeyExpiresAt, fmt.Sprintf("%d", msg.ExpiresAt)),
+			sdk.NewAttribute(types.AttributeKeyBond, bond.String()),
+		),
+	)
+
+	return &types.MsgClaimVoterRoleResponse{VoterRoleId: id}, nil
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) SuspendVoterRole(ctx context.Context, msg *types.MsgSuspendVoterRole) (*types.MsgSuspendVoterRoleResponse, error) {
+	role, err := k.authorityVoterRole(ctx, msg.Authority, types.RoleOperationSuspend, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if role.IsSuspended() {
+		return nil, errorsmod.Wrapf(types.ErrRoleSuspended, "voter role %d", msg.Id)
+	}
+
+	if msg.Reason == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "suspension reason cannot be empty")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
+	if msg.ReinstateAt < 0 || (msg.ReinstateAt > 0 && msg.ReinstateAt <= now) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "reinstate time %d is not in the future", msg.ReinstateAt)
+	}
+
+	role.Suspension = &types.RoleSuspension{
+		Reason:      msg.Reason,
+		SuspendedAt: now,
+		ReinstateAt: msg.ReinstateAt,
+	}
+	if err := k.SetVoterRole(ctx, role); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to suspend voterRole")
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoterRoleSuspended,
+			sdk.NewAttribute(types.AttributeKeyRoleID, fmt.Sprintf("%d", msg.Id)),
+			sdk.NewAttribute(types.AttributeKeyAddress, role.Address),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+			sdk.NewAttribute(types.AttributeKeyReinstateAt, fmt.Sprintf("%d", msg.ReinstateAt)),
+			sdk.NewAttribute(types.AttributeKeySuspendedBy, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+		),
+	)
+
+	return &types.MsgSuspendVoterRoleResponse{}, nil
+}
+
+func (k msgServer) ReinstateVoterRole(ctx context.Context, msg *types.MsgReinstateVoterRole) (*types.MsgReinstateVoterRoleResponse, error) {
+	role, err := k.authorityVoterRole(ctx, msg.Authority, types.RoleOperationReinstate, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !role.IsSuspended() {
+		return nil, errorsmod.Wrapf(types.ErrRoleNotSuspended, "voter role %d", msg.Id)
+	}
+
+	if err := k.Keeper.ReinstateVoterRole(ctx, role, msg.Authority); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to reinstate voterRole")
+	}
+
+	return &types.MsgReinstateVoterRoleResponse{}, nil
+}
+
+// authorityVoterRole checks that the signer is the module authority, or a role authority
+// allowed the operation on the voter role, and returns the voter role.
+func (k msgServer) authorityVoterRole(ctx context.Context, signer, operation string, id uint64) (types.VoterRole, error) {
+	authority, err := k.roleAuthority(ctx, signer)
+	if err != nil {
+		return types.VoterRole{}, err
+	}
+
+	role, err := k.VoterRole.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.VoterRole{}, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", id))
+		}
+
+		return types.VoterRole{}, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get voterRole")
+	}
+
+	if err := authorizeRoleChange(authority, operation, &role, nil); err != nil {
+		return types.VoterRole{}, err
+	}
+
+	return role, nil
+}
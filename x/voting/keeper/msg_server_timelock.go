@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) TimelockMessages(ctx context.Context, msg *types.MsgTimelockMessages) (*types.MsgTimelockMessagesResponse, error) {
+	authority, err := k.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, msg.Authority)
+	}
+
+	held, err := msg.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+	if err := k.validateHeldMessages(held); err != nil {
+		return nil, err
+	}
+
+	if err := k.HoldProposalMessages(ctx, msg.Messages); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTimelockMessagesResponse{}, nil
+}
+
+func (k msgServer) VetoProposal(ctx context.Context, msg *types.MsgVetoProposal) (*types.MsgVetoProposalResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Voter); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid voter address: %s", err))
+	}
+
+	vetoHolder, err := k.IsVetoHolder(ctx, msg.Voter)
+	if err != nil {
+		return nil, err
+	}
+	if !vetoHolder {
+		return nil, errorsmod.Wrapf(types.ErrNotVetoHolder, "voter %s", msg.Voter)
+	}
+
+	timelock, err := k.Timelocks.Get(ctx, msg.ProposalId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrTimelockNotFound, "proposal %d", msg.ProposalId)
+		}
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.BlockTime().Unix() >= timelock.ExecuteAfter {
+		return nil, errorsmod.Wrapf(types.ErrVetoWindowClosed, "proposal %d", msg.ProposalId)
+	}
+
+	if slices.Contains(timelock.Vetoes, msg.Voter) {
+		return nil, errorsmod.Wrapf(types.ErrAlreadyVetoed, "proposal %d by %s", msg.ProposalId, msg.Voter)
+	}
+	timelock.Vetoes = append(timelock.Vetoes, msg.Voter)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get module params")
+	}
+
+	vetoed, err := k.IsVetoed(ctx, timelock, params.VetoThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	if vetoed {
+		err = k.RemoveTimelock(ctx, timelock)
+	} else {
+		err = k.Timelocks.Set(ctx, timelock.ProposalId, timelock)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update timelock")
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalVetoCast,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", msg.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeyVoter, msg.Voter),
+		),
+	)
+	if vetoed {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProposalVetoed,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", msg.ProposalId)),
+			),
+		)
+	}
+
+	return &types.MsgVetoProposalResponse{Vetoed: vetoed}, nil
+}
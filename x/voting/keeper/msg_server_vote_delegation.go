@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) DelegateVote(ctx context.Context, msg *types.MsgDelegateVote) (*types.MsgDelegateVoteResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Delegator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid delegator address: %s", err))
+	}
+
+	if _, err := k.addressCodec.StringToBytes(msg.Representative); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid representative address: %s", err))
+	}
+
+	if msg.Delegator == msg.Representative {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot delegate vote to self")
+	}
+
+	// liquid democracy only works between role holders
+	if !k.HasVoterRole(ctx, msg.Delegator) {
+		return nil, errorsmod.Wrapf(types.ErrNoVoterRole, "delegator %s", msg.Delegator)
+	}
+	if !k.HasVoterRole(ctx, msg.Representative) {
+		return nil, errorsmod.Wrapf(types.ErrNoVoterRole, "representative %s", msg.Representative)
+	}
+
+	seen := make(map[string]bool, len(msg.MsgTypeUrls))
+	for _, typeURL := range msg.MsgTypeUrls {
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid msg type url: %q", typeURL)
+		}
+		if seen[typeURL] {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate msg type url: %s", typeURL)
+		}
+		seen[typeURL] = true
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get module params")
+	}
+
+	if err := k.ValidateVoteDelegation(ctx, msg.Delegator, msg.Representative, params.MaxVoteDelegationDepth); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	delegation := types.VoteDelegation{
+		Delegator:      msg.Delegator,
+		Representative: msg.Representative,
+		MsgTypeUrls:    msg.MsgTypeUrls,
+		CreatedAt:      sdkCtx.BlockTime().Unix(),
+	}
+
+	if err := k.SetVoteDelegation(ctx, delegation); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set vote delegation")
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoteDelegated,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator),
+			sdk.NewAttribute(types.AttributeKeyRepresentative, msg.Representative),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURLs, strings.Join(msg.MsgTypeUrls, ",")),
+		),
+	)
+
+	return &types.MsgDelegateVoteResponse{}, nil
+}
+
+func (k msgServer) RevokeVoteDelegation(ctx context.Context, msg *types.MsgRevokeVoteDelegation) (*types.MsgRevokeVoteDelegationResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Delegator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid delegator address: %s", err))
+	}
+
+	delegation, err := k.RemoveVoteDelegation(ctx, msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoteDelegationRevoked,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator),
+			sdk.NewAttribute(types.AttributeKeyRepresentative, delegation.Representative),
+		),
+	)
+
+	return &types.MsgRevokeVoteDelegationResponse{}, nil
+}
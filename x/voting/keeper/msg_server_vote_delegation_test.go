@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"cosmos-weighted-governance-sdk/x/voting/keeper"
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// setRoleHolder stores a voter role for a test account and returns its address.
+func setRoleHolder(t *testing.T, f *fixture, id uint64, name, role, multiplier string) string {
+	t.Helper()
+
+	address, err := f.addressCodec.BytesToString(testAccAddress(name))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.VoterRole.Set(f.ctx, id, types.VoterRole{
+		Id:         id,
+		Address:    address,
+		Role:       role,
+		Multiplier: multiplier,
+	}))
+
+	return address
+}
+
+func testAccAddress(name string) sdk.AccAddress {
+	addr := make([]byte, 20)
+	copy(addr, name)
+	return addr
+}
+
+func TestVoteDelegationMsgServerDelegate(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	alice := setRoleHolder(t, f, 0, "alice", "core_contributor", "2.0")
+	bob := setRoleHolder(t, f, 1, "bob", "community_member", "1.0")
+	carol := setRoleHolder(t, f, 2, "carol", "community_member", "1.0")
+	dave := setRoleHolder(t, f, 3, "dave", "community_member", "1.0")
+	noRole, err := f.addressCodec.BytesToString(testAccAddress("norole"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.MaxVoteDelegationDepth = 2
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	tests := []struct {
+		desc    string
+		request *types.MsgDelegateVote
+		err     error
+	}{
+		{
+			desc:    "invalid delegator",
+			request: &types.MsgDelegateVote{Delegator: "invalid", Representative: bob},
+			err:     sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc:    "invalid representative",
+			request: &types.MsgDelegateVote{Delegator: alice, Representative: "invalid"},
+			err:     sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc:    "self delegation",
+			request: &types.MsgDelegateVote{Delegator: alice, Representative: alice},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "delegator without role",
+			request: &types.MsgDelegateVote{Delegator: noRole, Representative: bob},
+			err:     types.ErrNoVoterRole,
+		},
+		{
+			desc:    "representative without role",
+			request: &types.MsgDelegateVote{Delegator: alice, Representative: noRole},
+			err:     types.ErrNoVoterRole,
+		},
+		{
+			desc:    "invalid msg type url",
+			request: &types.MsgDelegateVote{Delegator: alice, Representative: bob, MsgTypeUrls: []string{"cosmos.bank.v1beta1.MsgSend"}},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "alice to bob",
+			request: &types.MsgDelegateVote{Delegator: alice, Representative: bob},
+		},
+		{
+			desc:    "cycle",
+			request: &types.MsgDelegateVote{Delegator: bob, Representative: alice},
+			err:     types.ErrVoteDelegationCycle,
+		},
+		{
+			desc:    "bob to carol",
+			request: &types.MsgDelegateVote{Delegator: bob, Representative: carol, MsgTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"}},
+		},
+		{
+			desc:    "indirect cycle",
+			request: &types.MsgDelegateVote{Delegator: carol, Representative: alice},
+			err:     types.ErrVoteDelegationCycle,
+		},
+		{
+			desc:    "too deep",
+			request: &types.MsgDelegateVote{Delegator: dave, Representative: alice},
+			err:     types.ErrVoteDelegationDepth,
+		},
+		{
+			desc:    "redelegate within depth",
+			request: &types.MsgDelegateVote{Delegator: alice, Representative: carol},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.DelegateVote(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// alice's new delegation replaced the old one in the representative index
+	has, err := f.keeper.VoteDelegationsByRepresentative.Has(f.ctx, collections.Join(bob, alice))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.VoteDelegationsByRepresentative.Has(f.ctx, collections.Join(carol, alice))
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+func TestValidateVoteDelegationBounded(t *testing.T) {
+	f := initFixture(t)
+
+	alice := setRoleHolder(t, f, 0, "alice", "core_contributor", "2.0")
+	bob := setRoleHolder(t, f, 1, "bob", "community_member", "1.0")
+	carol := setRoleHolder(t, f, 2, "carol", "community_member", "1.0")
+	dave := setRoleHolder(t, f, 3, "dave", "community_member", "1.0")
+
+	// a cycle stored without validation bounds both walks instead of looping forever
+	require.NoError(t, f.keeper.SetVoteDelegation(f.ctx, types.VoteDelegation{Delegator: bob, Representative: carol}))
+	require.NoError(t, f.keeper.SetVoteDelegation(f.ctx, types.VoteDelegation{Delegator: carol, Representative: bob}))
+
+	require.ErrorIs(t, f.keeper.ValidateVoteDelegation(f.ctx, alice, bob, 3), types.ErrVoteDelegationDepth)
+	require.ErrorIs(t, f.keeper.ValidateVoteDelegation(f.ctx, carol, dave, 3), types.ErrVoteDelegationDepth)
+	require.ErrorIs(t, f.keeper.ValidateVoteDelegation(f.ctx, alice, dave, 0), types.ErrVoteDelegationDepth)
+	require.NoError(t, f.keeper.ValidateVoteDelegation(f.ctx, alice, dave, 1))
+}
+
+func TestVoteDelegationMsgServerRevoke(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	alice := setRoleHolder(t, f, 0, "alice", "core_contributor", "2.0")
+	bob := setRoleHolder(t, f, 1, "bob", "community_member", "1.0")
+
+	_, err := srv.DelegateVote(f.ctx, &types.MsgDelegateVote{Delegator: alice, Representative: bob})
+	require.NoError(t, err)
+
+	_, err = srv.RevokeVoteDelegation(f.ctx, &types.MsgRevokeVoteDelegation{Delegator: "invalid"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+
+	_, err = srv.RevokeVoteDelegation(f.ctx, &types.MsgRevokeVoteDelegation{Delegator: bob})
+	require.ErrorIs(t, err, types.ErrVoteDelegationNotFound)
+
+	_, err = srv.RevokeVoteDelegation(f.ctx, &types.MsgRevokeVoteDelegation{Delegator: alice})
+	require.NoError(t, err)
+
+	_, err = f.keeper.VoteDelegations.Get(f.ctx, alice)
+	require.Error(t, err)
+	has, err := f.keeper.VoteDelegationsByRepresentative.Has(f.ctx, collections.Join(bob, alice))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestVoteDelegationRemovedWithRole(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	alice := setRoleHolder(t, f, 0, "alice", "core_contributor", "2.0")
+	bob := setRoleHolder(t, f, 1, "bob", "community_member", "1.0")
+	carol := setRoleHolder(t, f, 2, "carol", "community_member", "1.0")
+
+	_, err = srv.DelegateVote(f.ctx, &types.MsgDelegateVote{Delegator: alice, Representative: bob})
+	require.NoError(t, err)
+	_, err = srv.DelegateVote(f.ctx, &types.MsgDelegateVote{Delegator: bob, Representative: carol})
+	require.NoError(t, err)
+
+	// bob loses the role: both the delegation to bob and bob's own delegation go away
+	_, err = srv.DeleteVoterRole(f.ctx, &types.MsgDeleteVoterRole{Creator: authority, Id: 1})
+	require.NoError(t, err)
+
+	for _, delegator := range []string{alice, bob} {
+		has, err := f.keeper.VoteDelegations.Has(f.ctx, delegator)
+		require.NoError(t, err)
+		require.False(t, has, delegator)
+	}
+}
@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -15,15 +14,16 @@ import (
 )
 
 func (k msgServer) CreateVoterRole(ctx context.Context, msg *types.MsgCreateVoterRole) (*types.MsgCreateVoterRoleResponse, error) {
-	creator, err := k.addressCodec.StringToBytes(msg.Creator)
-	if err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
-	}
+	// bonded roles are only created from approved nominations, which hold the bond
+	return k.createVoterRole(ctx, msg, nil)
+}
 
-	// only gov can do this
-	if !bytes.Equal(k.GetAuthority(), creator) {
-		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
-		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "only governance account can create voter roles; expected %s, got %s", expectedAuthorityStr, msg.Creator)
+// createVoterRole creates a voter role holding the given bond, already in the module account.
+func (k msgServer) createVoterRole(ctx context.Context, msg *types.MsgCreateVoterRole, bond sdk.Coins) (*types.MsgCreateVoterRoleResponse, error) {
+	// gov or a role authority from the params
+	authority, err := k.roleAuthority(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
 	}
 
 	params, err := k.Params.Get(ctx)
@@ -49,6 +49,36 @@ func (k msgServer) CreateVoterRole(ctx context.Context, msg *types.MsgCreateVote
 		return nil, err
 	}
 
+	if err := k.ValidateMsgTypeMultipliers(msg.MsgTypeMultipliers); err != nil {
+		return nil, err
+	}
+
+	var voterRole = types.VoterRole{
+		Creator:    msg.Creator,
+		Address:    msg.Address,
+		Role:       msg.Role,
+		Multiplier: msg.Multiplier,
+		AddedAt:    msg.AddedAt,
+		AddedBy:    msg.AddedBy,
+
+		MsgTypeMultipliers: msg.MsgTypeMultipliers,
+		VetoHolder:         msg.VetoHolder,
+	}
+
+	if err := authorizeRoleChange(authority, types.RoleOperationCreate, nil, &voterRole); err != nil {
+		return nil, err
+	}
+
+	if required := params.RoleBond.Required(msg.Role); !bond.IsAllGTE(required) {
+		return nil, errorsmod.Wrapf(types.ErrRoleBondRequired, "role %s requires a bond of %s, apply with MsgNominateVoterRole", msg.Role, required)
+	}
+	voterRole.Bond = bond
+
+	// a role set by governance replaces an automatically assigned one
+	if err := k.replaceAutoRole(ctx, msg.Address); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to replace auto assigned voterRole")
+	}
+
 	// check if they already have a role
 	if k.HasVoterRole(ctx, msg.Address) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest,
@@ -68,15 +98,7 @@ func (k msgServer) CreateVoterRole(ctx context.Context, msg *types.MsgCreateVote
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get next id")
 	}
 
-	var voterRole = types.VoterRole{
-		Id:         nextId,
-		Creator:    msg.Creator,
-		Address:    msg.Address,
-		Role:       msg.Role,
-		Multiplier: msg.Multiplier,
-		AddedAt:    msg.AddedAt,
-		AddedBy:    msg.AddedBy,
-	}
+	voterRole.Id = nextId
 
 	if err = k.VoterRole.Set(
 		ctx,
@@ -102,6 +124,7 @@ func (k msgServer) CreateVoterRole(ctx context.Context, msg *types.MsgCreateVote
 			sdk.NewAttribute(types.AttributeKeyMultiplier, msg.Multiplier),
 			sdk.NewAttribute(types.AttributeKeyAddedBy, msg.AddedBy),
 			sdk.NewAttribute(types.AttributeKeyAddedAt, fmt.Sprintf("%d", msg.AddedAt)),
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Creator),
 		),
 	)
 
@@ -111,23 +134,22 @@ func (k msgServer) CreateVoterRole(ctx context.Context, msg *types.MsgCreateVote
 }
 
 func (k msgServer) UpdateVoterRole(ctx context.Context, msg *types.MsgUpdateVoterRole) (*types.MsgUpdateVoterRoleResponse, error) {
-	creator, err := k.addressCodec.StringToBytes(msg.Creator)
+	// same check as create
+	authority, err := k.roleAuthority(ctx, msg.Creator)
 	if err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+		return nil, err
 	}
 
-	// same check as create - only gov
-	if !bytes.Equal(k.GetAuthority(), creator) {
-		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
-		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "only governance account can update voter roles; expected %s, got %s", expectedAuthorityStr, msg.Creator)
+	if err := k.ValidateVoterRole(msg.Address, msg.Role, msg.Multiplier); err != nil {
+		return nil, err
 	}
 
-	if err := k.ValidateVoterRole(msg.Address, msg.Role, msg.Multiplier); err != nil {
+	if err := k.ValidateMsgTypeMultipliers(msg.MsgTypeMultipliers); err != nil {
 		return nil, err
 	}
 
 	// make sure it exists first
-	_, err = k.VoterRole.Get(ctx, msg.Id)
+	existing, err := k.VoterRole.Get(ctx, msg.Id)
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
 			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
@@ -144,12 +166,51 @@ func (k msgServer) UpdateVoterRole(ctx context.Context, msg *types.MsgUpdateVote
 		Multiplier: msg.Multiplier,
 		AddedAt:    msg.AddedAt,
 		AddedBy:    msg.AddedBy,
+
+		MsgTypeMultipliers: msg.MsgTypeMultipliers,
+		VetoHolder:         msg.VetoHolder,
+		// an update does not lift a suspension, nor the expiry of a claimed role
+		Suspension: existing.Suspension,
+		ExpiresAt:  existing.ExpiresAt,
+	}
+
+	// both the role before and after the update must be in the scope of the authority
+	if err := authorizeRoleChange(authority, types.RoleOperationUpdate, &existing, &voterRole); err != nil {
+		return nil, err
+	}
+
+	// the bond stays with its holder and must cover the updated role
+	if !existing.Bond.IsZero() && existing.Address != msg.Address {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "the address of a bonded voter role cannot change")
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get module params")
+	}
+	if required := params.RoleBond.Required(msg.Role); !existing.Bond.IsAllGTE(required) {
+		return nil, errorsmod.Wrapf(types.ErrRoleBondRequired, "role %s requires a bond of %s", msg.Role, required)
 	}
+	voterRole.Bond = existing.Bond
 
 	if err := k.VoterRole.Set(ctx, msg.Id, voterRole); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update voterRole")
 	}
 
+	// a role set by governance is not restored to the multiplier it had before a downgrade
+	if err := k.clearDowngrade(ctx, existing.Address); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to clear downgrade")
+	}
+	if err := k.clearDowngrade(ctx, msg.Address); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to clear downgrade")
+	}
+
+	// the old address no longer holds a role
+	if existing.Address != msg.Address {
+		if err := k.RemoveVoteDelegationsFor(ctx, existing.Address); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove vote delegations")
+		}
+	}
+
 	// Emit event
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(
@@ -160,6 +221,7 @@ func (k msgServer) UpdateVoterRole(ctx context.Context, msg *types.MsgUpdateVote
 			sdk.NewAttribute(types.AttributeKeyRole, msg.Role),
 			sdk.NewAttribute(types.AttributeKeyMultiplier, msg.Multiplier),
 			sdk.NewAttribute(types.AttributeKeyUpdatedBy, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Creator),
 		),
 	)
 
@@ -167,15 +229,10 @@ func (k msgServer) UpdateVoterRole(ctx context.Context, msg *types.MsgUpdateVote
 }
 
 func (k msgServer) DeleteVoterRole(ctx context.Context, msg *types.MsgDeleteVoterRole) (*types.MsgDeleteVoterRoleResponse, error) {
-	creator, err := k.addressCodec.StringToBytes(msg.Creator)
+	// Check if creator is the governance module account or a role authority
+	authority, err := k.roleAuthority(ctx, msg.Creator)
 	if err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
-	}
-
-	// Check if creator is the governance module account
-	if !bytes.Equal(k.GetAuthority(), creator) {
-		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
-		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "only governance account can delete voter roles; expected %s, got %s", expectedAuthorityStr, msg.Creator)
+		return nil, err
 	}
 
 	// Checks that the element exists
@@ -188,10 +245,14 @@ func (k msgServer) DeleteVoterRole(ctx context.Context, msg *types.MsgDeleteVote
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get voterRole")
 	}
 
-	// No need to check if msg creator matches val.Creator since only governance can delete
+	// No need to check if msg creator matches val.Creator since only authorities can delete
+	if err := authorizeRoleChange(authority, types.RoleOperationDelete, &val, nil); err != nil {
+		return nil, err
+	}
 
-	if err := k.VoterRole.Remove(ctx, msg.Id); err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to delete voterRole")
+	slashed, err := k.removeVoterRole(ctx, val, msg.ForCause)
+	if err != nil {
+		return nil, err
 	}
 
 	// Emit event
@@ -202,6 +263,9 @@ func (k msgServer) DeleteVoterRole(ctx context.Context, msg *types.MsgDeleteVote
 			sdk.NewAttribute(types.AttributeKeyRoleID, fmt.Sprintf("%d", msg.Id)),
 			sdk.NewAttribute(types.AttributeKeyAddress, val.Address),
 			sdk.NewAttribute(types.AttributeKeyDeletedBy, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyForCause, fmt.Sprintf("%t", msg.ForCause)),
+			sdk.NewAttribute(types.AttributeKeySlashed, slashed.String()),
 		),
 	)
 
@@ -24,9 +24,21 @@ func (k msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams)
 		return nil, err
 	}
 
+	previous, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := k.Params.Set(ctx, req.Params); err != nil {
 		return nil, err
 	}
 
+	// validators bonded while auto_validator_role was disabled get their role now
+	if req.Params.AutoValidatorRole && !previous.AutoValidatorRole {
+		if err := k.AssignBondedValidatorRoles(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
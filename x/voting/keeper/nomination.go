@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// SetNomination stores a pending nomination and indexes its expiry.
+func (k Keeper) SetNomination(ctx context.Context, nomination types.Nomination) error {
+	if err := k.Nominations.Set(ctx, nomination.Id, nomination); err != nil {
+		return err
+	}
+
+	return k.NominationQueue.Set(ctx, collections.Join(nomination.ExpiresAt, nomination.Id))
+}
+
+// settleNomination removes a nomination and refunds its deposit to the nominator, or
+// burns it. The bond, if any, is always refunded.
+func (k Keeper) settleNomination(ctx context.Context, nomination types.Nomination, burn bool) error {
+	if err := k.Nominations.Remove(ctx, nomination.Id); err != nil {
+		return err
+	}
+	if err := k.NominationQueue.Remove(ctx, collections.Join(nomination.ExpiresAt, nomination.Id)); err != nil {
+		return err
+	}
+
+	refund := nomination.Bond
+	if burn && !nomination.Deposit.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, nomination.Deposit); err != nil {
+			return err
+		}
+	} else {
+		refund = refund.Add(nomination.Deposit...)
+	}
+
+	if refund.IsZero() {
+		return nil
+	}
+
+	nominator, err := k.addressCodec.StringToBytes(nomination.Nominator)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, nominator, refund)
+}
+
+// ProcessNominations drops the nominations whose expiry has been reached and refunds
+// their deposit.
+func (k Keeper) ProcessNominations(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	rng := new(collections.Range[collections.Pair[int64, uint64]]).
+		EndExclusive(collections.PairPrefix[int64, uint64](sdkCtx.BlockTime().Unix() + 1))
+	var expired []collections.Pair[int64, uint64]
+	if err := k.NominationQueue.Walk(ctx, rng, func(key collections.Pair[int64, uint64]) (bool, error) {
+		expired = append(expired, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		nomination, err := k.Nominations.Get(ctx, key.K2())
+		if errors.Is(err, collections.ErrNotFound) {
+			if err := k.NominationQueue.Remove(ctx, key); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := k.settleNomination(ctx, nomination, false); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeNominationExpired,
+				sdk.NewAttribute(types.AttributeKeyNominationID, fmt.Sprintf("%d", nomination.Id)),
+				sdk.NewAttribute(types.AttributeKeyAddress, nomination.Address),
+				sdk.NewAttribute(types.AttributeKeyRole, nomination.Role),
+			),
+		)
+	}
+
+	return nil
+}
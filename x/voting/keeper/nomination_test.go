@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"cosmos-weighted-governance-sdk/x/voting/keeper"
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+func TestNominationWorkflow(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	authority := authorityMsg(t, f).Authority
+	ctx := withBlockTime(f, 1000)
+
+	council, err := f.addressCodec.BytesToString(testAccAddress("council"))
+	require.NoError(t, err)
+	alice := setRoleHolder(t, f, 9, "alice", "core_contributor", "2.0")
+	bob, err := f.addressCodec.BytesToString(testAccAddress("bob"))
+	require.NoError(t, err)
+	carol, err := f.addressCodec.BytesToString(testAccAddress("carol"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.RoleCreationCooldown = 0
+	params.Nomination = types.NewNominationParams(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 500)
+	params.RoleAuthorities = []types.RoleAuthority{types.NewRoleAuthority(council, []string{types.RoleOperationCreate},
+		[]string{"community_member"}, math.LegacyOneDec(), math.LegacyOneDec())}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	f.bankKeeper.balances[string(testAccAddress("alice"))] = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+
+	for _, tc := range []struct {
+		desc string
+		msg  *types.MsgNominateVoterRole
+		err  error
+	}{
+		{desc: "invalid nominee", msg: types.NewMsgNominateVoterRole(alice, "invalid", "community_member", "active", deposit), err: sdkerrors.ErrInvalidAddress},
+		{desc: "unknown role", msg: types.NewMsgNominateVoterRole(alice, bob, "admin", "active", deposit), err: sdkerrors.ErrInvalidRequest},
+		{desc: "no role", msg: types.NewMsgNominateVoterRole(alice, bob, "", "active", deposit), err: sdkerrors.ErrInvalidRequest},
+		{desc: "no justification", msg: types.NewMsgNominateVoterRole(alice, bob, "community_member", "", deposit), err: sdkerrors.ErrInvalidRequest},
+		{desc: "role holder", msg: types.NewMsgNominateVoterRole(alice, alice, "community_member", "active", deposit), err: sdkerrors.ErrInvalidRequest},
+		{
+			desc: "insufficient deposit",
+			msg:  types.NewMsgNominateVoterRole(alice, bob, "community_member", "active", sdk.NewCoins(sdk.NewInt64Coin("stake", 99))),
+			err:  types.ErrInsufficientDeposit,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.NominateVoterRole(ctx, tc.msg)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	nominate := func(address, role string) uint64 {
+		res, err := srv.NominateVoterRole(ctx, types.NewMsgNominateVoterRole(alice, address, role, "active", deposit))
+		require.NoError(t, err)
+		return res.Id
+	}
+	first := nominate(bob, "community_member")
+	second := nominate(carol, "core_contributor")
+	third := nominate(carol, "community_member")
+	require.Equal(t, deposit.MulInt(math.NewInt(3)), f.bankKeeper.moduleBalance(types.ModuleName))
+
+	nomination, err := qs.GetNomination(ctx, &types.QueryGetNominationRequest{Id: first})
+	require.NoError(t, err)
+	require.Equal(t, types.Nomination{
+		Id: first, Nominator: alice, Address: bob, Role: "community_member", Justification: "active",
+		Deposit: deposit, SubmittedAt: 1000, ExpiresAt: 1500,
+	}, nomination.Nomination)
+	nominations, err := qs.ListNomination(ctx, &types.QueryAllNominationRequest{})
+	require.NoError(t, err)
+	require.Len(t, nominations.Nomination, 3)
+
+	// the council approves within its scope, and the deposit is refunded
+	_, err = srv.ApproveNomination(ctx, types.NewMsgApproveNomination(council, first, "2.0"))
+	require.ErrorIs(t, err, types.ErrUnauthorizedRoleChange)
+	_, err = srv.ApproveNomination(ctx, types.NewMsgApproveNomination(council, second, "1.0"))
+	require.ErrorIs(t, err, types.ErrUnauthorizedRoleChange)
+	res, err := srv.ApproveNomination(ctx, types.NewMsgApproveNomination(council, first, "1.0"))
+	require.NoError(t, err)
+	role, err := f.keeper.VoterRole.Get(ctx, res.VoterRoleId)
+	require.NoError(t, err)
+	require.Equal(t, bob, role.Address)
+	require.Equal(t, alice, role.AddedBy)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 800)), f.bankKeeper.balances[string(testAccAddress("alice"))])
+	_, err = qs.GetNomination(ctx, &types.QueryGetNominationRequest{Id: first})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// a nomination outside the scope of the council can only be rejected by gov
+	_, err = srv.RejectNomination(ctx, types.NewMsgRejectNomination(council, second, true))
+	require.ErrorIs(t, err, types.ErrUnauthorizedRoleChange)
+	_, err = srv.RejectNomination(ctx, types.NewMsgRejectNomination(carol, second, true))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = srv.RejectNomination(ctx, types.NewMsgRejectNomination(authority, second, true))
+	require.NoError(t, err)
+	require.Equal(t, deposit, f.bankKeeper.burned)
+	_, err = srv.RejectNomination(ctx, types.NewMsgRejectNomination(authority, second, true))
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// expired nominations are dropped and refunded
+	require.NoError(t, f.keeper.ProcessNominations(withBlockTime(f, 1499)))
+	has, err := f.keeper.Nominations.Has(ctx, third)
+	require.NoError(t, err)
+	require.True(t, has)
+	require.NoError(t, f.keeper.ProcessNominations(withBlockTime(f, 1500)))
+	has, err = f.keeper.Nominations.Has(ctx, third)
+	require.NoError(t, err)
+	require.False(t, has)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 900)), f.bankKeeper.balances[string(testAccAddress("alice"))])
+	require.True(t, f.bankKeeper.moduleBalance(types.ModuleName).IsZero())
+}
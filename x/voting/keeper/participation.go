@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// RecordVote notes that a role holder voted on a proposal in voting period. Votes of
+// addresses without a role are not tracked.
+func (k Keeper) RecordVote(ctx context.Context, proposalID uint64, voter string) error {
+	if !k.HasVoterRole(ctx, voter) {
+		return nil
+	}
+
+	return k.ProposalVoters.Set(ctx, collections.Join(proposalID, voter))
+}
+
+// RecordParticipation records for every role holder whether it took part in a proposal
+// whose voting period ended. A holder takes part by voting directly, by having its vote
+// delegation counted in the tally, or by being recused from the proposal. When downgrades are enabled, holders whose participation rate over
+// a full window fell below the min rate are downgraded, and downgraded holders are
+// restored once their rate recovers or downgrades are disabled.
+func (k Keeper) RecordParticipation(ctx context.Context, proposalID uint64) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	window := params.Participation.Window
+
+	var roles []types.VoterRole
+	err = k.VoterRole.Walk(ctx, nil, func(_ uint64, role types.VoterRole) (bool, error) {
+		roles = append(roles, role)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(roles))
+	for _, role := range roles {
+		if seen[role.Address] {
+			continue
+		}
+		seen[role.Address] = true
+
+		participation, err := k.Participation.Get(ctx, role.Address)
+		if errors.Is(err, collections.ErrNotFound) {
+			participation = types.Participation{Address: role.Address}
+		} else if err != nil {
+			return err
+		}
+
+		voted, err := k.ProposalVoters.Has(ctx, collections.Join(proposalID, role.Address))
+		if err != nil {
+			return err
+		}
+
+		participation.ProposalsEnded++
+		if voted {
+			participation.ProposalsVoted++
+		}
+		participation.Recent = append(participation.Recent, voted)
+		if len(participation.Recent) > int(window) {
+			participation.Recent = participation.Recent[len(participation.Recent)-int(window):]
+		}
+
+		if err := k.enforceParticipation(ctx, params.Participation, role, &participation); err != nil {
+			return err
+		}
+
+		if err := k.Participation.Set(ctx, role.Address, participation); err != nil {
+			return err
+		}
+	}
+
+	return k.ProposalVoters.Clear(ctx, collections.NewPrefixedPairRange[uint64, string](proposalID))
+}
+
+// enforceParticipation downgrades or restores the role of a holder by its participation.
+// A downgrade caps the multiplier and the message type overrides of the role at the
+// downgrade multiplier, and a restore brings the originals back.
+func (k Keeper) enforceParticipation(ctx context.Context, params types.ParticipationParams, role types.VoterRole, participation *types.Participation) error {
+	rate := participation.Rate(params.Window)
+	eventType := ""
+
+	switch {
+	case participation.DowngradedFrom != "":
+		if params.Downgrade && rate.LT(params.MinRate) {
+			return nil
+		}
+		role.Multiplier = participation.DowngradedFrom
+		role.MsgTypeMultipliers = participation.DowngradedOverrides
+		participation.DowngradedFrom = ""
+		participation.DowngradedOverrides = nil
+		eventType = types.EventTypeVoterRoleRestored
+	case params.Downgrade && len(participation.Recent) >= int(params.Window) && rate.LT(params.MinRate):
+		multiplier, err := math.LegacyNewDecFromStr(role.Multiplier)
+		if err != nil {
+			return err
+		}
+		overrides, capped, err := capOverrides(role.MsgTypeMultipliers, params.DowngradeMultiplier)
+		if err != nil {
+			return err
+		}
+		if multiplier.LTE(params.DowngradeMultiplier) && !capped {
+			return nil
+		}
+		participation.DowngradedFrom = role.Multiplier
+		participation.DowngradedOverrides = role.MsgTypeMultipliers
+		if multiplier.GT(params.DowngradeMultiplier) {
+			role.Multiplier = params.DowngradeMultiplier.String()
+		}
+		role.MsgTypeMultipliers = overrides
+		eventType = types.EventTypeVoterRoleDowngraded
+	default:
+		return nil
+	}
+
+	if err := k.VoterRole.Set(ctx, role.Id, role); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyRoleID, fmt.Sprintf("%d", role.Id)),
+			sdk.NewAttribute(types.AttributeKeyAddress, role.Address),
+			sdk.NewAttribute(types.AttributeKeyMultiplier, role.Multiplier),
+			sdk.NewAttribute(types.AttributeKeyParticipationRate, rate.String()),
+		),
+	)
+
+	return nil
+}
+
+// capOverrides returns the message type overrides with their multipliers capped at limit,
+// and whether any of them was above it.
+func capOverrides(overrides []types.MsgTypeMultiplier, limit math.LegacyDec) ([]types.MsgTypeMultiplier, bool, error) {
+	var (
+		result []types.MsgTypeMultiplier
+		capped bool
+	)
+	for _, override := range overrides {
+		multiplier, err := math.LegacyNewDecFromStr(override.Multiplier)
+		if err != nil {
+			return nil, false, err
+		}
+		if multiplier.GT(limit) {
+			override.Multiplier = limit.String()
+			capped = true
+		}
+		result = append(result, override)
+	}
+	return result, capped, nil
+}
+
+// clearDowngrade forgets the downgrade of an address whose role is set or deleted, so that
+// a later restore does not overwrite it.
+func (k Keeper) clearDowngrade(ctx context.Context, address string) error {
+	participation, err := k.Participation.Get(ctx, address)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if participation.DowngradedFrom == "" {
+		return nil
+	}
+
+	participation.DowngradedFrom = ""
+	participation.DowngradedOverrides = nil
+	return k.Participation.Set(ctx, address, participation)
+}
@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmos-weighted-governance-sdk/x/voting/keeper"
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+func TestParticipationDowngrade(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	hooks := keeper.NewGovHooksWrapper(f.keeper, nil)
+
+	alice := setRoleHolder(t, f, 0, "alice", "core_contributor", "2.0")
+	setRoleHolder(t, f, 1, "bob", "community_member", "1.0")
+
+	params := types.DefaultParams()
+	params.Participation = types.NewParticipationParams(2, math.LegacyNewDecWithPrec(5, 1), true, math.LegacyOneDec())
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	endProposal := func(id uint64, voters ...string) {
+		for _, voter := range voters {
+			require.NoError(t, hooks.AfterProposalVote(f.ctx, id, testAccAddress(voter)))
+		}
+		require.NoError(t, hooks.AfterProposalVotingPeriodEnded(f.ctx, id))
+	}
+	multiplier := func() string {
+		role, err := f.keeper.VoterRole.Get(f.ctx, 0)
+		require.NoError(t, err)
+		return role.Multiplier
+	}
+
+	endProposal(1, "alice", "bob")
+	endProposal(2, "bob")
+	// one of the last two proposals is not below the min rate
+	require.Equal(t, "2.0", multiplier())
+
+	endProposal(3)
+	require.Equal(t, math.LegacyOneDec().String(), multiplier())
+
+	response, err := qs.Participation(f.ctx, &types.QueryParticipationRequest{Address: alice})
+	require.NoError(t, err)
+	require.Equal(t, types.Participation{
+		Address:        alice,
+		ProposalsEnded: 3,
+		ProposalsVoted: 1,
+		Recent:         []bool{false, false},
+		DowngradedFrom: "2.0",
+	}, response.Participation)
+	require.True(t, response.Rate.IsZero())
+
+	// voting again restores the multiplier once the rate recovers
+	endProposal(4, "alice")
+	require.Equal(t, "2.0", multiplier())
+
+	// a downgrade is forgotten when governance sets the role
+	endProposal(5)
+	endProposal(6)
+	require.Equal(t, math.LegacyOneDec().String(), multiplier())
+	_, err = keeper.NewMsgServerImpl(f.keeper).UpdateVoterRole(f.ctx, &types.MsgUpdateVoterRole{
+		Creator:    authorityMsg(t, f).Authority,
+		Id:         0,
+		Address:    alice,
+		Role:       "core_contributor",
+		Multiplier: "3.0",
+	})
+	require.NoError(t, err)
+	endProposal(7, "alice")
+	require.Equal(t, "3.0", multiplier())
+
+	// votes of addresses without a role are not tracked
+	require.NoError(t, hooks.AfterProposalVote(f.ctx, 8, testAccAddress("carol")))
+	carol, err := f.addressCodec.BytesToString(testAccAddress("carol"))
+	require.NoError(t, err)
+	has, err := f.keeper.ProposalVoters.Has(f.ctx, collections.Join(uint64(8), carol))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	_, err = qs.Participation(f.ctx, &types.QueryParticipationRequest{Address: carol})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	_, err = qs.Participation(f.ctx, &types.QueryParticipationRequest{Address: sdk.ValAddress(testAccAddress("carol")).String()})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestParticipationDowngradeOverrides(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	hooks := keeper.NewGovHooksWrapper(f.keeper, nil)
+
+	alice := setRoleHolder(t, f, 0, "alice", "core_contributor", "2.0")
+	setRoleOverrides(t, f, 0,
+		types.MsgTypeMultiplier{MsgTypeUrl: msgSoftwareUpgradeURL, Multiplier: "3.0"},
+		types.MsgTypeMultiplier{MsgTypeUrl: msgSendURL, Multiplier: "0.5"},
+	)
+	bob := setRoleHolder(t, f, 1, "bob", "community_member", "1.0")
+	setRoleOverrides(t, f, 1, types.MsgTypeMultiplier{MsgTypeUrl: msgSoftwareUpgradeURL, Multiplier: "4.0"})
+
+	params := types.DefaultParams()
+	params.Participation = types.NewParticipationParams(2, math.LegacyNewDecWithPrec(5, 1), true, math.LegacyOneDec())
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	f.govKeeper.SetProposal(10, msgSoftwareUpgradeURL)
+
+	endProposal := func(id uint64, voters ...string) {
+		for _, voter := range voters {
+			require.NoError(t, hooks.AfterProposalVote(f.ctx, id, testAccAddress(voter)))
+		}
+		require.NoError(t, hooks.AfterProposalVotingPeriodEnded(f.ctx, id))
+	}
+	effective := func(address string) math.LegacyDec {
+		res, err := qs.EffectiveMultiplier(f.ctx, &types.QueryEffectiveMultiplierRequest{Address: address, ProposalId: 10})
+		require.NoError(t, err)
+		return res.Multiplier
+	}
+	require.Equal(t, math.LegacyNewDec(3), effective(alice))
+	require.Equal(t, math.LegacyNewDec(4), effective(bob))
+
+	// overrides above the downgrade multiplier are capped along with the multiplier, and
+	// a role whose only override is above it is downgraded as well
+	endProposal(1)
+	endProposal(2)
+	require.Equal(t, math.LegacyOneDec(), effective(alice))
+	require.Equal(t, math.LegacyOneDec(), effective(bob))
+	role, err := f.keeper.VoterRole.Get(f.ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, []types.MsgTypeMultiplier{
+		{MsgTypeUrl: msgSoftwareUpgradeURL, Multiplier: math.LegacyOneDec().String()},
+		{MsgTypeUrl: msgSendURL, Multiplier: "0.5"},
+	}, role.MsgTypeMultipliers)
+	role, err = f.keeper.VoterRole.Get(f.ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "1.0", role.Multiplier)
+
+	// a restore brings the original overrides back
+	endProposal(3, "alice", "bob")
+	require.Equal(t, math.LegacyNewDec(3), effective(alice))
+	require.Equal(t, math.LegacyNewDec(4), effective(bob))
+	participation, err := f.keeper.Participation.Get(f.ctx, alice)
+	require.NoError(t, err)
+	require.Empty(t, participation.DowngradedFrom)
+	require.Empty(t, participation.DowngradedOverrides)
+}
+
+func TestParticipationFromTally(t *testing.T) {
+	f := initFixture(t)
+	valAddr, validators := tallyValidators(t, f)
+	hooks := keeper.NewGovHooksWrapper(f.keeper, nil)
+
+	alice := setRoleHolder(t, f, 0, "alice", "core_contributor", "2.0")
+	bob := setRoleHolder(t, f, 1, "bob", "community_member", "1.0")
+	carol := setRoleHolder(t, f, 2, "carol", "community_member", "1.0")
+	dave := setRoleHolder(t, f, 3, "dave", "community_member", "1.0")
+	for _, name := range []string{"alice", "bob", "carol", "dave"} {
+		f.stakingKeeper.Delegate(testAccAddress(name), valAddr, 100)
+	}
+	require.NoError(t, f.keeper.SetVoteDelegation(f.ctx, types.VoteDelegation{Delegator: bob, Representative: alice}))
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	// bob votes through alice and carol is recused by the proposal, so neither is absent
+	proposal := setMsgsProposal(t, f, 1, &types.MsgSuspendVoterRole{Authority: authority, Id: 2})
+	require.NoError(t, hooks.AfterProposalVote(f.ctx, 1, testAccAddress("alice")))
+	_, _, err = f.keeper.TallyVotes(f.ctx, proposal, []v1.Vote{newVote(alice, v1.OptionYes)}, copyValidators(validators))
+	require.NoError(t, err)
+	require.NoError(t, hooks.AfterProposalVotingPeriodEnded(f.ctx, 1))
+
+	for address, voted := range map[string]bool{alice: true, bob: true, carol: true, dave: false} {
+		participation, err := f.keeper.Participation.Get(f.ctx, address)
+		require.NoError(t, err)
+		require.Equal(t, []bool{voted}, participation.Recent, address)
+	}
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// log2Precision is the number of fractional bits computed by log2.
+const log2Precision = 40
+
+// TransformPower applies the power_transform param to a voter's stake.
+func TransformPower(params types.Params, power math.LegacyDec) (math.LegacyDec, error) {
+	if !power.IsPositive() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	switch params.PowerTransform {
+	case types.PowerTransformSqrt:
+		return power.ApproxSqrt()
+	case types.PowerTransformLog:
+		return log2(power.Add(math.LegacyOneDec())), nil
+	case types.PowerTransformCapped:
+		if powerCap := math.LegacyNewDecFromInt(params.PowerCap); power.GT(powerCap) {
+			return powerCap, nil
+		}
+		return power, nil
+	default:
+		return power, nil
+	}
+}
+
+// log2 returns the binary logarithm of x >= 1, computed bit by bit so that every node
+// gets the same result.
+func log2(x math.LegacyDec) math.LegacyDec {
+	// integer part: position of the highest bit
+	exponent := x.TruncateInt().BigInt().BitLen() - 1
+	result := math.LegacyNewDec(int64(exponent))
+
+	// normalize x into [1, 2)
+	y := x.Quo(math.LegacyNewDecFromBigInt(new(big.Int).Lsh(big.NewInt(1), uint(exponent))))
+
+	two := math.LegacyNewDec(2)
+	bit := math.LegacyNewDecWithPrec(5, 1)
+	for i := 0; i < log2Precision; i++ {
+		y = y.Mul(y)
+		if y.GTE(two) {
+			y = y.QuoInt64(2)
+			result = result.Add(bit)
+		}
+		bit = bit.QuoInt64(2)
+	}
+
+	return result
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+
+	"cosmos-weighted-governance-sdk/x/voting/keeper"
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+func TestTransformPower(t *testing.T) {
+	params := func(transform string, powerCap int64) types.Params {
+		p := types.DefaultParams()
+		p.PowerTransform = transform
+		p.PowerCap = math.NewInt(powerCap)
+		return p
+	}
+
+	tests := []struct {
+		desc   string
+		params types.Params
+		power  math.LegacyDec
+		exp    math.LegacyDec
+	}{
+		{desc: "linear", params: params(types.PowerTransformLinear, 0), power: math.LegacyNewDec(10000), exp: math.LegacyNewDec(10000)},
+		{desc: "sqrt", params: params(types.PowerTransformSqrt, 0), power: math.LegacyNewDec(10000), exp: math.LegacyNewDec(100)},
+		{desc: "log", params: params(types.PowerTransformLog, 0), power: math.LegacyNewDec(1023), exp: math.LegacyNewDec(10)},
+		{desc: "capped above", params: params(types.PowerTransformCapped, 500), power: math.LegacyNewDec(10000), exp: math.LegacyNewDec(500)},
+		{desc: "capped below", params: params(types.PowerTransformCapped, 500), power: math.LegacyNewDec(200), exp: math.LegacyNewDec(200)},
+		{desc: "zero", params: params(types.PowerTransformLog, 0), power: math.LegacyZeroDec(), exp: math.LegacyZeroDec()},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := keeper.TransformPower(tc.params, tc.power)
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, got)
+		})
+	}
+
+	// log2(3) = 1.5849625007...
+	got, err := keeper.TransformPower(params(types.PowerTransformLog, 0), math.LegacyNewDec(2))
+	require.NoError(t, err)
+	require.True(t, got.Sub(math.LegacyMustNewDecFromStr("1.5849625007")).Abs().LT(math.LegacyNewDecWithPrec(1, 9)), got.String())
+}
+
+func TestTallyVotesPowerTransform(t *testing.T) {
+	f := initFixture(t)
+	valAddr, validators := tallyValidators(t, f)
+
+	whale := setRoleHolder(t, f, 0, "whale", "community_member", "1.0")
+	minnow := setRoleHolder(t, f, 1, "minnow", "core_contributor", "2.0")
+
+	f.stakingKeeper.Delegate(testAccAddress("whale"), valAddr, 900)
+	f.stakingKeeper.Delegate(testAccAddress("minnow"), valAddr, 100)
+
+	params := types.DefaultParams()
+	params.PowerTransform = types.PowerTransformSqrt
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	votes := []v1.Vote{
+		newVote(whale, v1.OptionYes),
+		newVote(minnow, v1.OptionNo),
+	}
+	total, results, err := f.keeper.TallyVotes(f.ctx, v1.Proposal{Id: 1}, votes, validators)
+	require.NoError(t, err)
+	// sqrt(900) * 1.0 vs sqrt(100) * 2.0 split the 1000 staked that voted 30 to 20
+	require.Equal(t, math.LegacyNewDec(600), results[v1.OptionYes])
+	require.Equal(t, math.LegacyNewDec(400), results[v1.OptionNo])
+	require.Equal(t, math.LegacyNewDec(1000), total)
+}
+
+func TestTallyVotesPowerTransformQuorum(t *testing.T) {
+	f := initFixture(t)
+	valAddr, validators := tallyValidators(t, f)
+
+	carol := setRoleHolder(t, f, 0, "carol", "community_member", "1.0")
+	validatorAcc, err := f.addressCodec.BytesToString(testAccAddress("validator"))
+	require.NoError(t, err)
+
+	// the whole bonded stake votes, carol directly and the others through the validator
+	f.stakingKeeper.Delegate(testAccAddress("carol"), valAddr, 400)
+	f.stakingKeeper.Delegate(testAccAddress("whale"), valAddr, 400)
+	f.stakingKeeper.Delegate(testAccAddress("minnow"), valAddr, 100)
+	f.stakingKeeper.Delegate(testAccAddress("shrimp"), valAddr, 100)
+
+	params := types.DefaultParams()
+	params.PowerTransform = types.PowerTransformSqrt
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	votes := []v1.Vote{
+		newVote(carol, v1.OptionNo),
+		newVote(validatorAcc, v1.OptionYes),
+	}
+	total, results, err := f.keeper.TallyVotes(f.ctx, v1.Proposal{Id: 1}, votes, validators)
+	require.NoError(t, err)
+
+	// x/gov measures its quorum on the stake, not on the transformed power
+	require.Equal(t, math.LegacyNewDec(1000), total)
+	quorum := math.LegacyMustNewDecFromStr(v1.DefaultParams().Quorum)
+	require.True(t, total.QuoInt64(1000).GTE(quorum))
+
+	// the inherited power is transformed per delegator: sqrt(400) + sqrt(100) + sqrt(100)
+	// rather than sqrt(600), against sqrt(400) for carol
+	require.Equal(t, math.LegacyNewDec(40), weightedYes(t, f, 1))
+	require.Equal(t, govShare(math.LegacyNewDec(40), math.LegacyNewDec(60), total), results[v1.OptionYes])
+	require.Equal(t, govShare(math.LegacyNewDec(20), math.LegacyNewDec(60), total), results[v1.OptionNo])
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+)
+
+// StoreProposalRoleTally stores the per-role votes recorded when x/gov tallied the
+// proposal, once its voting period ended.
+func (k Keeper) StoreProposalRoleTally(ctx context.Context, proposalID uint64) error {
+	proposalRoleTally, err := k.PendingRoleTallies.Get(ctx, proposalID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := k.PendingRoleTallies.Remove(ctx, proposalID); err != nil {
+		return err
+	}
+
+	return k.ProposalRoleTallies.Set(ctx, proposalID, proposalRoleTally)
+}
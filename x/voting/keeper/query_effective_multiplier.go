@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) EffectiveMultiplier(ctx context.Context, req *types.QueryEffectiveMultiplierRequest) (*types.QueryEffectiveMultiplierResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := q.k.addressCodec.StringToBytes(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	proposal, err := q.k.getProposal(ctx, req.ProposalId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	role, err := q.k.GetVoterRoleByAddress(ctx, req.Address)
+	if err != nil {
+		if errors.Is(err, sdkerrors.ErrKeyNotFound) {
+			return &types.QueryEffectiveMultiplierResponse{
+				Multiplier:     math.LegacyOneDec(),
+				BaseMultiplier: math.LegacyOneDec(),
+			}, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	multiplier, msgTypeURL, err := EffectiveMultiplier(*role, proposal)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	base, err := math.LegacyNewDecFromStr(role.Multiplier)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEffectiveMultiplierResponse{
+		Multiplier:     multiplier,
+		BaseMultiplier: base,
+		MsgTypeUrl:     msgTypeURL,
+	}, nil
+}
@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmos-weighted-governance-sdk/x/voting/keeper"
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+const (
+	msgSendURL            = "/cosmos.bank.v1beta1.MsgSend"
+	msgSoftwareUpgradeURL = "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade"
+)
+
+// setRoleOverrides adds per message type multipliers to a stored role.
+func setRoleOverrides(t *testing.T, f *fixture, id uint64, overrides ...types.MsgTypeMultiplier) {
+	t.Helper()
+
+	role, err := f.keeper.VoterRole.Get(f.ctx, id)
+	require.NoError(t, err)
+	role.MsgTypeMultipliers = overrides
+	require.NoError(t, f.keeper.VoterRole.Set(f.ctx, id, role))
+}
+
+func TestEffectiveMultiplierQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	alice := setRoleHolder(t, f, 0, "alice", "core_contributor", "2.0")
+	setRoleOverrides(t, f, 0,
+		types.MsgTypeMultiplier{MsgTypeUrl: msgSoftwareUpgradeURL, Multiplier: "3.0"},
+		types.MsgTypeMultiplier{MsgTypeUrl: msgSendURL, Multiplier: "0.5"},
+	)
+	bob, err := f.addressCodec.BytesToString(testAccAddress("bob"))
+	require.NoError(t, err)
+
+	f.govKeeper.SetProposal(1, msgSoftwareUpgradeURL)
+	f.govKeeper.SetProposal(2, msgSoftwareUpgradeURL, msgSendURL)
+	f.govKeeper.SetProposal(3)
+	f.govKeeper.SetProposal(4, "/cosmos.gov.v1.MsgUpdateParams", msgSoftwareUpgradeURL)
+
+	tests := []struct {
+		desc     string
+		request  *types.QueryEffectiveMultiplierRequest
+		response *types.QueryEffectiveMultiplierResponse
+		err      error
+	}{
+		{
+			desc:    "override applies",
+			request: &types.QueryEffectiveMultiplierRequest{Address: alice, ProposalId: 1},
+			response: &types.QueryEffectiveMultiplierResponse{
+				Multiplier:     math.LegacyNewDec(3),
+				BaseMultiplier: math.LegacyNewDec(2),
+				MsgTypeUrl:     msgSoftwareUpgradeURL,
+			},
+		},
+		{
+			desc:    "lowest override wins",
+			request: &types.QueryEffectiveMultiplierRequest{Address: alice, ProposalId: 2},
+			response: &types.QueryEffectiveMultiplierResponse{
+				Multiplier:     math.LegacyMustNewDecFromStr("0.5"),
+				BaseMultiplier: math.LegacyNewDec(2),
+				MsgTypeUrl:     msgSendURL,
+			},
+		},
+		{
+			desc:    "no messages",
+			request: &types.QueryEffectiveMultiplierRequest{Address: alice, ProposalId: 3},
+			response: &types.QueryEffectiveMultiplierResponse{
+				Multiplier:     math.LegacyNewDec(2),
+				BaseMultiplier: math.LegacyNewDec(2),
+			},
+		},
+		{
+			desc:    "base lower than override",
+			request: &types.QueryEffectiveMultiplierRequest{Address: alice, ProposalId: 4},
+			response: &types.QueryEffectiveMultiplierResponse{
+				Multiplier:     math.LegacyNewDec(2),
+				BaseMultiplier: math.LegacyNewDec(2),
+			},
+		},
+		{
+			desc:    "no role",
+			request: &types.QueryEffectiveMultiplierRequest{Address: bob, ProposalId: 1},
+			response: &types.QueryEffectiveMultiplierResponse{
+				Multiplier:     math.LegacyOneDec(),
+				BaseMultiplier: math.LegacyOneDec(),
+			},
+		},
+		{
+			desc:    "proposal not found",
+			request: &types.QueryEffectiveMultiplierRequest{Address: alice, ProposalId: 9},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "invalid address",
+			request: &types.QueryEffectiveMultiplierRequest{Address: "invalid", ProposalId: 1},
+			err:     status.Error(codes.InvalidArgument, "invalid address"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := qs.EffectiveMultiplier(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
+
+func TestTallyVotesMsgTypeMultipliers(t *testing.T) {
+	f := initFixture(t)
+	valAddr, validators := tallyValidators(t, f)
+
+	alice := setRoleHolder(t, f, 0, "alice", "core_contributor", "2.0")
+	setRoleOverrides(t, f, 0, types.MsgTypeMultiplier{MsgTypeUrl: msgSendURL, Multiplier: "0.5"})
+	f.stakingKeeper.Delegate(testAccAddress("alice"), valAddr, 100)
+
+	votes := []v1.Vote{newVote(alice, v1.OptionYes)}
+
+	_, _, err := f.keeper.TallyVotes(f.ctx, f.govKeeper.SetProposal(1, msgSendURL), votes, copyValidators(validators))
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(50), weightedYes(t, f, 1))
+
+	_, _, err = f.keeper.TallyVotes(f.ctx, f.govKeeper.SetProposal(2, msgSoftwareUpgradeURL), votes, copyValidators(validators))
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(200), weightedYes(t, f, 2))
+}
+
+func TestValidateMsgTypeMultipliers(t *testing.T) {
+	f := initFixture(t)
+
+	tests := []struct {
+		desc      string
+		overrides []types.MsgTypeMultiplier
+		valid     bool
+	}{
+		{desc: "empty", valid: true},
+		{
+			desc:      "valid",
+			overrides: []types.MsgTypeMultiplier{{MsgTypeUrl: msgSendURL, Multiplier: "0.5"}, {MsgTypeUrl: msgSoftwareUpgradeURL, Multiplier: "3"}},
+			valid:     true,
+		},
+		{desc: "missing slash", overrides: []types.MsgTypeMultiplier{{MsgTypeUrl: "cosmos.bank.v1beta1.MsgSend", Multiplier: "1"}}},
+		{desc: "duplicate", overrides: []types.MsgTypeMultiplier{{MsgTypeUrl: msgSendURL, Multiplier: "1"}, {MsgTypeUrl: msgSendURL, Multiplier: "2"}}},
+		{desc: "out of bounds", overrides: []types.MsgTypeMultiplier{{MsgTypeUrl: msgSendURL, Multiplier: "11"}}},
+		{desc: "not a decimal", overrides: []types.MsgTypeMultiplier{{MsgTypeUrl: msgSendURL, Multiplier: "two"}}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := f.keeper.ValidateMsgTypeMultipliers(tc.overrides)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListNomination(ctx context.Context, req *types.QueryAllNominationRequest) (*types.QueryAllNominationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	nominations, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Nominations,
+		req.Pagination,
+		func(_ uint64, value types.Nomination) (types.Nomination, error) {
+			return value, nil
+		},
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllNominationResponse{Nomination: nominations, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetNomination(ctx context.Context, req *types.QueryGetNominationRequest) (*types.QueryGetNominationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	nomination, err := q.k.Nominations.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetNominationResponse{Nomination: nomination}, nil
+}
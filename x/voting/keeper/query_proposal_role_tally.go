@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ProposalRoleTally(ctx context.Context, req *types.QueryProposalRoleTallyRequest) (*types.QueryProposalRoleTallyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	proposalRoleTally, err := q.k.ProposalRoleTallies.Get(ctx, req.ProposalId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryProposalRoleTallyResponse{ProposalRoleTally: proposalRoleTally}, nil
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmos-weighted-governance-sdk/x/voting/keeper"
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+func TestProposalRoleTallyQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	hooks := keeper.NewGovHooksWrapper(f.keeper, nil)
+	valAddr, validators := tallyValidators(t, f)
+
+	alice := setRoleHolder(t, f, 0, "alice", "core_contributor", "2.0")
+	bob := setRoleHolder(t, f, 1, "bob", "community_member", "1.0")
+	carol := setRoleHolder(t, f, 2, "carol", "community_member", "1.0")
+	dave, err := f.addressCodec.BytesToString(testAccAddress("dave"))
+	require.NoError(t, err)
+	validatorAcc, err := f.addressCodec.BytesToString(testAccAddress("validator"))
+	require.NoError(t, err)
+
+	f.stakingKeeper.Delegate(testAccAddress("alice"), valAddr, 100)
+	f.stakingKeeper.Delegate(testAccAddress("bob"), valAddr, 50)
+	f.stakingKeeper.Delegate(testAccAddress("carol"), valAddr, 30)
+	f.stakingKeeper.Delegate(testAccAddress("dave"), valAddr, 20)
+	require.NoError(t, f.keeper.SetVoteDelegation(f.ctx, types.VoteDelegation{Delegator: bob, Representative: alice}))
+
+	votes := []v1.Vote{
+		newVote(alice, v1.OptionYes),
+		newVote(carol, v1.OptionNo),
+		newVote(dave, v1.OptionAbstain),
+		newVote(validatorAcc, v1.OptionNoWithVeto),
+	}
+	_, _, err = f.keeper.TallyVotes(f.ctx, v1.Proposal{Id: 1}, votes, validators)
+	require.NoError(t, err)
+
+	// the per-role votes are stored once the voting period ended
+	_, err = qs.ProposalRoleTally(f.ctx, &types.QueryProposalRoleTallyRequest{ProposalId: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	require.NoError(t, hooks.AfterProposalVotingPeriodEnded(f.ctx, 1))
+	has, err := f.keeper.PendingRoleTallies.Has(f.ctx, 1)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	response, err := qs.ProposalRoleTally(f.ctx, &types.QueryProposalRoleTallyRequest{ProposalId: 1})
+	require.NoError(t, err)
+
+	tallyResult := func(yes, abstain, no, veto string) v1.TallyResult {
+		return v1.TallyResult{YesCount: yes, AbstainCount: abstain, NoCount: no, NoWithVetoCount: veto}
+	}
+	dec := func(i int64) string { return math.LegacyNewDec(i).String() }
+	require.Equal(t, types.ProposalRoleTally{
+		ProposalId: 1,
+		RoleTallies: []types.RoleVoteTally{
+			{
+				// dave and the validator, which keeps the 800 its delegators did not vote with
+				Role:          "",
+				Voters:        2,
+				Multiplier:    math.LegacyOneDec(),
+				RawPower:      tallyResult("0", "20", "0", "800"),
+				WeightedPower: tallyResult(dec(0), dec(20), dec(0), dec(800)),
+			},
+			{
+				Role:          "community_member",
+				Voters:        2,
+				Multiplier:    math.LegacyOneDec(),
+				RawPower:      tallyResult("50", "0", "30", "0"),
+				WeightedPower: tallyResult(dec(50), dec(0), dec(30), dec(0)),
+			},
+			{
+				Role:          "core_contributor",
+				Voters:        1,
+				Multiplier:    math.LegacyNewDec(2),
+				RawPower:      tallyResult("100", "0", "0", "0"),
+				WeightedPower: tallyResult(dec(200), dec(0), dec(0), dec(0)),
+			},
+		},
+	}, response.ProposalRoleTally)
+
+	// proposals x/gov did not tally store nothing
+	require.NoError(t, hooks.AfterProposalVotingPeriodEnded(f.ctx, 2))
+	_, err = qs.ProposalRoleTally(f.ctx, &types.QueryProposalRoleTallyRequest{ProposalId: 2})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	_, err = qs.ProposalRoleTally(f.ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) RoleChangeImpact(ctx context.Context, req *types.QueryRoleChangeImpactRequest) (*types.QueryRoleChangeImpactResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	impacts, err := q.k.RoleChangeImpact(ctx, req.ProposalId)
+	if err != nil {
+		switch {
+		case errors.Is(err, collections.ErrNotFound):
+			return nil, sdkerrors.ErrKeyNotFound
+		case errors.Is(err, sdkerrors.ErrInvalidRequest):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRoleChangeImpactResponse{Impacts: impacts}, nil
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListRoleDistribution(ctx context.Context, req *types.QueryAllRoleDistributionRequest) (*types.QueryAllRoleDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	distributions, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.RoleDistributions,
+		req.Pagination,
+		func(_ uint64, value types.RoleDistribution) (types.RoleDistribution, error) {
+			return value, nil
+		},
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRoleDistributionResponse{RoleDistribution: distributions, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetRoleDistribution(ctx context.Context, req *types.QueryGetRoleDistributionRequest) (*types.QueryGetRoleDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	distribution, err := q.k.RoleDistributions.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetRoleDistributionResponse{RoleDistribution: distribution}, nil
+}
+
+func (q queryServer) RoleClaimed(ctx context.Context, req *types.QueryRoleClaimedRequest) (*types.QueryRoleClaimedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := q.k.addressCodec.StringToBytes(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	has, err := q.k.RoleDistributions.Has(ctx, req.DistributionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if !has {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	claimed, err := q.k.RoleClaims.Has(ctx, collections.Join(req.DistributionId, req.Address))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryRoleClaimedResponse{Claimed: claimed}, nil
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmos-weighted-governance-sdk/x/voting/keeper"
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// setRoleQuorums stores role quorums in the module params.
+func setRoleQuorums(t *testing.T, f *fixture, quorums ...types.RoleQuorum) {
+	t.Helper()
+
+	params := types.DefaultParams()
+	params.RoleQuorums = quorums
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+}
+
+func TestTallyBreakdownQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	valAddr := f.stakingKeeper.SetValidator(sdk.ValAddress(testAccAddress("validator")), 1000)
+	alice := setRoleHolder(t, f, 0, "alice", "core_contributor", "2.0")
+	setRoleHolder(t, f, 1, "bob", "core_contributor", "2.0")
+	setRoleHolder(t, f, 2, "carol", "community_member", "1.0")
+	f.stakingKeeper.Delegate(testAccAddress("alice"), valAddr, 100)
+	f.stakingKeeper.Delegate(testAccAddress("bob"), valAddr, 300)
+	f.stakingKeeper.Delegate(testAccAddress("carol"), valAddr, 50)
+
+	setRoleQuorums(t, f,
+		types.RoleQuorum{Role: "core_contributor", MinParticipation: math.LegacyNewDecWithPrec(5, 1), Basis: types.RoleQuorumBasisHolders},
+		types.RoleQuorum{Role: "community_member", MinParticipation: math.LegacyOneDec(), Basis: types.RoleQuorumBasisPower},
+		types.RoleQuorum{Role: "validator", MinParticipation: math.LegacyOneDec(), Basis: types.RoleQuorumBasisHolders},
+	)
+
+	f.govKeeper.SetProposal(1)
+	f.govKeeper.Vote(1, newVote(alice, v1.OptionYes))
+	passed := f.govKeeper.SetProposal(2)
+	passed.Status = v1.StatusPassed
+	f.govKeeper.proposals[2] = passed
+
+	response, err := qs.TallyBreakdown(f.ctx, &types.QueryTallyBreakdownRequest{ProposalId: 1})
+	require.NoError(t, err)
+	require.False(t, response.RoleQuorumsMet)
+	require.Equal(t, []types.RoleTally{
+		{
+			Role:             "community_member",
+			Holders:          1,
+			Power:            math.LegacyNewDec(50),
+			VotedPower:       math.LegacyZeroDec(),
+			Participation:    math.LegacyZeroDec(),
+			MinParticipation: math.LegacyOneDec(),
+			Basis:            types.RoleQuorumBasisPower,
+		},
+		{
+			Role:             "core_contributor",
+			Holders:          2,
+			Voters:           1,
+			Power:            math.LegacyNewDec(800),
+			VotedPower:       math.LegacyNewDec(200),
+			Participation:    math.LegacyNewDecWithPrec(5, 1),
+			MinParticipation: math.LegacyNewDecWithPrec(5, 1),
+			Basis:            types.RoleQuorumBasisHolders,
+			QuorumMet:        true,
+		},
+		{
+			// no holders, nobody can participate
+			Role:             "validator",
+			Power:            math.LegacyZeroDec(),
+			VotedPower:       math.LegacyZeroDec(),
+			Participation:    math.LegacyZeroDec(),
+			MinParticipation: math.LegacyOneDec(),
+			Basis:            types.RoleQuorumBasisHolders,
+			QuorumMet:        true,
+		},
+	}, response.RoleTallies)
+
+	_, err = qs.TallyBreakdown(f.ctx, &types.QueryTallyBreakdownRequest{ProposalId: 2})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = qs.TallyBreakdown(f.ctx, &types.QueryTallyBreakdownRequest{ProposalId: 9})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	_, err = qs.TallyBreakdown(f.ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestTallyVotesRoleQuorum(t *testing.T) {
+	f := initFixture(t)
+	valAddr, validators := tallyValidators(t, f)
+
+	alice := setRoleHolder(t, f, 0, "alice", "core_contributor", "2.0")
+	carol := setRoleHolder(t, f, 1, "carol", "community_member", "1.0")
+	f.stakingKeeper.Delegate(testAccAddress("alice"), valAddr, 100)
+	f.stakingKeeper.Delegate(testAccAddress("carol"), valAddr, 50)
+
+	setRoleQuorums(t, f, types.RoleQuorum{Role: "core_contributor", MinParticipation: math.LegacyOneDec(), Basis: types.RoleQuorumBasisPower})
+
+	// no core contributor voted
+	_, results, err := f.keeper.TallyVotes(f.ctx, v1.Proposal{Id: 1}, []v1.Vote{newVote(carol, v1.OptionYes)}, copyValidators(validators))
+	require.NoError(t, err)
+	require.True(t, results[v1.OptionYes].IsZero())
+	require.Equal(t, math.LegacyNewDec(50), results[v1.OptionAbstain])
+
+	votes := []v1.Vote{newVote(carol, v1.OptionYes), newVote(alice, v1.OptionYes)}
+	_, results, err = f.keeper.TallyVotes(f.ctx, v1.Proposal{Id: 1}, votes, copyValidators(validators))
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(250), weightedYes(t, f, 1))
+	require.Equal(t, math.LegacyNewDec(150), results[v1.OptionYes])
+}
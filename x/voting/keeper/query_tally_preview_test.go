@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmos-weighted-governance-sdk/x/voting/keeper"
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+func TestTallyPreviewQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	valAddr := f.stakingKeeper.SetValidator(sdk.ValAddress(testAccAddress("validator")), 1000)
+	alice := setRoleHolder(t, f, 0, "alice", "core_contributor", "4.0")
+	bob := setRoleHolder(t, f, 1, "bob", "community_member", "1.0")
+	f.stakingKeeper.Delegate(testAccAddress("alice"), valAddr, 100)
+	f.stakingKeeper.Delegate(testAccAddress("bob"), valAddr, 300)
+
+	f.govKeeper.SetProposal(1)
+	f.govKeeper.Vote(1, newVote(alice, v1.OptionYes))
+	f.govKeeper.Vote(1, newVote(bob, v1.OptionNo))
+
+	response, err := qs.TallyPreview(f.ctx, &types.QueryTallyPreviewRequest{ProposalId: 1})
+	require.NoError(t, err)
+
+	// stake only, yes 100 of 400 does not reach the threshold
+	require.Equal(t, types.TallyPreview{
+		Result:        v1.TallyResult{YesCount: "100", AbstainCount: "0", NoCount: "300", NoWithVetoCount: "0"},
+		TotalPower:    math.LegacyNewDec(400),
+		Turnout:       math.LegacyNewDecWithPrec(4, 1),
+		QuorumReached: true,
+	}, response.StakeTally)
+
+	// weighted, yes 100 * 4.0 of 700 passes, the turnout still counts the 400 staked
+	require.Equal(t, types.TallyPreview{
+		Result:           v1.TallyResult{YesCount: "400", AbstainCount: "0", NoCount: "300", NoWithVetoCount: "0"},
+		TotalPower:       math.LegacyNewDec(700),
+		Turnout:          math.LegacyNewDecWithPrec(4, 1),
+		QuorumReached:    true,
+		ThresholdReached: true,
+		Passes:           true,
+	}, response.WeightedTally)
+
+	// the preview applies the same rejections as the tally
+	setRoleQuorums(t, f, types.RoleQuorum{Role: "validator", MinParticipation: math.LegacyOneDec(), Basis: types.RoleQuorumBasisHolders})
+	setRoleHolder(t, f, 2, "carol", "validator", "1.0")
+	response, err = qs.TallyPreview(f.ctx, &types.QueryTallyPreviewRequest{ProposalId: 1})
+	require.NoError(t, err)
+	require.Equal(t, "0", response.WeightedTally.Result.YesCount)
+	require.False(t, response.WeightedTally.Passes)
+
+	// a preview stores nothing
+	has, err := f.keeper.PendingRoleTallies.Has(f.ctx, 1)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	passed := f.govKeeper.SetProposal(2)
+	passed.Status = v1.StatusPassed
+	f.govKeeper.proposals[2] = passed
+	_, err = qs.TallyPreview(f.ctx, &types.QueryTallyPreviewRequest{ProposalId: 2})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = qs.TallyPreview(f.ctx, &types.QueryTallyPreviewRequest{ProposalId: 9})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	_, err = qs.TallyPreview(f.ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListTimelock(ctx context.Context, req *types.QueryAllTimelockRequest) (*types.QueryAllTimelockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	timelocks, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Timelocks,
+		req.Pagination,
+		func(_ uint64, value types.Timelock) (types.Timelock, error) {
+			return value, nil
+		},
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllTimelockResponse{Timelock: timelocks, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetTimelock(ctx context.Context, req *types.QueryGetTimelockRequest) (*types.QueryGetTimelockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	timelock, err := q.k.Timelocks.Get(ctx, req.ProposalId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetTimelockResponse{Timelock: timelock}, nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) VoteDelegationFrom(ctx context.Context, req *types.QueryVoteDelegationFromRequest) (*types.QueryVoteDelegationFromResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	delegation, err := q.k.VoteDelegations.Get(ctx, req.Delegator)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryVoteDelegationFromResponse{VoteDelegation: delegation}, nil
+}
+
+func (q queryServer) VoteDelegationsTo(ctx context.Context, req *types.QueryVoteDelegationsToRequest) (*types.QueryVoteDelegationsToResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Representative == "" {
+		return nil, status.Error(codes.InvalidArgument, "representative cannot be empty")
+	}
+
+	delegations, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.VoteDelegationsByRepresentative,
+		req.Pagination,
+		func(key collections.Pair[string, string], _ collections.NoValue) (types.VoteDelegation, error) {
+			return q.k.VoteDelegations.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.Representative),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVoteDelegationsToResponse{VoteDelegations: delegations, Pagination: pageRes}, nil
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmos-weighted-governance-sdk/x/voting/keeper"
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+func TestVotingPowerQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	bonded := f.stakingKeeper.SetValidator(sdk.ValAddress(testAccAddress("bonded")), 1000)
+	unbonded := f.stakingKeeper.SetValidator(sdk.ValAddress(testAccAddress("unbonded")), 1000)
+	val := f.stakingKeeper.validators[string(testAccAddress("unbonded"))]
+	val.Status = stakingtypes.Unbonded
+	f.stakingKeeper.validators[string(testAccAddress("unbonded"))] = val
+
+	alice := setRoleHolder(t, f, 0, "alice", "validator", "1.5")
+	bob, err := f.addressCodec.BytesToString(testAccAddress("bob"))
+	require.NoError(t, err)
+
+	f.stakingKeeper.Delegate(testAccAddress("alice"), bonded, 400)
+	f.stakingKeeper.Delegate(testAccAddress("alice"), unbonded, 500)
+	f.stakingKeeper.Delegate(testAccAddress("bob"), bonded, 100)
+
+	params := types.DefaultParams()
+	params.PowerTransform = types.PowerTransformSqrt
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	tests := []struct {
+		desc     string
+		request  *types.QueryVotingPowerRequest
+		response *types.QueryVotingPowerResponse
+		err      error
+	}{
+		{
+			desc:    "role holder",
+			request: &types.QueryVotingPowerRequest{Address: alice},
+			response: &types.QueryVotingPowerResponse{
+				RawPower:         math.LegacyNewDec(400),
+				TransformedPower: math.LegacyNewDec(20),
+				Multiplier:       math.LegacyMustNewDecFromStr("1.5"),
+				WeightedPower:    math.LegacyNewDec(30),
+				PowerTransform:   types.PowerTransformSqrt,
+			},
+		},
+		{
+			desc:    "no role",
+			request: &types.QueryVotingPowerRequest{Address: bob},
+			response: &types.QueryVotingPowerResponse{
+				RawPower:         math.LegacyNewDec(100),
+				TransformedPower: math.LegacyNewDec(10),
+				Multiplier:       math.LegacyOneDec(),
+				WeightedPower:    math.LegacyNewDec(10),
+				PowerTransform:   types.PowerTransformSqrt,
+			},
+		},
+		{
+			desc:    "invalid address",
+			request: &types.QueryVotingPowerRequest{Address: "invalid"},
+			err:     status.Error(codes.InvalidArgument, "invalid address"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := qs.VotingPower(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
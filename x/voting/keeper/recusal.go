@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+var (
+	msgCreateVoterRoleURL    = sdk.MsgTypeURL(&types.MsgCreateVoterRole{})
+	msgUpdateVoterRoleURL    = sdk.MsgTypeURL(&types.MsgUpdateVoterRole{})
+	msgDeleteVoterRoleURL    = sdk.MsgTypeURL(&types.MsgDeleteVoterRole{})
+	msgSuspendVoterRoleURL   = sdk.MsgTypeURL(&types.MsgSuspendVoterRole{})
+	msgReinstateVoterRoleURL = sdk.MsgTypeURL(&types.MsgReinstateVoterRole{})
+	msgTimelockMessagesURL   = sdk.MsgTypeURL(&types.MsgTimelockMessages{})
+	msgApproveNominationURL  = sdk.MsgTypeURL(&types.MsgApproveNomination{})
+)
+
+// RecusedVoters returns the addresses whose voter role the proposal creates, updates,
+// suspends, reinstates or deletes, including messages held in a MsgTimelockMessages. An update recuses both the
+// current holder of the role and the address it is moved to, and approving a nomination
+// recuses the nominee. A MsgCreateRoleDistribution recuses no one: it only carries the
+// Merkle root of its leaves, so the listed addresses are unknown until they claim.
+func (k Keeper) RecusedVoters(ctx context.Context, proposal v1.Proposal) (map[string]bool, error) {
+	recused := make(map[string]bool)
+	if err := k.recuse(ctx, proposal.Messages, recused); err != nil {
+		return nil, err
+	}
+
+	return recused, nil
+}
+
+func (k Keeper) recuse(ctx context.Context, messages []*codectypes.Any, recused map[string]bool) error {
+	for _, message := range messages {
+		// only role messages are unpacked, other messages cannot change a role
+		switch message.TypeUrl {
+		case msgCreateVoterRoleURL, msgUpdateVoterRoleURL, msgDeleteVoterRoleURL, msgSuspendVoterRoleURL,
+			msgReinstateVoterRoleURL, msgTimelockMessagesURL, msgApproveNominationURL:
+		default:
+			continue
+		}
+
+		var msg sdk.Msg
+		if err := k.cdc.UnpackAny(message, &msg); err != nil {
+			return err
+		}
+
+		switch msg := msg.(type) {
+		case *types.MsgTimelockMessages:
+			if err := k.recuse(ctx, msg.Messages, recused); err != nil {
+				return err
+			}
+		case *types.MsgCreateVoterRole:
+			recused[msg.Address] = true
+		case *types.MsgUpdateVoterRole:
+			recused[msg.Address] = true
+			if err := k.recuseHolder(ctx, msg.Id, recused); err != nil {
+				return err
+			}
+		case *types.MsgDeleteVoterRole:
+			if err := k.recuseHolder(ctx, msg.Id, recused); err != nil {
+				return err
+			}
+		case *types.MsgSuspendVoterRole:
+			if err := k.recuseHolder(ctx, msg.Id, recused); err != nil {
+				return err
+			}
+		case *types.MsgReinstateVoterRole:
+			if err := k.recuseHolder(ctx, msg.Id, recused); err != nil {
+				return err
+			}
+		case *types.MsgApproveNomination:
+			if err := k.recuseNominee(ctx, msg.Id, recused); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// recuseHolder recuses the current holder of a voter role, if the role still exists.
+func (k Keeper) recuseHolder(ctx context.Context, id uint64, recused map[string]bool) error {
+	role, err := k.VoterRole.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	recused[role.Address] = true
+	return nil
+}
+
+// recuseNominee recuses the nominee of a nomination, if the nomination is still pending.
+func (k Keeper) recuseNominee(ctx context.Context, id uint64, recused map[string]bool) error {
+	nomination, err := k.Nominations.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	recused[nomination.Address] = true
+	return nil
+}
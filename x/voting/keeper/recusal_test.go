@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+
+	"cosmos-weighted-governance-sdk/x/voting/keeper"
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// setMsgsProposal records a proposal in voting period carrying the given messages.
+func setMsgsProposal(t *testing.T, f *fixture, id uint64, msgs ...sdk.Msg) v1.Proposal {
+	t.Helper()
+
+	anys, err := sdktx.SetMsgs(msgs)
+	require.NoError(t, err)
+	proposal := v1.Proposal{Id: id, Status: v1.StatusVotingPeriod, Messages: anys}
+	f.govKeeper.proposals[id] = proposal
+	return proposal
+}
+
+func TestRecusedVoters(t *testing.T) {
+	f := initFixture(t)
+
+	alice := setRoleHolder(t, f, 0, "alice", "core_contributor", "2.0")
+	bob := setRoleHolder(t, f, 1, "bob", "core_contributor", "2.0")
+	setRoleHolder(t, f, 2, "carol", "community_member", "1.0")
+	dave, err := f.addressCodec.BytesToString(testAccAddress("dave"))
+	require.NoError(t, err)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	wrapper, err := types.NewMsgTimelockMessages(authority, []sdk.Msg{&types.MsgDeleteVoterRole{Creator: authority, Id: 1}})
+	require.NoError(t, err)
+
+	proposal := setMsgsProposal(t, f, 1,
+		// moving alice's role to dave recuses both
+		&types.MsgUpdateVoterRole{Creator: authority, Id: 0, Address: dave, Role: "core_contributor", Multiplier: "3.0"},
+		wrapper,
+		&types.MsgDeleteVoterRole{Creator: authority, Id: 9},
+		types.NewMsgVetoProposal(authority, 1),
+	)
+
+	recused, err := f.keeper.RecusedVoters(f.ctx, proposal)
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{alice: true, bob: true, dave: true}, recused)
+
+	recused, err = f.keeper.RecusedVoters(f.ctx, f.govKeeper.SetProposal(2, msgSendURL))
+	require.NoError(t, err)
+	require.Empty(t, recused)
+
+	// suspending or reinstating a role recuses its holder
+	erin := setRoleHolder(t, f, 3, "erin", "core_contributor", "2.0")
+	frank := setRoleHolder(t, f, 4, "frank", "core_contributor", "2.0")
+	wrapper, err = types.NewMsgTimelockMessages(authority, []sdk.Msg{&types.MsgReinstateVoterRole{Authority: authority, Id: 4}})
+	require.NoError(t, err)
+	proposal = setMsgsProposal(t, f, 3,
+		&types.MsgSuspendVoterRole{Authority: authority, Id: 3, Reason: "inactive"},
+		wrapper,
+	)
+	recused, err = f.keeper.RecusedVoters(f.ctx, proposal)
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{erin: true, frank: true}, recused)
+
+	// approving a nomination recuses the nominee, unless the nomination is gone
+	gina, err := f.addressCodec.BytesToString(testAccAddress("gina"))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.Nominations.Set(f.ctx, 0, types.Nomination{Id: 0, Address: gina, Role: "core_contributor"}))
+	proposal = setMsgsProposal(t, f, 4,
+		&types.MsgApproveNomination{Authority: authority, Id: 0, Multiplier: "2.0"},
+		&types.MsgApproveNomination{Authority: authority, Id: 9, Multiplier: "2.0"},
+	)
+	recused, err = f.keeper.RecusedVoters(f.ctx, proposal)
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{gina: true}, recused)
+}
+
+func TestTallyVotesRecusal(t *testing.T) {
+	for _, tc := range []struct {
+		mode     string
+		yes      math.LegacyDec
+		roleSelf types.RoleTally
+	}{
+		{
+			// alice counts 100 * 1.0 instead of 100 * 2.0
+			mode: types.RecusalModeNeutral,
+			yes:  math.LegacyNewDec(150),
+			roleSelf: types.RoleTally{
+				Role:             "core_contributor",
+				Holders:          1,
+				Voters:           1,
+				Power:            math.LegacyNewDec(100),
+				VotedPower:       math.LegacyNewDec(100),
+				Participation:    math.LegacyOneDec(),
+				MinParticipation: math.LegacyZeroDec(),
+				Basis:            types.RoleQuorumBasisHolders,
+				QuorumMet:        true,
+				Recused:          1,
+			},
+		},
+		{
+			// the vote of alice is not counted, and the validator does not inherit the stake
+			mode: types.RecusalModeExclude,
+			yes:  math.LegacyNewDec(50),
+			roleSelf: types.RoleTally{
+				Role:             "core_contributor",
+				Power:            math.LegacyZeroDec(),
+				VotedPower:       math.LegacyZeroDec(),
+				Participation:    math.LegacyZeroDec(),
+				MinParticipation: math.LegacyZeroDec(),
+				Basis:            types.RoleQuorumBasisHolders,
+				QuorumMet:        true,
+				Recused:          1,
+			},
+		},
+	} {
+		t.Run(tc.mode, func(t *testing.T) {
+			f := initFixture(t)
+			qs := keeper.NewQueryServerImpl(f.keeper)
+
+			valAddr := f.stakingKeeper.SetValidator(sdk.ValAddress(testAccAddress("validator")), 1000)
+			alice := setRoleHolder(t, f, 0, "alice", "core_contributor", "2.0")
+			bob := setRoleHolder(t, f, 1, "bob", "community_member", "1.0")
+			validatorAcc, err := f.addressCodec.BytesToString(testAccAddress("validator"))
+			require.NoError(t, err)
+			f.stakingKeeper.Delegate(testAccAddress("alice"), valAddr, 100)
+			f.stakingKeeper.Delegate(testAccAddress("bob"), valAddr, 50)
+
+			params := types.DefaultParams()
+			params.RecusalMode = tc.mode
+			require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+			authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+			require.NoError(t, err)
+			proposal := setMsgsProposal(t, f, 1,
+				&types.MsgUpdateVoterRole{Creator: authority, Id: 0, Address: alice, Role: "core_contributor", Multiplier: "3.0"},
+			)
+			f.govKeeper.Vote(1, newVote(alice, v1.OptionYes))
+			f.govKeeper.Vote(1, newVote(bob, v1.OptionYes))
+			f.govKeeper.Vote(1, newVote(validatorAcc, v1.OptionAbstain))
+
+			response, err := qs.TallyBreakdown(f.ctx, &types.QueryTallyBreakdownRequest{ProposalId: 1})
+			require.NoError(t, err)
+			require.Equal(t, []string{alice}, response.Recused)
+			require.Equal(t, tc.roleSelf, response.RoleTallies[1])
+
+			_, validators := tallyValidators(t, f)
+			_, results, err := f.keeper.TallyVotes(f.ctx, proposal, f.govKeeper.votes[1], validators)
+			require.NoError(t, err)
+			require.Equal(t, tc.yes, results[v1.OptionYes])
+			// the validator keeps 1000 - 150 deducted
+			require.Equal(t, math.LegacyNewDec(850), results[v1.OptionAbstain])
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// votingMsgPrefix is the type URL prefix of the voting module messages.
+var votingMsgPrefix = strings.TrimSuffix(sdk.MsgTypeURL(&types.MsgUpdateParams{}), "MsgUpdateParams")
+
+// IsRegistryProposal reports whether the proposal contains voting module messages, which
+// change the voter role registry or the voting params. Messages held in a
+// MsgTimelockMessages are counted, the wrapper itself is not.
+func (k Keeper) IsRegistryProposal(proposal v1.Proposal) (bool, error) {
+	registry, _, err := k.countProposalMsgs(proposal.Messages)
+	return registry > 0, err
+}
+
+// ValidateRegistryProposal rejects proposals that mix voting module messages with other
+// messages, so that ordinary messages cannot pass under the registry tally and registry
+// changes cannot hide in ordinary proposals.
+func (k Keeper) ValidateRegistryProposal(proposal v1.Proposal) error {
+	registry, other, err := k.countProposalMsgs(proposal.Messages)
+	if err != nil {
+		return err
+	}
+	if registry > 0 && other > 0 {
+		return errorsmod.Wrapf(types.ErrMixedRegistryProposal, "proposal %d", proposal.Id)
+	}
+
+	return nil
+}
+
+// countProposalMsgs counts the voting module messages and the other messages, looking
+// into MsgTimelockMessages.
+func (k Keeper) countProposalMsgs(messages []*codectypes.Any) (registry, other int, err error) {
+	for _, message := range messages {
+		switch {
+		case message.TypeUrl == msgTimelockMessagesURL:
+			var msg sdk.Msg
+			if err := k.cdc.UnpackAny(message, &msg); err != nil {
+				return 0, 0, err
+			}
+			wrapper, ok := msg.(*types.MsgTimelockMessages)
+			if !ok {
+				return 0, 0, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "expected %s, got %T", msgTimelockMessagesURL, msg)
+			}
+
+			heldRegistry, heldOther, err := k.countProposalMsgs(wrapper.Messages)
+			if err != nil {
+				return 0, 0, err
+			}
+			registry += heldRegistry
+			other += heldOther
+		case strings.HasPrefix(message.TypeUrl, votingMsgPrefix):
+			registry++
+		default:
+			other++
+		}
+	}
+
+	return registry, other, nil
+}
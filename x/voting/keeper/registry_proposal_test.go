@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+func TestValidateRegistryProposal(t *testing.T) {
+	f := initFixture(t)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	updateParams := &types.MsgUpdateParams{Authority: authority, Params: types.DefaultParams()}
+	send := &banktypes.MsgSend{FromAddress: authority, ToAddress: authority}
+	wrap := func(msgs ...sdk.Msg) sdk.Msg {
+		wrapper, err := types.NewMsgTimelockMessages(authority, msgs)
+		require.NoError(t, err)
+		return wrapper
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		msgs     []sdk.Msg
+		registry bool
+		err      error
+	}{
+		{
+			desc:     "registry",
+			msgs:     []sdk.Msg{updateParams, &types.MsgDeleteVoterRole{Creator: authority, Id: 1}},
+			registry: true,
+		},
+		{
+			desc: "ordinary",
+			msgs: []sdk.Msg{send},
+		},
+		{
+			desc:     "timelocked registry",
+			msgs:     []sdk.Msg{wrap(updateParams)},
+			registry: true,
+		},
+		{
+			desc:     "mixed",
+			msgs:     []sdk.Msg{updateParams, send},
+			registry: true,
+			err:      types.ErrMixedRegistryProposal,
+		},
+		{
+			desc:     "mixed across timelocks",
+			msgs:     []sdk.Msg{wrap(updateParams), wrap(send)},
+			registry: true,
+			err:      types.ErrMixedRegistryProposal,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			proposal := setMsgsProposal(t, f, 1, tc.msgs...)
+
+			registry, err := f.keeper.IsRegistryProposal(proposal)
+			require.NoError(t, err)
+			require.Equal(t, tc.registry, registry)
+
+			err = f.keeper.ValidateRegistryProposal(proposal)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTallyVotesRegistryProposal(t *testing.T) {
+	f := initFixture(t)
+	valAddr, validators := tallyValidators(t, f)
+
+	alice := setRoleHolder(t, f, 0, "alice", "core_contributor", "2.0")
+	bob := setRoleHolder(t, f, 1, "bob", "community_member", "1.0")
+	carol, err := f.addressCodec.BytesToString(testAccAddress("carol"))
+	require.NoError(t, err)
+	f.stakingKeeper.Delegate(testAccAddress("alice"), valAddr, 100)
+	f.stakingKeeper.Delegate(testAccAddress("bob"), valAddr, 50)
+	f.stakingKeeper.Delegate(testAccAddress("carol"), valAddr, 60)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	ordinary := v1.Proposal{Id: 1, Messages: []*codectypes.Any{{TypeUrl: msgSendURL}}}
+	registry := setMsgsProposal(t, f, 2, &types.MsgUpdateParams{Authority: authority, Params: types.DefaultParams()})
+
+	tally := func(proposal v1.Proposal, votes ...v1.Vote) map[v1.VoteOption]math.LegacyDec {
+		_, results, err := f.keeper.TallyVotes(f.ctx, proposal, votes, copyValidators(validators))
+		require.NoError(t, err)
+		return results
+	}
+
+	// yes 200 of 310 non-abstaining passes the x/gov threshold but not the registry threshold,
+	// x/gov receives its share of the 210 staked that voted
+	votes := []v1.Vote{newVote(alice, v1.OptionYes), newVote(bob, v1.OptionNo), newVote(carol, v1.OptionNo)}
+	yes := govShare(math.LegacyNewDec(200), math.LegacyNewDec(310), math.LegacyNewDec(210))
+	require.Equal(t, yes, tally(ordinary, votes...)[v1.OptionYes])
+	results := tally(registry, votes...)
+	require.True(t, results[v1.OptionYes].IsZero())
+	require.Equal(t, yes, results[v1.OptionAbstain])
+
+	// the registry quorum is measured on the bonded power
+	require.True(t, tally(registry, newVote(alice, v1.OptionYes))[v1.OptionYes].IsZero())
+
+	params := types.DefaultParams()
+	params.RegistryTally = types.NewRegistryTallyParams(math.LegacyNewDecWithPrec(1, 1), types.DefaultRegistryThreshold, math.LegacyNewDecWithPrec(1, 1))
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	// yes 200 of 250 passes the threshold, but the veto 50 of 250 exceeds the veto threshold
+	votes = []v1.Vote{newVote(alice, v1.OptionYes), newVote(bob, v1.OptionNoWithVeto)}
+	require.True(t, tally(registry, votes...)[v1.OptionYes].IsZero())
+	require.Equal(t, govShare(math.LegacyNewDec(200), math.LegacyNewDec(250), math.LegacyNewDec(150)), tally(ordinary, votes...)[v1.OptionYes])
+
+	// in bicameral mode the registry params replace the params of both chambers
+	params.TallyMode = types.TallyModeBicameral
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	tally(registry, votes...)
+	chamberTally, err := f.keeper.ChamberTallies.Get(f.ctx, registry.Id)
+	require.NoError(t, err)
+	require.False(t, chamberTally.TokenHousePassed)
+	require.False(t, chamberTally.RoleHousePassed)
+
+	tally(ordinary, votes...)
+	chamberTally, err = f.keeper.ChamberTallies.Get(f.ctx, ordinary.Id)
+	require.NoError(t, err)
+	require.True(t, chamberTally.RoleHousePassed)
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// roleAuthority resolves the authority a signer changes voter roles with. It returns nil
+// for the module authority, which may apply any change, and the matching role authority
+// from the params otherwise.
+func (k Keeper) roleAuthority(ctx context.Context, signer string) (*types.RoleAuthority, error) {
+	signerBz, err := k.addressCodec.StringToBytes(signer)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address: %s", err)
+	}
+
+	// x/gov keeps full control over the registry
+	if bytes.Equal(k.GetAuthority(), signerBz) {
+		return nil, nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get module params")
+	}
+
+	for _, authority := range params.RoleAuthorities {
+		if authority.Address == signer {
+			return &authority, nil
+		}
+	}
+
+	expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+	return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "expected %s or a role authority, got %s", expectedAuthorityStr, signer)
+}
+
+// authorizeRoleChange checks that a role authority may apply the operation to the voter
+// roles: only its operations, on its roles, writing multipliers, overrides included,
+// within its band. existing is the role before the change and updated the role after
+// it, either may be nil. Role authorities cannot touch veto holders. A nil authority,
+// the module authority, may apply any change.
+func authorizeRoleChange(authority *types.RoleAuthority, operation string, existing, updated *types.VoterRole) error {
+	if authority == nil {
+		return nil
+	}
+	signer := authority.Address
+
+	if !slices.Contains(authority.Operations, operation) {
+		return errorsmod.Wrapf(types.ErrUnauthorizedRoleChange, "%s cannot %s voter roles", signer, operation)
+	}
+
+	for _, role := range []*types.VoterRole{existing, updated} {
+		if role == nil {
+			continue
+		}
+		if len(authority.Roles) > 0 && !slices.Contains(authority.Roles, role.Role) {
+			return errorsmod.Wrapf(types.ErrUnauthorizedRoleChange, "%s cannot %s %s roles", signer, operation, role.Role)
+		}
+		if role.VetoHolder {
+			return errorsmod.Wrapf(types.ErrUnauthorizedRoleChange, "%s cannot %s veto holders", signer, operation)
+		}
+	}
+
+	if updated == nil {
+		return nil
+	}
+
+	multipliers := []string{updated.Multiplier}
+	for _, override := range updated.MsgTypeMultipliers {
+		multipliers = append(multipliers, override.Multiplier)
+	}
+	for _, multiplier := range multipliers {
+		value, err := math.LegacyNewDecFromStr(multiplier)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid multiplier %q", multiplier)
+		}
+		if value.LT(authority.MinMultiplier) || value.GT(authority.MaxMultiplier) {
+			return errorsmod.Wrapf(types.ErrUnauthorizedRoleChange, "multiplier %s outside of [%s, %s]",
+				multiplier, authority.MinMultiplier, authority.MaxMultiplier)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmos-weighted-governance-sdk/x/voting/keeper"
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// eventAttribute returns the value of an attribute of the last event of the given type.
+func eventAttribute(t *testing.T, ctx sdk.Context, eventType, key string) string {
+	t.Helper()
+
+	events := ctx.EventManager().Events()
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type != eventType {
+			continue
+		}
+		attribute, ok := events[i].GetAttribute(key)
+		require.True(t, ok)
+		return attribute.Value
+	}
+
+	require.Failf(t, "event not found", "no %s event", eventType)
+	return ""
+}
+
+func TestRoleAuthority(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	authority := authorityMsg(t, f).Authority
+
+	council, err := f.addressCodec.BytesToString(testAccAddress("council"))
+	require.NoError(t, err)
+	alice := setRoleHolder(t, f, 9, "alice", "core_contributor", "2.0")
+	bob, err := f.addressCodec.BytesToString(testAccAddress("bob"))
+	require.NoError(t, err)
+	carol, err := f.addressCodec.BytesToString(testAccAddress("carol"))
+	require.NoError(t, err)
+
+	// the council creates and updates community members within [0.5, 1.5]
+	params := types.DefaultParams()
+	params.RoleCreationCooldown = 0
+	params.RoleAuthorities = []types.RoleAuthority{types.NewRoleAuthority(council,
+		[]string{types.RoleOperationCreate, types.RoleOperationUpdate, types.RoleOperationSuspend},
+		[]string{"community_member"}, math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(15, 1))}
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	create := func(signer, role, multiplier string) error {
+		_, err := srv.CreateVoterRole(f.ctx, &types.MsgCreateVoterRole{Creator: signer, Address: bob, Role: role, Multiplier: multiplier})
+		return err
+	}
+	require.ErrorIs(t, create(carol, "community_member", "1.0"), types.ErrInvalidSigner)
+	require.ErrorIs(t, create(council, "core_contributor", "1.0"), types.ErrUnauthorizedRoleChange)
+	require.ErrorIs(t, create(council, "community_member", "2.0"), types.ErrUnauthorizedRoleChange)
+	_, err = srv.CreateVoterRole(f.ctx, &types.MsgCreateVoterRole{Creator: council, Address: bob, Role: "community_member", Multiplier: "1.0", VetoHolder: true})
+	require.ErrorIs(t, err, types.ErrUnauthorizedRoleChange)
+	_, err = srv.CreateVoterRole(f.ctx, &types.MsgCreateVoterRole{
+		Creator: council, Address: bob, Role: "community_member", Multiplier: "1.0",
+		MsgTypeMultipliers: []types.MsgTypeMultiplier{{MsgTypeUrl: msgSendURL, Multiplier: "3.0"}},
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorizedRoleChange)
+
+	res, err := srv.CreateVoterRole(f.ctx, &types.MsgCreateVoterRole{Creator: council, Address: bob, Role: "community_member", Multiplier: "1.0"})
+	require.NoError(t, err)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	require.Equal(t, council, eventAttribute(t, ctx, types.EventTypeVoterRoleCreated, types.AttributeKeyAuthority))
+
+	update := func(signer string, id uint64, role, multiplier string) error {
+		_, err := srv.UpdateVoterRole(f.ctx, &types.MsgUpdateVoterRole{Creator: signer, Id: id, Address: bob, Role: role, Multiplier: multiplier})
+		return err
+	}
+	require.NoError(t, update(council, res.Id, "community_member", "1.5"))
+	require.Equal(t, council, eventAttribute(t, ctx, types.EventTypeVoterRoleUpdated, types.AttributeKeyAuthority))
+	require.ErrorIs(t, update(council, res.Id, "community_member", "1.6"), types.ErrUnauthorizedRoleChange)
+	// a council cannot promote its members, nor change the roles of others
+	require.ErrorIs(t, update(council, res.Id, "core_contributor", "1.0"), types.ErrUnauthorizedRoleChange)
+	_, err = srv.UpdateVoterRole(f.ctx, &types.MsgUpdateVoterRole{Creator: council, Id: 9, Address: alice, Role: "community_member", Multiplier: "1.0"})
+	require.ErrorIs(t, err, types.ErrUnauthorizedRoleChange)
+
+	// only the operations of the council are allowed
+	_, err = srv.SuspendVoterRole(f.ctx, types.NewMsgSuspendVoterRole(council, res.Id, "investigation", 0))
+	require.NoError(t, err)
+	require.Equal(t, council, eventAttribute(t, ctx, types.EventTypeVoterRoleSuspended, types.AttributeKeyAuthority))
+	_, err = srv.ReinstateVoterRole(f.ctx, types.NewMsgReinstateVoterRole(council, res.Id))
+	require.ErrorIs(t, err, types.ErrUnauthorizedRoleChange)
+	_, err = srv.DeleteVoterRole(f.ctx, &types.MsgDeleteVoterRole{Creator: council, Id: res.Id})
+	require.ErrorIs(t, err, types.ErrUnauthorizedRoleChange)
+
+	// gov stays unrestricted
+	require.NoError(t, update(authority, res.Id, "core_contributor", "5.0"))
+	require.Equal(t, authority, eventAttribute(t, ctx, types.EventTypeVoterRoleUpdated, types.AttributeKeyAuthority))
+	_, err = srv.DeleteVoterRole(f.ctx, &types.MsgDeleteVoterRole{Creator: authority, Id: res.Id})
+	require.NoError(t, err)
+	require.Equal(t, authority, eventAttribute(t, ctx, types.EventTypeVoterRoleDeleted, types.AttributeKeyAuthority))
+}
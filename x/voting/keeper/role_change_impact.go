@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// RoleChangeImpact applies the messages of an active registry proposal to a cached context
+// and previews the weighted tally of every other active proposal before and after them.
+// The cached context is discarded.
+func (k Keeper) RoleChangeImpact(ctx context.Context, proposalID uint64) ([]types.ProposalImpact, error) {
+	proposal, err := k.getProposal(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	if proposal.Status != v1.StatusVotingPeriod {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "proposal %d is not in voting period", proposalID)
+	}
+
+	registry, err := k.IsRegistryProposal(proposal)
+	if err != nil {
+		return nil, err
+	}
+	if !registry {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "proposal %d contains no voting module messages", proposalID)
+	}
+
+	govParams, err := k.getGovParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting gov params: %w", err)
+	}
+
+	active, err := k.getActiveProposals(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	if err := k.applyProposalMessages(cacheCtx, proposal); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "proposal %d messages fail: %s", proposalID, err)
+	}
+
+	impacts := make([]types.ProposalImpact, 0, len(active))
+	for _, other := range active {
+		if other.Id == proposal.Id {
+			continue
+		}
+
+		before, beforeResults, err := k.previewWeighted(ctx, govParams, other)
+		if err != nil {
+			return nil, err
+		}
+		after, afterResults, err := k.previewWeighted(cacheCtx, govParams, other)
+		if err != nil {
+			return nil, err
+		}
+
+		difference := make(map[v1.VoteOption]math.LegacyDec, len(afterResults.results))
+		for option, power := range afterResults.results {
+			difference[option] = power.Sub(beforeResults.results[option])
+		}
+
+		impacts = append(impacts, types.ProposalImpact{
+			ProposalId: other.Id,
+			Before:     before,
+			After:      after,
+			Difference: v1.NewTallyResultFromMap(difference),
+		})
+	}
+
+	return impacts, nil
+}
+
+// previewWeighted previews the tally x/gov would receive for a proposal with its current votes.
+func (k Keeper) previewWeighted(ctx context.Context, govParams v1.Params, proposal v1.Proposal) (types.TallyPreview, *chamber, error) {
+	votes, err := k.getVotes(ctx, proposal.Id)
+	if err != nil {
+		return types.TallyPreview{}, nil, err
+	}
+
+	validators, err := k.bondedValidators(ctx)
+	if err != nil {
+		return types.TallyPreview{}, nil, err
+	}
+
+	outcome, results, err := k.previewTally(ctx, proposal, votes, validators)
+	if err != nil {
+		return types.TallyPreview{}, nil, err
+	}
+
+	preview, err := results.preview(govParams, proposal.Expedited, outcome.bondedPower)
+	return preview, results, err
+}
+
+// applyProposalMessages executes the messages of a proposal, including the messages held
+// in a MsgTimelockMessages, like x/gov would once it passed.
+func (k Keeper) applyProposalMessages(ctx sdk.Context, proposal v1.Proposal) error {
+	messages, err := proposal.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	for _, msg := range messages {
+		held := []sdk.Msg{msg}
+		if wrapper, ok := msg.(*types.MsgTimelockMessages); ok {
+			if held, err = wrapper.GetMsgs(); err != nil {
+				return err
+			}
+		}
+
+		for _, msg := range held {
+			if _, err := k.safeExecute(ctx, msg); err != nil {
+				return fmt.Errorf("%s: %w", sdk.MsgTypeURL(msg), err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmos-weighted-governance-sdk/x/voting/keeper"
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+func TestRoleChangeImpactQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	f.router.Register(sdk.MsgTypeURL(&types.MsgUpdateVoterRole{}), func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		_, err := srv.UpdateVoterRole(ctx, msg.(*types.MsgUpdateVoterRole))
+		return &sdk.Result{}, err
+	})
+
+	valAddr := f.stakingKeeper.SetValidator(sdk.ValAddress(testAccAddress("validator")), 1000)
+	alice := setRoleHolder(t, f, 0, "alice", "core_contributor", "2.0")
+	bob := setRoleHolder(t, f, 1, "bob", "community_member", "1.0")
+	f.stakingKeeper.Delegate(testAccAddress("alice"), valAddr, 100)
+	f.stakingKeeper.Delegate(testAccAddress("bob"), valAddr, 300)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	setMsgsProposal(t, f, 1, &types.MsgUpdateVoterRole{Creator: authority, Id: 0, Address: alice, Role: "core_contributor", Multiplier: "4.0"})
+	f.govKeeper.SetProposal(2)
+	f.govKeeper.Vote(2, newVote(alice, v1.OptionYes))
+	f.govKeeper.Vote(2, newVote(bob, v1.OptionNo))
+
+	response, err := qs.RoleChangeImpact(f.ctx, &types.QueryRoleChangeImpactRequest{ProposalId: 1})
+	require.NoError(t, err)
+	require.Len(t, response.Impacts, 1)
+
+	// raising alice from 2.0 to 4.0 turns yes 200 of 500 into yes 400 of 700
+	impact := response.Impacts[0]
+	require.Equal(t, uint64(2), impact.ProposalId)
+	require.Equal(t, "200", impact.Before.Result.YesCount)
+	require.False(t, impact.Before.Passes)
+	require.Equal(t, "400", impact.After.Result.YesCount)
+	require.True(t, impact.After.Passes)
+	require.Equal(t, v1.TallyResult{YesCount: "200", AbstainCount: "0", NoCount: "0", NoWithVetoCount: "0"}, impact.Difference)
+
+	// the role change is only applied to a cached state
+	role, err := f.keeper.VoterRole.Get(f.ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, "2.0", role.Multiplier)
+	_, weighted, err := f.keeper.TallyPreview(f.ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(500), weighted.TotalPower)
+
+	// proposals without voting module messages cannot change roles
+	_, err = qs.RoleChangeImpact(f.ctx, &types.QueryRoleChangeImpactRequest{ProposalId: 2})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// messages that would fail are reported
+	setMsgsProposal(t, f, 3, &types.MsgUpdateVoterRole{Creator: authority, Id: 9, Address: alice, Role: "core_contributor", Multiplier: "4.0"})
+	_, err = qs.RoleChangeImpact(f.ctx, &types.QueryRoleChangeImpactRequest{ProposalId: 3})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = qs.RoleChangeImpact(f.ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"cosmos-weighted-governance-sdk/x/voting/keeper"
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+func TestRoleDistribution(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	authority := authorityMsg(t, f).Authority
+	ctx := withBlockTime(f, 1000)
+
+	address := func(name string) string {
+		address, err := f.addressCodec.BytesToString(testAccAddress(name))
+		require.NoError(t, err)
+		return address
+	}
+	alice, bob, carol, dave := address("alice"), address("bob"), address("carol"), address("dave")
+
+	params := types.DefaultParams()
+	params.RoleBond = types.NewRoleBondParams("stake", types.DefaultRoleBondSlashFraction,
+		[]types.RoleBond{{Role: "core_contributor", Amount: math.NewInt(1000)}})
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	f.bankKeeper.balances[string(testAccAddress("carol"))] = sdk.NewCoins(sdk.NewInt64Coin("stake", 1500))
+
+	claims := []struct {
+		address, role, multiplier string
+		expiresAt                 int64
+	}{
+		{alice, "community_member", "1.0", 0},
+		{bob, "community_member", "1.5", 2000},
+		{carol, "core_contributor", "2.0", 0},
+	}
+	var hashes [][]byte
+	for _, claim := range claims {
+		hashes = append(hashes, types.RoleLeafHash(claim.address, claim.role, claim.multiplier, claim.expiresAt))
+	}
+	tree := types.NewRoleMerkleTree(hashes)
+
+	// only gov publishes roots
+	_, err := srv.CreateRoleDistribution(ctx, types.NewMsgCreateRoleDistribution(alice, tree.Root(), 1000, 1500))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = srv.CreateRoleDistribution(ctx, types.NewMsgCreateRoleDistribution(authority, []byte("root"), 1000, 1500))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.CreateRoleDistribution(ctx, types.NewMsgCreateRoleDistribution(authority, tree.Root(), 1500, 1500))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.CreateRoleDistribution(ctx, types.NewMsgCreateRoleDistribution(authority, tree.Root(), 500, 1000))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	res, err := srv.CreateRoleDistribution(ctx, types.NewMsgCreateRoleDistribution(authority, tree.Root(), 1100, 1500))
+	require.NoError(t, err)
+
+	claim := func(ctx sdk.Context, i int, claimant string) (uint64, error) {
+		c := claims[i]
+		res, err := srv.ClaimVoterRole(ctx, types.NewMsgClaimVoterRole(claimant, res.Id, c.role, c.multiplier, c.expiresAt, tree.Proof(i)))
+		if err != nil {
+			return 0, err
+		}
+		return res.VoterRoleId, nil
+	}
+
+	// the claim window bounds the claims
+	_, err = claim(ctx, 0, alice)
+	require.ErrorIs(t, err, types.ErrClaimWindowClosed)
+	_, err = claim(withBlockTime(f, 1500), 0, alice)
+	require.ErrorIs(t, err, types.ErrClaimWindowClosed)
+
+	// a proof only holds for its own leaf
+	ctx = withBlockTime(f, 1100)
+	_, err = claim(ctx, 0, dave)
+	require.ErrorIs(t, err, types.ErrInvalidMerkleProof)
+	_, err = srv.ClaimVoterRole(ctx, types.NewMsgClaimVoterRole(alice, res.Id, "community_member", "2.0", 0, tree.Proof(0)))
+	require.ErrorIs(t, err, types.ErrInvalidMerkleProof)
+	_, err = srv.ClaimVoterRole(ctx, types.NewMsgClaimVoterRole(alice, 9, "community_member", "1.0", 0, tree.Proof(0)))
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	id, err := claim(ctx, 0, alice)
+	require.NoError(t, err)
+	role, err := f.keeper.VoterRole.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.VoterRole{
+		Id: id, Creator: authority, Address: alice, Role: "community_member", Multiplier: "1.0", AddedAt: 1100, AddedBy: authority,
+	}, role)
+	_, err = claim(ctx, 0, alice)
+	require.ErrorIs(t, err, types.ErrRoleAlreadyClaimed)
+
+	// a role removed after its claim cannot be claimed again
+	require.NoError(t, f.keeper.VoterRole.Remove(ctx, id))
+	_, err = claim(ctx, 0, alice)
+	require.ErrorIs(t, err, types.ErrRoleAlreadyClaimed)
+
+	// the claimant of a bonded role posts the bond
+	id, err = claim(ctx, 2, carol)
+	require.NoError(t, err)
+	role, err = f.keeper.VoterRole.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), role.Bond)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)), f.bankKeeper.balances[string(testAccAddress("carol"))])
+
+	bobID, err := claim(ctx, 1, bob)
+	require.NoError(t, err)
+
+	distribution, err := qs.GetRoleDistribution(ctx, &types.QueryGetRoleDistributionRequest{Id: res.Id})
+	require.NoError(t, err)
+	require.Equal(t, types.RoleDistribution{
+		Id: res.Id, Authority: authority, MerkleRoot: tree.Root(), ClaimStart: 1100, ClaimEnd: 1500, Claimed: 3,
+	}, distribution.RoleDistribution)
+	claimed, err := qs.RoleClaimed(ctx, &types.QueryRoleClaimedRequest{DistributionId: res.Id, Address: bob})
+	require.NoError(t, err)
+	require.True(t, claimed.Claimed)
+	claimed, err = qs.RoleClaimed(ctx, &types.QueryRoleClaimedRequest{DistributionId: res.Id, Address: dave})
+	require.NoError(t, err)
+	require.False(t, claimed.Claimed)
+
+	// an update keeps the expiry, and the role is removed once it is reached
+	_, err = srv.UpdateVoterRole(ctx, &types.MsgUpdateVoterRole{Creator: authority, Id: bobID, Address: bob, Role: "community_member", Multiplier: "1.2"})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.ProcessRoleExpiries(withBlockTime(f, 1999)))
+	has, err := f.keeper.VoterRole.Has(ctx, bobID)
+	require.NoError(t, err)
+	require.True(t, has)
+	expired := withBlockTime(f, 2000)
+	require.NoError(t, f.keeper.ProcessRoleExpiries(expired))
+	has, err = f.keeper.VoterRole.Has(ctx, bobID)
+	require.NoError(t, err)
+	require.False(t, has)
+	require.Equal(t, bob, eventAttribute(t, expired, types.EventTypeVoterRoleExpired, types.AttributeKeyAddress))
+
+	msg, broken := keeper.EscrowedFundsInvariant(f.keeper)(ctx)
+	require.False(t, broken, msg)
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// removeVoterRole deletes a voter role, releases its bond and drops the state kept for
+// its holder. It returns the amount of the bond slashed for cause.
+func (k Keeper) removeVoterRole(ctx context.Context, role types.VoterRole, forCause bool) (sdk.Coins, error) {
+	if err := k.VoterRole.Remove(ctx, role.Id); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to delete voterRole")
+	}
+
+	slashed, err := k.releaseBond(ctx, role, forCause)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to release voterRole bond")
+	}
+
+	// vote delegations only exist between role holders
+	if err := k.RemoveVoteDelegationsFor(ctx, role.Address); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove vote delegations")
+	}
+
+	if err := k.clearDowngrade(ctx, role.Address); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to clear downgrade")
+	}
+
+	return slashed, nil
+}
+
+// ProcessRoleExpiries removes the voter roles whose expiry has been reached and refunds
+// their bond. Index entries of roles deleted meanwhile are dropped.
+func (k Keeper) ProcessRoleExpiries(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	rng := new(collections.Range[collections.Pair[int64, uint64]]).
+		EndExclusive(collections.PairPrefix[int64, uint64](sdkCtx.BlockTime().Unix() + 1))
+	var expired []collections.Pair[int64, uint64]
+	if err := k.RoleExpiryQueue.Walk(ctx, rng, func(key collections.Pair[int64, uint64]) (bool, error) {
+		expired = append(expired, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := k.RoleExpiryQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		role, err := k.VoterRole.Get(ctx, key.K2())
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if role.ExpiresAt != key.K1() {
+			continue
+		}
+
+		if _, err := k.removeVoterRole(ctx, role, false); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeVoterRoleExpired,
+				sdk.NewAttribute(types.AttributeKeyRoleID, fmt.Sprintf("%d", role.Id)),
+				sdk.NewAttribute(types.AttributeKeyAddress, role.Address),
+				sdk.NewAttribute(types.AttributeKeyRole, role.Role),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ stakingtypes.StakingHooks = StakingHooks{}
+
+// StakingHooks grants and revokes the validator role as validators bond and unbond, and
+// records delegators as community role candidates
+type StakingHooks struct {
+	k Keeper
+}
+
+// NewStakingHooks creates the staking hooks of the voting module
+func NewStakingHooks(k Keeper) StakingHooks {
+	return StakingHooks{k: k}
+}
+
+// AfterValidatorBonded grants the validator role to the operator account
+func (h StakingHooks) AfterValidatorBonded(ctx context.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return h.k.AssignValidatorRole(ctx, valAddr)
+}
+
+// AfterValidatorBeginUnbonding revokes the automatically assigned validator role
+func (h StakingHooks) AfterValidatorBeginUnbonding(ctx context.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return h.k.RevokeValidatorRole(ctx, valAddr)
+}
+
+// AfterValidatorRemoved revokes the automatically assigned validator role
+func (h StakingHooks) AfterValidatorRemoved(ctx context.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return h.k.RevokeValidatorRole(ctx, valAddr)
+}
+
+func (h StakingHooks) AfterValidatorCreated(context.Context, sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeValidatorModified(context.Context, sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeDelegationCreated(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeDelegationSharesModified(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeDelegationRemoved(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+// AfterDelegationModified records the delegator as a community role candidate
+func (h StakingHooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, _ sdk.ValAddress) error {
+	return h.k.AddCommunityRoleCandidate(ctx, delAddr)
+}
+
+func (h StakingHooks) BeforeValidatorSlashed(context.Context, sdk.ValAddress, math.LegacyDec) error {
+	return nil
+}
+
+func (h StakingHooks) AfterUnbondingInitiated(context.Context, uint64) error {
+	return nil
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmos-weighted-governance-sdk/x/voting/keeper"
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+func TestStakingHooksValidatorRole(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	hooks := keeper.NewStakingHooks(f.keeper)
+
+	alice := sdk.ValAddress(testAccAddress("alice"))
+	bob := sdk.ValAddress(testAccAddress("bob"))
+	carol := sdk.ValAddress(testAccAddress("carol"))
+	f.stakingKeeper.SetValidator(alice, 1000)
+	carolAcc := setRoleHolder(t, f, 9, "carol", "core_contributor", "2.0")
+
+	roleOf := func(valAddr sdk.ValAddress) *types.VoterRole {
+		address, err := f.addressCodec.BytesToString(valAddr)
+		require.NoError(t, err)
+		if !f.keeper.HasVoterRole(f.ctx, address) {
+			return nil
+		}
+		role, err := f.keeper.GetVoterRoleByAddress(f.ctx, address)
+		require.NoError(t, err)
+		return role
+	}
+
+	// nothing is assigned while auto_validator_role is disabled
+	require.NoError(t, hooks.AfterValidatorBonded(f.ctx, nil, bob))
+	require.Nil(t, roleOf(bob))
+
+	// enabling it assigns the role to the validators already bonded
+	params := types.DefaultParams()
+	params.AutoValidatorRole = true
+	msg := authorityMsg(t, f)
+	msg.Params = params
+	_, err := srv.UpdateParams(f.ctx, msg)
+	require.NoError(t, err)
+
+	role := roleOf(alice)
+	require.NotNil(t, role)
+	require.Equal(t, "validator", role.Role)
+	require.Equal(t, "1.5", role.Multiplier)
+	require.True(t, role.AutoAssigned)
+
+	require.NoError(t, hooks.AfterValidatorBonded(f.ctx, nil, bob))
+	require.True(t, roleOf(bob).AutoAssigned)
+
+	// a role set by governance takes precedence
+	require.NoError(t, hooks.AfterValidatorBonded(f.ctx, nil, carol))
+	require.Equal(t, "core_contributor", roleOf(carol).Role)
+	require.NoError(t, hooks.AfterValidatorBeginUnbonding(f.ctx, nil, carol))
+	require.Equal(t, carolAcc, roleOf(carol).Address)
+
+	aliceAcc, err := f.addressCodec.BytesToString(alice)
+	require.NoError(t, err)
+	_, err = srv.CreateVoterRole(f.ctx, &types.MsgCreateVoterRole{
+		Creator:    msg.Authority,
+		Address:    aliceAcc,
+		Role:       "core_contributor",
+		Multiplier: "2.0",
+	})
+	require.NoError(t, err)
+	require.False(t, roleOf(alice).AutoAssigned)
+	require.NoError(t, hooks.AfterValidatorRemoved(f.ctx, nil, alice))
+	require.Equal(t, "core_contributor", roleOf(alice).Role)
+
+	// unbonding revokes the assigned role
+	require.NoError(t, hooks.AfterValidatorBeginUnbonding(f.ctx, nil, bob))
+	require.Nil(t, roleOf(bob))
+
+	// a role that requires a bond is not assigned automatically
+	params.RoleBond = types.NewRoleBondParams("stake", types.DefaultRoleBondSlashFraction,
+		[]types.RoleBond{{Role: "validator", Amount: math.NewInt(1000)}})
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	require.NoError(t, hooks.AfterValidatorBonded(f.ctx, nil, bob))
+	require.Nil(t, roleOf(bob))
+}
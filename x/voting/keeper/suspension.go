@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// SetVoterRole stores a voter role and indexes its expiry and automatic reinstatement.
+func (k Keeper) SetVoterRole(ctx context.Context, role types.VoterRole) error {
+	if err := k.VoterRole.Set(ctx, role.Id, role); err != nil {
+		return err
+	}
+
+	if role.ExpiresAt > 0 {
+		if err := k.RoleExpiryQueue.Set(ctx, collections.Join(role.ExpiresAt, role.Id)); err != nil {
+			return err
+		}
+	}
+
+	if role.IsSuspended() && role.Suspension.ReinstateAt > 0 {
+		return k.SuspensionQueue.Set(ctx, collections.Join(role.Suspension.ReinstateAt, role.Id))
+	}
+
+	return nil
+}
+
+// ReinstateVoterRole lifts the suspension of a voter role.
+func (k Keeper) ReinstateVoterRole(ctx context.Context, role types.VoterRole, reinstatedBy string) error {
+	if role.Suspension.ReinstateAt > 0 {
+		if err := k.SuspensionQueue.Remove(ctx, collections.Join(role.Suspension.ReinstateAt, role.Id)); err != nil {
+			return err
+		}
+	}
+
+	role.Suspension = nil
+	if err := k.VoterRole.Set(ctx, role.Id, role); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoterRoleReinstated,
+			sdk.NewAttribute(types.AttributeKeyRoleID, fmt.Sprintf("%d", role.Id)),
+			sdk.NewAttribute(types.AttributeKeyAddress, role.Address),
+			sdk.NewAttribute(types.AttributeKeyReinstatedBy, reinstatedBy),
+			sdk.NewAttribute(types.AttributeKeyAuthority, reinstatedBy),
+		),
+	)
+
+	return nil
+}
+
+// ProcessSuspensions reinstates the suspended roles whose reinstate time has been reached.
+// Index entries of roles deleted or reinstated meanwhile are dropped.
+func (k Keeper) ProcessSuspensions(ctx context.Context) error {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+	rng := new(collections.Range[collections.Pair[int64, uint64]]).
+		EndExclusive(collections.PairPrefix[int64, uint64](now + 1))
+	var due []collections.Pair[int64, uint64]
+	if err := k.SuspensionQueue.Walk(ctx, rng, func(key collections.Pair[int64, uint64]) (bool, error) {
+		due = append(due, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		role, err := k.VoterRole.Get(ctx, key.K2())
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		if err != nil || !role.IsSuspended() || role.Suspension.ReinstateAt != key.K1() {
+			if err := k.SuspensionQueue.Remove(ctx, key); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := k.ReinstateVoterRole(ctx, role, types.ModuleName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
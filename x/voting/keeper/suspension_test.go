@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"cosmos-weighted-governance-sdk/x/voting/keeper"
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+func TestSuspendVoterRole(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	authority := authorityMsg(t, f).Authority
+	alice := setRoleHolder(t, f, 0, "alice", "core_contributor", "2.0")
+	ctx := withBlockTime(f, 1000)
+
+	multiplier := func() math.LegacyDec {
+		multiplier, err := f.keeper.GetVotingMultiplier(ctx, alice)
+		require.NoError(t, err)
+		return multiplier
+	}
+
+	for _, tc := range []struct {
+		desc string
+		msg  *types.MsgSuspendVoterRole
+		err  error
+	}{
+		{desc: "not the authority", msg: types.NewMsgSuspendVoterRole(alice, 0, "investigation", 0), err: types.ErrInvalidSigner},
+		{desc: "unknown role", msg: types.NewMsgSuspendVoterRole(authority, 9, "investigation", 0), err: sdkerrors.ErrKeyNotFound},
+		{desc: "no reason", msg: types.NewMsgSuspendVoterRole(authority, 0, "", 0), err: sdkerrors.ErrInvalidRequest},
+		{desc: "reinstated in the past", msg: types.NewMsgSuspendVoterRole(authority, 0, "investigation", 1000), err: sdkerrors.ErrInvalidRequest},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.SuspendVoterRole(ctx, tc.msg)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	_, err := srv.SuspendVoterRole(ctx, types.NewMsgSuspendVoterRole(authority, 0, "investigation", 2000))
+	require.NoError(t, err)
+	require.True(t, multiplier().Equal(math.LegacyOneDec()))
+
+	_, err = srv.SuspendVoterRole(ctx, types.NewMsgSuspendVoterRole(authority, 0, "investigation", 0))
+	require.ErrorIs(t, err, types.ErrRoleSuspended)
+
+	// the suspended role stays queryable with its status, and updates keep it suspended
+	_, err = srv.UpdateVoterRole(ctx, &types.MsgUpdateVoterRole{Creator: authority, Id: 0, Address: alice, Role: "core_contributor", Multiplier: "3.0"})
+	require.NoError(t, err)
+	role, err := keeper.NewQueryServerImpl(f.keeper).GetVoterRole(ctx, &types.QueryGetVoterRoleRequest{Id: 0})
+	require.NoError(t, err)
+	require.Equal(t, &types.RoleSuspension{Reason: "investigation", SuspendedAt: 1000, ReinstateAt: 2000}, role.VoterRole.Suspension)
+	require.True(t, multiplier().Equal(math.LegacyOneDec()))
+
+	// the role is reinstated automatically at the reinstate time
+	require.NoError(t, f.keeper.ProcessSuspensions(withBlockTime(f, 1999)))
+	require.True(t, multiplier().Equal(math.LegacyOneDec()))
+	require.NoError(t, f.keeper.ProcessSuspensions(withBlockTime(f, 2000)))
+	require.True(t, multiplier().Equal(math.LegacyNewDec(3)))
+
+	_, err = srv.ReinstateVoterRole(ctx, types.NewMsgReinstateVoterRole(authority, 0))
+	require.ErrorIs(t, err, types.ErrRoleNotSuspended)
+
+	// a suspension without reinstate time waits for MsgReinstateVoterRole
+	_, err = srv.SuspendVoterRole(ctx, types.NewMsgSuspendVoterRole(authority, 0, "investigation", 0))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.ProcessSuspensions(withBlockTime(f, 1_000_000)))
+	require.True(t, multiplier().Equal(math.LegacyOneDec()))
+
+	_, err = srv.ReinstateVoterRole(ctx, types.NewMsgReinstateVoterRole(alice, 0))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = srv.ReinstateVoterRole(ctx, types.NewMsgReinstateVoterRole(authority, 0))
+	require.NoError(t, err)
+	require.True(t, multiplier().Equal(math.LegacyNewDec(3)))
+}
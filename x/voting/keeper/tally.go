@@ -0,0 +1,963 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"sort"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// CalculateVoteResultsAndVotingPower replaces the x/gov tally. It reads and removes the
+// proposal's votes like the default tally and weights them with TallyVotes.
+func (k Keeper) CalculateVoteResultsAndVotingPower(
+	ctx context.Context,
+	gk govkeeper.Keeper,
+	proposal v1.Proposal,
+	validators map[string]v1.ValidatorGovInfo,
+) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
+	var (
+		votes         []v1.Vote
+		votesToRemove []collections.Pair[uint64, sdk.AccAddress]
+	)
+
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
+	err := gk.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+		votes = append(votes, vote)
+		votesToRemove = append(votesToRemove, key)
+		return false, nil
+	})
+	if err != nil {
+		return math.LegacyZeroDec(), nil, fmt.Errorf("error while iterating votes: %w", err)
+	}
+
+	totalVotingPower, results, err := k.TallyVotes(ctx, proposal, votes, validators)
+	if err != nil {
+		return math.LegacyZeroDec(), nil, err
+	}
+
+	// x/gov executes the messages of the proposal next if it passed, and the
+	// AfterProposalVotingPeriodEnded hook clears the proposal once they ran
+	govParams, err := gk.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyZeroDec(), nil, fmt.Errorf("error while getting gov params: %w", err)
+	}
+	passes, err := passesGov(govParams, proposal, totalVotingPower, results, validators)
+	if err != nil {
+		return math.LegacyZeroDec(), nil, err
+	}
+	if passes {
+		err = k.TimelockProposal.Set(ctx, proposal.Id)
+	} else {
+		err = k.TimelockProposal.Remove(ctx)
+	}
+	if err != nil {
+		return math.LegacyZeroDec(), nil, err
+	}
+
+	for _, key := range votesToRemove {
+		if err := gk.Votes.Remove(ctx, key); err != nil {
+			return math.LegacyDec{}, nil, fmt.Errorf("error while removing vote (%d/%s): %w", key.K1(), key.K2(), err)
+		}
+	}
+
+	return totalVotingPower, results, nil
+}
+
+// passesGov reports whether x/gov passes a proposal with the given results, applying its
+// quorum, veto and threshold like the tally preview does.
+func passesGov(
+	params v1.Params,
+	proposal v1.Proposal,
+	total math.LegacyDec,
+	results map[v1.VoteOption]math.LegacyDec,
+	validators map[string]v1.ValidatorGovInfo,
+) (bool, error) {
+	bondedPower := math.LegacyZeroDec()
+	for _, val := range validators {
+		bondedPower = bondedPower.Add(math.LegacyNewDecFromInt(val.BondedTokens))
+	}
+
+	preview, err := (&chamber{total: total, stake: total, results: results}).preview(params, proposal.Expedited, bondedPower)
+	if err != nil {
+		return false, err
+	}
+	return preview.Passes, nil
+}
+
+// TallyVotes computes the role-weighted results of a proposal. A voter's staked power is
+// transformed by the power_transform param and multiplied by the voter's role multiplier.
+// Role holders that did not vote follow their vote delegation to the first representative
+// in the chain that voted directly; a direct vote always overrides the delegation.
+// Validators vote with their remaining inherited power, transformed delegator by delegator
+// and weighted according to the validator multiplier scope param. Role holders voting on a
+// proposal that changes their own role are recused according to the recusal mode param.
+// A proposal with an unmet role quorum is rejected by reporting its yes votes as abstain.
+// A registry proposal, which contains voting module messages, must also reach the quorum,
+// threshold and veto threshold of the registry_tally param, measured on the bonded power.
+//
+// Quorums count the staked power that voted, while thresholds and vetoes are measured on
+// the weighted power. The total returned to x/gov is the staked power that voted, with the
+// weighted results scaled to add up to it.
+//
+// In bicameral mode the proposal must also pass both the token house and the role house,
+// with the registry_tally quorum and threshold replacing the chamber params of registry
+// proposals. TallyVotes records the ChamberTally of the proposal and returns the token
+// house results, with yes votes counted as abstain when a chamber rejects the proposal.
+func (k Keeper) TallyVotes(
+	ctx context.Context,
+	proposal v1.Proposal,
+	votes []v1.Vote,
+	validators map[string]v1.ValidatorGovInfo,
+) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyZeroDec(), nil, fmt.Errorf("error while getting params: %w", err)
+	}
+
+	outcome, chamberTally, results, err := k.tallyResults(ctx, params, proposal, votes, validators)
+	if err != nil {
+		return math.LegacyZeroDec(), nil, err
+	}
+
+	// the AfterProposalVotingPeriodEnded hook stores the votes of each role
+	if err := k.PendingRoleTallies.Set(ctx, proposal.Id, outcome.proposalRoleTally(proposal.Id)); err != nil {
+		return math.LegacyZeroDec(), nil, err
+	}
+
+	// the AfterProposalVotingPeriodEnded hook records the participation of role holders,
+	// counting delegated votes and recusals along with the direct votes
+	for _, address := range outcome.participants {
+		if err := k.ProposalVoters.Set(ctx, collections.Join(proposal.Id, address)); err != nil {
+			return math.LegacyZeroDec(), nil, err
+		}
+	}
+
+	if chamberTally != nil {
+		if err := k.ChamberTallies.Set(ctx, proposal.Id, *chamberTally); err != nil {
+			return math.LegacyZeroDec(), nil, err
+		}
+	}
+
+	total, govResults := results.govResults()
+	return total, govResults, nil
+}
+
+// tallyResults tallies the proposal and returns the results x/gov receives, with yes votes
+// counted as abstain when the proposal is rejected, and the ChamberTally in bicameral mode.
+func (k Keeper) tallyResults(
+	ctx context.Context,
+	params types.Params,
+	proposal v1.Proposal,
+	votes []v1.Vote,
+	validators map[string]v1.ValidatorGovInfo,
+) (*tallyOutcome, *types.ChamberTally, *chamber, error) {
+	outcome, err := k.tally(ctx, params, proposal, votes, validators)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// any unmet role quorum rejects the proposal
+	_, roleQuorumsMet := outcome.roleTallies(params)
+
+	registry, err := k.IsRegistryProposal(proposal)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if params.TallyMode != types.TallyModeBicameral {
+		registryPassed := !registry || outcome.weighted.passesRegistry(params.RegistryTally, outcome.bondedPower)
+		return outcome, nil, outcome.weighted.verdict(roleQuorumsMet && registryPassed), nil
+	}
+
+	tokenHouse, roleHouse := params.TokenHouse, params.RoleHouse
+	if registry {
+		tokenHouse, roleHouse = params.RegistryTally.ChamberParams(), params.RegistryTally.ChamberParams()
+	}
+	chamberTally := types.ChamberTally{
+		ProposalId:       proposal.Id,
+		TokenHouse:       v1.NewTallyResultFromMap(outcome.tokenHouse.results),
+		RoleHouse:        decTallyResult(outcome.roleHouse.results),
+		TokenHousePassed: outcome.tokenHouse.passes(tokenHouse, outcome.bondedPower),
+		RoleHousePassed:  outcome.roleHouse.passes(roleHouse, outcome.roleSeats),
+	}
+	if registry {
+		chamberTally.TokenHousePassed = chamberTally.TokenHousePassed && !outcome.tokenHouse.vetoed(params.RegistryTally.VetoThreshold)
+		chamberTally.RoleHousePassed = chamberTally.RoleHousePassed && !outcome.roleHouse.vetoed(params.RegistryTally.VetoThreshold)
+	}
+
+	passed := chamberTally.TokenHousePassed && chamberTally.RoleHousePassed && roleQuorumsMet
+	return outcome, &chamberTally, outcome.tokenHouse.verdict(passed), nil
+}
+
+// reject reports yes votes as abstain so that x/gov rejects the proposal without burning
+// deposits, unless its own quorum or veto rules apply.
+func reject(results map[v1.VoteOption]math.LegacyDec) {
+	results[v1.OptionAbstain] = results[v1.OptionAbstain].Add(results[v1.OptionYes])
+	results[v1.OptionYes] = math.LegacyZeroDec()
+}
+
+// TallyBreakdown tallies an active proposal with its current votes and reports the
+// participation of each role, whether every role quorum is met and the recused voters.
+func (k Keeper) TallyBreakdown(ctx context.Context, proposalID uint64) ([]types.RoleTally, bool, []string, error) {
+	proposal, votes, validators, err := k.activeProposalVotes(ctx, proposalID)
+	if err != nil {
+		return nil, false, nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, false, nil, fmt.Errorf("error while getting params: %w", err)
+	}
+
+	outcome, err := k.tally(ctx, params, proposal, votes, validators)
+	if err != nil {
+		return nil, false, nil, err
+	}
+
+	roleTallies, roleQuorumsMet := outcome.roleTallies(params)
+	return roleTallies, roleQuorumsMet, outcome.recused, nil
+}
+
+// TallyPreview tallies an active proposal with its current votes, like x/gov would if the
+// voting period ended now, and evaluates both the stake-only tally and the tally x/gov
+// would receive against the x/gov params. Nothing is stored.
+func (k Keeper) TallyPreview(ctx context.Context, proposalID uint64) (stake, weighted types.TallyPreview, err error) {
+	proposal, votes, validators, err := k.activeProposalVotes(ctx, proposalID)
+	if err != nil {
+		return stake, weighted, err
+	}
+
+	govParams, err := k.getGovParams(ctx)
+	if err != nil {
+		return stake, weighted, fmt.Errorf("error while getting gov params: %w", err)
+	}
+
+	outcome, results, err := k.previewTally(ctx, proposal, votes, validators)
+	if err != nil {
+		return stake, weighted, err
+	}
+
+	stake, err = outcome.tokenHouse.preview(govParams, proposal.Expedited, outcome.bondedPower)
+	if err != nil {
+		return stake, weighted, err
+	}
+
+	weighted, err = results.preview(govParams, proposal.Expedited, outcome.bondedPower)
+	return stake, weighted, err
+}
+
+// previewTally tallies a proposal without storing anything and returns the results x/gov
+// would receive.
+func (k Keeper) previewTally(
+	ctx context.Context,
+	proposal v1.Proposal,
+	votes []v1.Vote,
+	validators map[string]v1.ValidatorGovInfo,
+) (*tallyOutcome, *chamber, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error while getting params: %w", err)
+	}
+
+	outcome, _, results, err := k.tallyResults(ctx, params, proposal, votes, validators)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return outcome, results, nil
+}
+
+// activeProposalVotes loads a proposal in its voting period with its current votes and
+// the bonded validators.
+func (k Keeper) activeProposalVotes(ctx context.Context, proposalID uint64) (v1.Proposal, []v1.Vote, map[string]v1.ValidatorGovInfo, error) {
+	proposal, err := k.getProposal(ctx, proposalID)
+	if err != nil {
+		return v1.Proposal{}, nil, nil, err
+	}
+	if proposal.Status != v1.StatusVotingPeriod {
+		return v1.Proposal{}, nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "proposal %d is not in voting period", proposalID)
+	}
+
+	votes, err := k.getVotes(ctx, proposalID)
+	if err != nil {
+		return v1.Proposal{}, nil, nil, err
+	}
+
+	validators, err := k.bondedValidators(ctx)
+	if err != nil {
+		return v1.Proposal{}, nil, nil, err
+	}
+
+	return proposal, votes, validators, nil
+}
+
+// bondedValidators mirrors the validator set x/gov passes to the tally.
+func (k Keeper) bondedValidators(ctx context.Context) (map[string]v1.ValidatorGovInfo, error) {
+	var iterErr error
+	validators := make(map[string]v1.ValidatorGovInfo)
+	err := k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.ValidatorI) (stop bool) {
+		valBz, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+		if err != nil {
+			iterErr = err
+			return true
+		}
+		validators[validator.GetOperator()] = v1.NewValidatorGovInfo(
+			valBz,
+			validator.GetBondedTokens(),
+			validator.GetDelegatorShares(),
+			math.LegacyZeroDec(),
+			v1.WeightedVoteOptions{},
+		)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return validators, iterErr
+}
+
+// chamber accumulates the results of one chamber of the tally.
+type chamber struct {
+	// total is the voting power behind the results, which the threshold and veto are measured on
+	total math.LegacyDec
+	// stake is the untransformed, unmultiplied power behind the results, which the quorum is
+	// measured on. It equals total in the chambers that do not weight votes.
+	stake   math.LegacyDec
+	results map[v1.VoteOption]math.LegacyDec
+}
+
+func newChamber() *chamber {
+	return &chamber{
+		total: math.LegacyZeroDec(),
+		stake: math.LegacyZeroDec(),
+		results: map[v1.VoteOption]math.LegacyDec{
+			v1.OptionYes:        math.LegacyZeroDec(),
+			v1.OptionAbstain:    math.LegacyZeroDec(),
+			v1.OptionNo:         math.LegacyZeroDec(),
+			v1.OptionNoWithVeto: math.LegacyZeroDec(),
+		},
+	}
+}
+
+func (c *chamber) add(options v1.WeightedVoteOptions, votingPower math.LegacyDec) {
+	c.addWeighted(options, votingPower, votingPower)
+}
+
+// addWeighted adds a vote whose stake counts with the given, transformed and weighted, voting power.
+func (c *chamber) addWeighted(options v1.WeightedVoteOptions, stake, votingPower math.LegacyDec) {
+	addVote(c.results, options, votingPower)
+	c.total = c.total.Add(votingPower)
+	c.stake = c.stake.Add(stake)
+}
+
+// passes reports whether the stake of the chamber reached its quorum of the eligible power
+// and more than its threshold of the non-abstaining power voted yes.
+func (c *chamber) passes(params types.ChamberParams, eligible math.LegacyDec) bool {
+	if !eligible.IsPositive() || c.stake.Quo(eligible).LT(params.Quorum) {
+		return false
+	}
+
+	nonAbstaining := c.total.Sub(c.results[v1.OptionAbstain])
+	if !nonAbstaining.IsPositive() {
+		return false
+	}
+
+	return c.results[v1.OptionYes].Quo(nonAbstaining).GT(params.Threshold)
+}
+
+// preview evaluates the chamber like x/gov evaluates the tally results of a proposal.
+func (c *chamber) preview(params v1.Params, expedited bool, bondedPower math.LegacyDec) (types.TallyPreview, error) {
+	thresholdStr := params.Threshold
+	if expedited {
+		thresholdStr = params.ExpeditedThreshold
+	}
+
+	quorum, err := math.LegacyNewDecFromStr(params.Quorum)
+	if err != nil {
+		return types.TallyPreview{}, fmt.Errorf("invalid gov quorum: %w", err)
+	}
+	threshold, err := math.LegacyNewDecFromStr(thresholdStr)
+	if err != nil {
+		return types.TallyPreview{}, fmt.Errorf("invalid gov threshold: %w", err)
+	}
+	vetoThreshold, err := math.LegacyNewDecFromStr(params.VetoThreshold)
+	if err != nil {
+		return types.TallyPreview{}, fmt.Errorf("invalid gov veto threshold: %w", err)
+	}
+
+	preview := types.TallyPreview{
+		Result:     v1.NewTallyResultFromMap(c.results),
+		TotalPower: c.total,
+		Turnout:    math.LegacyZeroDec(),
+	}
+	if bondedPower.IsPositive() {
+		preview.Turnout = c.stake.Quo(bondedPower)
+		preview.QuorumReached = preview.Turnout.GTE(quorum)
+	}
+	if c.total.IsPositive() {
+		preview.Vetoed = c.results[v1.OptionNoWithVeto].Quo(c.total).GT(vetoThreshold)
+	}
+	if nonAbstaining := c.total.Sub(c.results[v1.OptionAbstain]); nonAbstaining.IsPositive() {
+		preview.ThresholdReached = c.results[v1.OptionYes].Quo(nonAbstaining).GT(threshold)
+	}
+	preview.Passes = preview.QuorumReached && !preview.Vetoed && preview.ThresholdReached
+
+	return preview, nil
+}
+
+// verdict returns a copy of the chamber, with yes votes counted as abstain unless the
+// proposal passed.
+func (c *chamber) verdict(passed bool) *chamber {
+	results := maps.Clone(c.results)
+	if !passed {
+		reject(results)
+	}
+	return &chamber{total: c.total, stake: c.stake, results: results}
+}
+
+// govResults returns the total and the results x/gov receives. x/gov measures its quorum
+// and its ratios on the same total, so the results are scaled to add up to the stake: the
+// quorum counts the stake that voted while the threshold and veto ratios stay those of the
+// weighted votes.
+func (c *chamber) govResults() (math.LegacyDec, map[v1.VoteOption]math.LegacyDec) {
+	if c.total.Equal(c.stake) {
+		return c.total, c.results
+	}
+
+	results := make(map[v1.VoteOption]math.LegacyDec, len(c.results))
+	for option, power := range c.results {
+		results[option] = math.LegacyZeroDec()
+		if c.total.IsPositive() {
+			results[option] = power.Mul(c.stake).Quo(c.total)
+		}
+	}
+	return c.stake, results
+}
+
+// vetoed reports whether more than the veto threshold of the voted power voted no with veto.
+func (c *chamber) vetoed(threshold math.LegacyDec) bool {
+	if !c.total.IsPositive() {
+		return false
+	}
+
+	return c.results[v1.OptionNoWithVeto].Quo(c.total).GT(threshold)
+}
+
+// passesRegistry reports whether the chamber passes the elevated params of registry proposals.
+func (c *chamber) passesRegistry(params types.RegistryTallyParams, eligible math.LegacyDec) bool {
+	return c.passes(params.ChamberParams(), eligible) && !c.vetoed(params.VetoThreshold)
+}
+
+// tallyOutcome holds every chamber computed from the same votes.
+type tallyOutcome struct {
+	// weighted is the role-weighted tally used outside bicameral mode
+	weighted *chamber
+	// tokenHouse counts the staked power of voters, without transform or multipliers
+	tokenHouse *chamber
+	// roleHouse counts every role holder that voted with its multiplier
+	roleHouse *chamber
+	// bondedPower is the power of all bonded validators, the token house's eligible power
+	bondedPower math.LegacyDec
+	// roleSeats is the sum of all role multipliers, the role house's eligible power
+	roleSeats math.LegacyDec
+	// roles holds the participation of each role, keyed by role
+	roles map[string]*types.RoleTally
+	// recused lists the addresses whose role the proposal changes, sorted
+	recused []string
+	// votes holds how the voters of each role voted, keyed by role
+	votes map[string]*roleVote
+	// participants lists the role holders that voted, directly or through a vote
+	// delegation, or were recused, sorted
+	participants []string
+}
+
+// roleVote accumulates the votes of the holders of one role.
+type roleVote struct {
+	voters      uint64
+	multipliers math.LegacyDec
+	raw         *chamber
+	weighted    *chamber
+}
+
+// addRoleVote records a vote of raw and weighted power for the role, counting the voter
+// when it has a multiplier.
+func (o *tallyOutcome) addRoleVote(role string, options v1.WeightedVoteOptions, raw, weighted math.LegacyDec, multiplier *math.LegacyDec) {
+	vote, ok := o.votes[role]
+	if !ok {
+		vote = &roleVote{multipliers: math.LegacyZeroDec(), raw: newChamber(), weighted: newChamber()}
+		o.votes[role] = vote
+	}
+
+	vote.raw.add(options, raw)
+	vote.weighted.addWeighted(options, raw, weighted)
+	if multiplier != nil {
+		vote.voters++
+		vote.multipliers = vote.multipliers.Add(*multiplier)
+	}
+}
+
+// proposalRoleTally returns the votes of each role, sorted by role.
+func (o *tallyOutcome) proposalRoleTally(proposalID uint64) types.ProposalRoleTally {
+	roles := make([]string, 0, len(o.votes))
+	for role := range o.votes {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+
+	proposalRoleTally := types.ProposalRoleTally{ProposalId: proposalID}
+	for _, role := range roles {
+		vote := o.votes[role]
+		multiplier := math.LegacyZeroDec()
+		if vote.voters > 0 {
+			multiplier = vote.multipliers.QuoInt64(int64(vote.voters))
+		}
+
+		proposalRoleTally.RoleTallies = append(proposalRoleTally.RoleTallies, types.RoleVoteTally{
+			Role:          role,
+			Voters:        vote.voters,
+			Multiplier:    multiplier,
+			RawPower:      v1.NewTallyResultFromMap(vote.raw.results),
+			WeightedPower: decTallyResult(vote.weighted.results),
+		})
+	}
+
+	return proposalRoleTally
+}
+
+// roleTallies completes the participation of each role with its quorum from params, sorted
+// by role, and reports whether every role quorum is met. A role without holders cannot
+// participate and meets its quorum.
+func (o *tallyOutcome) roleTallies(params types.Params) ([]types.RoleTally, bool) {
+	quorums := make(map[string]types.RoleQuorum, len(params.RoleQuorums))
+	for _, quorum := range params.RoleQuorums {
+		quorums[quorum.Role] = quorum
+	}
+
+	roles := make([]string, 0, len(o.roles)+len(quorums))
+	for role := range o.roles {
+		roles = append(roles, role)
+	}
+	for role := range quorums {
+		if _, ok := o.roles[role]; !ok {
+			roles = append(roles, role)
+		}
+	}
+	sort.Strings(roles)
+
+	met := true
+	roleTallies := make([]types.RoleTally, 0, len(roles))
+	for _, role := range roles {
+		roleTally := newRoleTally(role)
+		if tallied, ok := o.roles[role]; ok {
+			roleTally = *tallied
+		}
+
+		roleTally.Basis = types.RoleQuorumBasisHolders
+		roleTally.MinParticipation = math.LegacyZeroDec()
+		if quorum, ok := quorums[role]; ok {
+			roleTally.Basis = quorum.Basis
+			roleTally.MinParticipation = quorum.MinParticipation
+		}
+
+		switch {
+		case roleTally.Basis == types.RoleQuorumBasisPower && roleTally.Power.IsPositive():
+			roleTally.Participation = roleTally.VotedPower.Quo(roleTally.Power)
+		case roleTally.Basis == types.RoleQuorumBasisHolders && roleTally.Holders > 0:
+			roleTally.Participation = math.LegacyNewDec(int64(roleTally.Voters)).QuoInt64(int64(roleTally.Holders))
+		}
+
+		roleTally.QuorumMet = roleTally.Holders == 0 || roleTally.Participation.GTE(roleTally.MinParticipation)
+		met = met && roleTally.QuorumMet
+		roleTallies = append(roleTallies, roleTally)
+	}
+
+	return roleTallies, met
+}
+
+func newRoleTally(role string) types.RoleTally {
+	return types.RoleTally{
+		Role:             role,
+		Power:            math.LegacyZeroDec(),
+		VotedPower:       math.LegacyZeroDec(),
+		Participation:    math.LegacyZeroDec(),
+		MinParticipation: math.LegacyZeroDec(),
+	}
+}
+
+// tally walks the votes once and fills every chamber.
+func (k Keeper) tally(
+	ctx context.Context,
+	params types.Params,
+	proposal v1.Proposal,
+	votes []v1.Vote,
+	validators map[string]v1.ValidatorGovInfo,
+) (*tallyOutcome, error) {
+	multipliers, roles, err := k.roleMultipliers(ctx, proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	// holders voting on their own role count with the default weight, or not at all
+	recused, err := k.RecusedVoters(ctx, proposal)
+	if err != nil {
+		return nil, err
+	}
+	exclude := params.RecusalMode == types.RecusalModeExclude
+
+	outcome := &tallyOutcome{
+		weighted:    newChamber(),
+		tokenHouse:  newChamber(),
+		roleHouse:   newChamber(),
+		bondedPower: math.LegacyZeroDec(),
+		roleSeats:   math.LegacyZeroDec(),
+		roles:       make(map[string]*types.RoleTally),
+		recused:     make([]string, 0, len(recused)),
+		votes:       make(map[string]*roleVote),
+	}
+
+	for address := range recused {
+		outcome.recused = append(outcome.recused, address)
+		if _, ok := multipliers[address]; ok {
+			multipliers[address] = math.LegacyOneDec()
+		}
+	}
+	sort.Strings(outcome.recused)
+
+	// every role holder has a seat in the role house, whatever the validator multiplier scope
+	seats := maps.Clone(multipliers)
+	if exclude {
+		for address := range recused {
+			delete(seats, address)
+		}
+	}
+	for _, seat := range seats {
+		outcome.roleSeats = outcome.roleSeats.Add(seat)
+	}
+	for _, val := range validators {
+		outcome.bondedPower = outcome.bondedPower.Add(math.LegacyNewDecFromInt(val.BondedTokens))
+	}
+
+	// with scope none, validator operators vote with the default weight
+	if params.ValidatorMultiplierScope == types.ValidatorMultiplierScopeNone {
+		for _, val := range validators {
+			operator, err := k.addressCodec.BytesToString(val.Address)
+			if err != nil {
+				return nil, err
+			}
+			delete(multipliers, operator)
+		}
+	}
+
+	// stakedPowerOf returns the power of the voter's delegations to bonded validators,
+	// deducting them from the validators when the voter casts a vote
+	stakedPowerOf := func(voter string, deduct bool) (math.LegacyDec, error) {
+		voterAddr, err := k.addressCodec.StringToBytes(voter)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		stakedPower := math.LegacyZeroDec()
+		err = k.stakingKeeper.IterateDelegations(ctx, voterAddr, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+			valAddrStr := delegation.GetValidatorAddr()
+			if val, ok := validators[valAddrStr]; ok {
+				if deduct {
+					val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+					validators[valAddrStr] = val
+				}
+
+				// delegation shares * bonded / total shares
+				stakedPower = stakedPower.Add(delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+			}
+			return false
+		})
+		return stakedPower, err
+	}
+
+	// cast adds the voter's staked power, weighted by its multiplier, and deducts it from
+	// the validators it is delegated to. An excluded voter only counts in the token house,
+	// which does not weight votes.
+	voted := make(map[string]bool)
+	deducted := make(map[string]bool)
+	cast := func(voter string, options v1.WeightedVoteOptions) error {
+		stakedPower, err := stakedPowerOf(voter, true)
+		if err != nil {
+			return err
+		}
+		deducted[voter] = true
+
+		if exclude && recused[voter] {
+			outcome.tokenHouse.add(options, stakedPower)
+			return nil
+		}
+
+		transformedPower, err := TransformPower(params, stakedPower)
+		if err != nil {
+			return err
+		}
+
+		multiplier := multiplierOf(multipliers, voter)
+		outcome.weighted.addWeighted(options, stakedPower, transformedPower.Mul(multiplier))
+		outcome.tokenHouse.add(options, stakedPower)
+		outcome.addRoleVote(roles[voter], options, stakedPower, transformedPower.Mul(multiplier), &multiplier)
+		if seat, ok := seats[voter]; ok {
+			outcome.roleHouse.add(options, seat)
+		}
+		voted[voter] = true
+
+		return nil
+	}
+
+	direct := make(map[string]v1.WeightedVoteOptions, len(votes))
+	for _, vote := range votes {
+		direct[vote.Voter] = vote.Options
+
+		// if validator, just record it in the map
+		voterAddr, err := k.addressCodec.StringToBytes(vote.Voter)
+		if err != nil {
+			return nil, err
+		}
+		valAddrStr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(voterAddr)
+		if err != nil {
+			return nil, err
+		}
+		if val, ok := validators[valAddrStr]; ok {
+			val.Vote = vote.Options
+			validators[valAddrStr] = val
+		}
+
+		if err := cast(vote.Voter, vote.Options); err != nil {
+			return nil, fmt.Errorf("error while tallying vote of %s: %w", vote.Voter, err)
+		}
+	}
+
+	err = k.VoteDelegations.Walk(ctx, nil, func(delegator string, delegation types.VoteDelegation) (bool, error) {
+		if _, voted := direct[delegator]; voted {
+			return false, nil
+		}
+
+		options, found, err := k.representativeVote(ctx, delegation, proposal, direct, params.MaxVoteDelegationDepth)
+		if err != nil {
+			return true, err
+		}
+		if !found {
+			return false, nil
+		}
+
+		if err := cast(delegator, options); err != nil {
+			return true, fmt.Errorf("error while tallying delegated vote of %s: %w", delegator, err)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// transformedShares caches the transformed power of each non-voting delegator per
+	// unit of its stake
+	transformedShares := make(map[string]math.LegacyDec)
+	transformedShare := func(delegator string) (math.LegacyDec, error) {
+		if share, ok := transformedShares[delegator]; ok {
+			return share, nil
+		}
+
+		stakedPower, err := stakedPowerOf(delegator, false)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+		share := math.LegacyZeroDec()
+		if stakedPower.IsPositive() {
+			transformedPower, err := TransformPower(params, stakedPower)
+			if err != nil {
+				return math.LegacyDec{}, err
+			}
+			share = transformedPower.Quo(stakedPower)
+		}
+		transformedShares[delegator] = share
+		return share, nil
+	}
+
+	// inheritedPower transforms the stake a validator inherits from its delegators that did
+	// not vote one delegator at a time, as each would have counted voting itself. The
+	// transformed power of a delegator is split over its validators in proportion to its stake.
+	inheritedPower := func(val v1.ValidatorGovInfo) (math.LegacyDec, error) {
+		delegations, err := k.stakingKeeper.GetValidatorDelegations(ctx, sdk.ValAddress(val.Address))
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		votingPower := math.LegacyZeroDec()
+		for _, delegation := range delegations {
+			if deducted[delegation.DelegatorAddress] {
+				continue
+			}
+
+			share, err := transformedShare(delegation.DelegatorAddress)
+			if err != nil {
+				return math.LegacyDec{}, err
+			}
+			stakedPower := delegation.Shares.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+			votingPower = votingPower.Add(stakedPower.Mul(share))
+		}
+		return votingPower, nil
+	}
+
+	// iterate over the validators again to tally their remaining voting power
+	for _, val := range validators {
+		if len(val.Vote) == 0 {
+			continue
+		}
+
+		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
+		stakedPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+		votingPower := stakedPower
+		if params.PowerTransform != types.PowerTransformLinear {
+			votingPower, err = inheritedPower(val)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		operator, err := k.addressCodec.BytesToString(val.Address)
+		if err != nil {
+			return nil, err
+		}
+		if params.ValidatorMultiplierScope == types.ValidatorMultiplierScopeAllInherited {
+			votingPower = votingPower.Mul(multiplierOf(multipliers, operator))
+		}
+
+		outcome.weighted.addWeighted(val.Vote, stakedPower, votingPower)
+		outcome.tokenHouse.add(val.Vote, stakedPower)
+		// the inherited power counts for the role of the operator, who voted already
+		outcome.addRoleVote(roles[operator], val.Vote, stakedPower, votingPower, nil)
+	}
+
+	// the participation of each role counts holders with their role-weighted power
+	for address, role := range roles {
+		roleTally, ok := outcome.roles[role]
+		if !ok {
+			created := newRoleTally(role)
+			roleTally = &created
+			outcome.roles[role] = roleTally
+		}
+
+		if voted[address] || recused[address] {
+			outcome.participants = append(outcome.participants, address)
+		}
+
+		if recused[address] {
+			roleTally.Recused++
+			// excluded holders cannot participate
+			if exclude {
+				continue
+			}
+		}
+
+		stakedPower, err := stakedPowerOf(address, false)
+		if err != nil {
+			return nil, err
+		}
+		transformedPower, err := TransformPower(params, stakedPower)
+		if err != nil {
+			return nil, err
+		}
+		power := transformedPower.Mul(multiplierOf(multipliers, address))
+
+		roleTally.Holders++
+		roleTally.Power = roleTally.Power.Add(power)
+		if voted[address] {
+			roleTally.Voters++
+			roleTally.VotedPower = roleTally.VotedPower.Add(power)
+		}
+	}
+	sort.Strings(outcome.participants)
+
+	return outcome, nil
+}
+
+// representativeVote follows a delegation chain up to maxDepth hops and returns the vote
+// of the first representative that voted directly. Every hop must apply to the proposal.
+func (k Keeper) representativeVote(
+	ctx context.Context,
+	delegation types.VoteDelegation,
+	proposal v1.Proposal,
+	direct map[string]v1.WeightedVoteOptions,
+	maxDepth uint32,
+) (v1.WeightedVoteOptions, bool, error) {
+	for depth := uint32(1); depth <= maxDepth; depth++ {
+		if !VoteDelegationApplies(delegation, proposal) {
+			return nil, false, nil
+		}
+
+		if options, voted := direct[delegation.Representative]; voted {
+			return options, true, nil
+		}
+
+		next, err := k.VoteDelegations.Get(ctx, delegation.Representative)
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, false, nil
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		delegation = next
+	}
+
+	return nil, false, nil
+}
+
+// roleMultipliers loads the effective multiplier and the role of every role holder on the
+// proposal. Roles with an unparsable multiplier keep the default weight.
+func (k Keeper) roleMultipliers(ctx context.Context, proposal v1.Proposal) (map[string]math.LegacyDec, map[string]string, error) {
+	multipliers := make(map[string]math.LegacyDec)
+	roles := make(map[string]string)
+	err := k.VoterRole.Walk(ctx, nil, func(_ uint64, role types.VoterRole) (bool, error) {
+		roles[role.Address] = role.Role
+		multiplier, _, err := EffectiveMultiplier(role, proposal)
+		if err == nil {
+			multipliers[role.Address] = multiplier
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error while loading voter roles: %w", err)
+	}
+
+	return multipliers, roles, nil
+}
+
+func multiplierOf(multipliers map[string]math.LegacyDec, address string) math.LegacyDec {
+	if multiplier, ok := multipliers[address]; ok {
+		return multiplier
+	}
+	return math.LegacyOneDec()
+}
+
+func addVote(results map[v1.VoteOption]math.LegacyDec, options v1.WeightedVoteOptions, votingPower math.LegacyDec) {
+	for _, option := range options {
+		weight, _ := math.LegacyNewDecFromStr(option.Weight)
+		results[option.Option] = results[option.Option].Add(votingPower.Mul(weight))
+	}
+}
+
+// decTallyResult keeps the decimals of results that are not token amounts.
+func decTallyResult(results map[v1.VoteOption]math.LegacyDec) v1.TallyResult {
+	return v1.TallyResult{
+		YesCount:        results[v1.OptionYes].String(),
+		AbstainCount:    results[v1.OptionAbstain].String(),
+		NoCount:         results[v1.OptionNo].String(),
+		NoWithVetoCount: results[v1.OptionNoWithVeto].String(),
+	}
+}
@@ -0,0 +1,305 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// tallyValidators returns a single bonded validator with 1000 tokens and shares.
+func tallyValidators(t *testing.T, f *fixture) (string, map[string]v1.ValidatorGovInfo) {
+	t.Helper()
+
+	valBz := testAccAddress("validator")
+	valAddr, err := f.stakingKeeper.ValidatorAddressCodec().BytesToString(valBz)
+	require.NoError(t, err)
+
+	return valAddr, map[string]v1.ValidatorGovInfo{
+		valAddr: v1.NewValidatorGovInfo(sdk.ValAddress(valBz), math.NewInt(1000), math.LegacyNewDec(1000), math.LegacyZeroDec(), v1.WeightedVoteOptions{}),
+	}
+}
+
+func newVote(voter string, option v1.VoteOption) v1.Vote {
+	return v1.Vote{Voter: voter, Options: v1.NewNonSplitVoteOption(option)}
+}
+
+// weightedYes returns the role-weighted yes power recorded by the last tally of a proposal,
+// before it is scaled to the stake x/gov receives.
+func weightedYes(t *testing.T, f *fixture, proposalID uint64) math.LegacyDec {
+	t.Helper()
+
+	roleTally, err := f.keeper.PendingRoleTallies.Get(f.ctx, proposalID)
+	require.NoError(t, err)
+
+	yes := math.LegacyZeroDec()
+	for _, voteTally := range roleTally.RoleTallies {
+		yes = yes.Add(math.LegacyMustNewDecFromStr(voteTally.WeightedPower.YesCount))
+	}
+	return yes
+}
+
+// govShare scales a weighted power to its share of the stake that voted, as x/gov receives it.
+func govShare(weighted, weightedTotal, stake math.LegacyDec) math.LegacyDec {
+	return weighted.Mul(stake).Quo(weightedTotal)
+}
+
+func TestTallyVotesWithDelegation(t *testing.T) {
+	f := initFixture(t)
+	valAddr, validators := tallyValidators(t, f)
+
+	alice := setRoleHolder(t, f, 0, "alice", "core_contributor", "2.0")
+	bob := setRoleHolder(t, f, 1, "bob", "community_member", "1.0")
+	carol := setRoleHolder(t, f, 2, "carol", "community_member", "1.0")
+	erin := setRoleHolder(t, f, 3, "erin", "community_member", "1.0")
+	dave, err := f.addressCodec.BytesToString(testAccAddress("dave"))
+	require.NoError(t, err)
+	validatorAcc, err := f.addressCodec.BytesToString(testAccAddress("validator"))
+	require.NoError(t, err)
+
+	f.stakingKeeper.Delegate(testAccAddress("alice"), valAddr, 100)
+	f.stakingKeeper.Delegate(testAccAddress("bob"), valAddr, 50)
+	f.stakingKeeper.Delegate(testAccAddress("carol"), valAddr, 30)
+	f.stakingKeeper.Delegate(testAccAddress("dave"), valAddr, 20)
+	f.stakingKeeper.Delegate(testAccAddress("erin"), valAddr, 40)
+
+	// bob follows alice, carol overrides her delegation, erin's delegation is out of scope
+	require.NoError(t, f.keeper.SetVoteDelegation(f.ctx, types.VoteDelegation{Delegator: bob, Representative: alice}))
+	require.NoError(t, f.keeper.SetVoteDelegation(f.ctx, types.VoteDelegation{Delegator: carol, Representative: alice}))
+	require.NoError(t, f.keeper.SetVoteDelegation(f.ctx, types.VoteDelegation{
+		Delegator:      erin,
+		Representative: alice,
+		MsgTypeUrls:    []string{"/cosmos.bank.v1beta1.MsgSend"},
+	}))
+
+	votes := []v1.Vote{
+		newVote(alice, v1.OptionYes),
+		newVote(carol, v1.OptionNo),
+		newVote(dave, v1.OptionAbstain),
+		newVote(validatorAcc, v1.OptionNoWithVeto),
+	}
+
+	total, results, err := f.keeper.TallyVotes(f.ctx, v1.Proposal{Id: 1}, votes, validators)
+	require.NoError(t, err)
+
+	// alice 100*2.0 + bob 50*1.0
+	require.Equal(t, math.LegacyNewDec(250), weightedYes(t, f, 1))
+
+	// x/gov receives the 1000 staked that voted, split like the 1100 weighted power: yes 250,
+	// no 30, abstain 20 and the validator keeps 1000 - 200 deducted, including erin's 40
+	weightedTotal, stake := math.LegacyNewDec(1100), math.LegacyNewDec(1000)
+	require.Equal(t, govShare(math.LegacyNewDec(250), weightedTotal, stake), results[v1.OptionYes])
+	require.Equal(t, govShare(math.LegacyNewDec(30), weightedTotal, stake), results[v1.OptionNo])
+	require.Equal(t, govShare(math.LegacyNewDec(20), weightedTotal, stake), results[v1.OptionAbstain])
+	require.Equal(t, govShare(math.LegacyNewDec(800), weightedTotal, stake), results[v1.OptionNoWithVeto])
+	require.Equal(t, stake, total)
+}
+
+func TestTallyVotesDelegationScopeAndDepth(t *testing.T) {
+	f := initFixture(t)
+	valAddr, validators := tallyValidators(t, f)
+
+	alice := setRoleHolder(t, f, 0, "alice", "core_contributor", "2.0")
+	bob := setRoleHolder(t, f, 1, "bob", "community_member", "1.0")
+	carol := setRoleHolder(t, f, 2, "carol", "community_member", "1.0")
+
+	f.stakingKeeper.Delegate(testAccAddress("alice"), valAddr, 100)
+	f.stakingKeeper.Delegate(testAccAddress("bob"), valAddr, 50)
+	f.stakingKeeper.Delegate(testAccAddress("carol"), valAddr, 30)
+
+	// carol -> bob -> alice, scoped to bank sends
+	scope := []string{"/cosmos.bank.v1beta1.MsgSend"}
+	require.NoError(t, f.keeper.SetVoteDelegation(f.ctx, types.VoteDelegation{Delegator: carol, Representative: bob, MsgTypeUrls: scope}))
+	require.NoError(t, f.keeper.SetVoteDelegation(f.ctx, types.VoteDelegation{Delegator: bob, Representative: alice, MsgTypeUrls: scope}))
+
+	bankProposal := v1.Proposal{Id: 1, Messages: []*codectypes.Any{{TypeUrl: "/cosmos.bank.v1beta1.MsgSend"}}}
+	mixedProposal := v1.Proposal{Id: 2, Messages: []*codectypes.Any{
+		{TypeUrl: "/cosmos.bank.v1beta1.MsgSend"},
+		{TypeUrl: "/cosmos.staking.v1beta1.MsgUpdateParams"},
+	}}
+
+	tests := []struct {
+		desc     string
+		proposal v1.Proposal
+		maxDepth uint32
+		yes      int64
+	}{
+		{desc: "in scope", proposal: bankProposal, maxDepth: 3, yes: 280},
+		{desc: "depth limit", proposal: bankProposal, maxDepth: 1, yes: 250},
+		{desc: "out of scope", proposal: mixedProposal, maxDepth: 3, yes: 200},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.MaxVoteDelegationDepth = tc.maxDepth
+			require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+			_, _, err := f.keeper.TallyVotes(f.ctx, tc.proposal, []v1.Vote{newVote(alice, v1.OptionYes)}, copyValidators(validators))
+			require.NoError(t, err)
+			require.Equal(t, math.LegacyNewDec(tc.yes), weightedYes(t, f, tc.proposal.Id))
+		})
+	}
+}
+
+func copyValidators(validators map[string]v1.ValidatorGovInfo) map[string]v1.ValidatorGovInfo {
+	copied := make(map[string]v1.ValidatorGovInfo, len(validators))
+	for addr, val := range validators {
+		copied[addr] = val
+	}
+	return copied
+}
+
+func TestTallyVotesValidatorMultiplierScope(t *testing.T) {
+	f := initFixture(t)
+	valAddr, validators := tallyValidators(t, f)
+
+	operator := setRoleHolder(t, f, 0, "validator", "validator", "1.5")
+	alice := setRoleHolder(t, f, 1, "alice", "core_contributor", "2.0")
+
+	// self bond 100, alice votes herself with 200, bob inherits with 300
+	f.stakingKeeper.Delegate(testAccAddress("validator"), valAddr, 100)
+	f.stakingKeeper.Delegate(testAccAddress("alice"), valAddr, 200)
+	f.stakingKeeper.Delegate(testAccAddress("bob"), valAddr, 300)
+
+	votes := []v1.Vote{
+		newVote(operator, v1.OptionYes),
+		newVote(alice, v1.OptionNo),
+	}
+
+	tests := []struct {
+		scope string
+		yes   math.LegacyDec
+	}{
+		// 100*1.5 + 700
+		{scope: types.ValidatorMultiplierScopeSelfBondOnly, yes: math.LegacyNewDec(850)},
+		// 100*1.5 + 700*1.5
+		{scope: types.ValidatorMultiplierScopeAllInherited, yes: math.LegacyNewDec(1200)},
+		// 100 + 700
+		{scope: types.ValidatorMultiplierScopeNone, yes: math.LegacyNewDec(800)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.scope, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.ValidatorMultiplierScope = tc.scope
+			require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+			total, results, err := f.keeper.TallyVotes(f.ctx, v1.Proposal{Id: 1}, votes, copyValidators(validators))
+			require.NoError(t, err)
+			require.Equal(t, tc.yes, weightedYes(t, f, 1))
+			// a delegator voting herself always uses her own multiplier and leaves the validator's
+			// power: no 200*2.0 of the 1000 staked that voted
+			stake := math.LegacyNewDec(1000)
+			require.Equal(t, govShare(math.LegacyNewDec(400), tc.yes.Add(math.LegacyNewDec(400)), stake), results[v1.OptionNo])
+			require.Equal(t, stake, total)
+		})
+	}
+}
+
+func TestTallyVotesBicameral(t *testing.T) {
+	f := initFixture(t)
+	valAddr, validators := tallyValidators(t, f)
+
+	params := types.DefaultParams()
+	params.TallyMode = types.TallyModeBicameral
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	alice := setRoleHolder(t, f, 0, "alice", "core_contributor", "2.0")
+	bob := setRoleHolder(t, f, 1, "bob", "community_member", "1.0")
+	setRoleHolder(t, f, 2, "carol", "community_member", "1.0")
+	dave, err := f.addressCodec.BytesToString(testAccAddress("dave"))
+	require.NoError(t, err)
+
+	f.stakingKeeper.Delegate(testAccAddress("alice"), valAddr, 100)
+	f.stakingKeeper.Delegate(testAccAddress("bob"), valAddr, 600)
+	f.stakingKeeper.Delegate(testAccAddress("dave"), valAddr, 200)
+
+	tests := []struct {
+		desc       string
+		alice, bob v1.VoteOption
+		tokenHouse bool
+		roleHouse  bool
+		results    map[v1.VoteOption]math.LegacyDec
+	}{
+		{
+			desc:       "both chambers pass",
+			alice:      v1.OptionYes,
+			bob:        v1.OptionYes,
+			tokenHouse: true,
+			roleHouse:  true,
+			results:    map[v1.VoteOption]math.LegacyDec{v1.OptionYes: math.LegacyNewDec(900), v1.OptionAbstain: math.LegacyZeroDec(), v1.OptionNo: math.LegacyZeroDec()},
+		},
+		{
+			// stake 300 yes against 600 no, seats 2 yes against 1 no
+			desc:      "token house rejects",
+			alice:     v1.OptionYes,
+			bob:       v1.OptionNo,
+			roleHouse: true,
+			results:   map[v1.VoteOption]math.LegacyDec{v1.OptionYes: math.LegacyZeroDec(), v1.OptionAbstain: math.LegacyNewDec(300), v1.OptionNo: math.LegacyNewDec(600)},
+		},
+		{
+			// stake 800 yes against 100 no, seats 1 yes against 2 no
+			desc:       "role house rejects",
+			alice:      v1.OptionNo,
+			bob:        v1.OptionYes,
+			tokenHouse: true,
+			results:    map[v1.VoteOption]math.LegacyDec{v1.OptionYes: math.LegacyZeroDec(), v1.OptionAbstain: math.LegacyNewDec(800), v1.OptionNo: math.LegacyNewDec(100)},
+		},
+	}
+	for i, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			proposal := v1.Proposal{Id: uint64(i + 1)}
+			votes := []v1.Vote{
+				newVote(alice, tc.alice),
+				newVote(bob, tc.bob),
+				newVote(dave, v1.OptionYes),
+			}
+
+			total, results, err := f.keeper.TallyVotes(f.ctx, proposal, votes, copyValidators(validators))
+			require.NoError(t, err)
+			require.Equal(t, math.LegacyNewDec(900), total)
+			for option, power := range tc.results {
+				require.Equal(t, power, results[option], option.String())
+			}
+
+			chamberTally, err := f.keeper.ChamberTallies.Get(f.ctx, proposal.Id)
+			require.NoError(t, err)
+			require.Equal(t, tc.tokenHouse, chamberTally.TokenHousePassed)
+			require.Equal(t, tc.roleHouse, chamberTally.RoleHousePassed)
+		})
+	}
+
+	chamberTally, err := f.keeper.ChamberTallies.Get(f.ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, v1.TallyResult{YesCount: "300", AbstainCount: "0", NoCount: "600", NoWithVetoCount: "0"}, chamberTally.TokenHouse)
+	require.Equal(t, math.LegacyNewDec(2).String(), chamberTally.RoleHouse.YesCount)
+	require.Equal(t, math.LegacyOneDec().String(), chamberTally.RoleHouse.NoCount)
+}
+
+func TestTallyVotesBicameralRoleQuorum(t *testing.T) {
+	f := initFixture(t)
+	valAddr, validators := tallyValidators(t, f)
+
+	params := types.DefaultParams()
+	params.TallyMode = types.TallyModeBicameral
+	params.RoleHouse = types.NewChamberParams(math.LegacyMustNewDecFromStr("0.5"), types.DefaultChamberThreshold)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	// alice holds 1 of 3 seats
+	alice := setRoleHolder(t, f, 0, "alice", "community_member", "1.0")
+	setRoleHolder(t, f, 1, "bob", "core_contributor", "2.0")
+	f.stakingKeeper.Delegate(testAccAddress("alice"), valAddr, 900)
+
+	_, results, err := f.keeper.TallyVotes(f.ctx, v1.Proposal{Id: 1}, []v1.Vote{newVote(alice, v1.OptionYes)}, validators)
+	require.NoError(t, err)
+	require.True(t, results[v1.OptionYes].IsZero())
+
+	chamberTally, err := f.keeper.ChamberTallies.Get(f.ctx, 1)
+	require.NoError(t, err)
+	require.True(t, chamberTally.TokenHousePassed)
+	require.False(t, chamberTally.RoleHousePassed)
+}
@@ -0,0 +1,274 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// SetTimelock stores a timelock and indexes it by execution time.
+func (k Keeper) SetTimelock(ctx context.Context, timelock types.Timelock) error {
+	if err := k.Timelocks.Set(ctx, timelock.ProposalId, timelock); err != nil {
+		return err
+	}
+
+	return k.TimelockQueue.Set(ctx, collections.Join(timelock.ExecuteAfter, timelock.ProposalId))
+}
+
+// RemoveTimelock removes a timelock and its index.
+func (k Keeper) RemoveTimelock(ctx context.Context, timelock types.Timelock) error {
+	if err := k.Timelocks.Remove(ctx, timelock.ProposalId); err != nil {
+		return err
+	}
+
+	return k.TimelockQueue.Remove(ctx, collections.Join(timelock.ExecuteAfter, timelock.ProposalId))
+}
+
+// ValidateTimelockedProposal requires every message of a proposal to be wrapped in
+// MsgTimelockMessages while the veto window is enabled.
+func (k Keeper) ValidateTimelockedProposal(ctx context.Context, proposal v1.Proposal) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.VetoWindow == 0 {
+		return nil
+	}
+
+	messages, err := proposal.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	for _, msg := range messages {
+		wrapper, ok := msg.(*types.MsgTimelockMessages)
+		if !ok {
+			return errorsmod.Wrapf(types.ErrTimelockRequired, "proposal %d contains %s", proposal.Id, sdk.MsgTypeURL(msg))
+		}
+
+		held, err := wrapper.GetMsgs()
+		if err != nil {
+			return err
+		}
+		if err := k.validateHeldMessages(held); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateHeldMessages checks that the held messages can be executed with the module
+// authority, like x/gov checks proposal messages.
+func (k Keeper) validateHeldMessages(messages []sdk.Msg) error {
+	for _, msg := range messages {
+		if _, ok := msg.(*types.MsgTimelockMessages); ok {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "timelocked messages cannot be nested")
+		}
+
+		if k.router == nil || k.router.Handler(msg) == nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+
+		signers, _, err := k.cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return err
+		}
+		if len(signers) != 1 || !bytes.Equal(signers[0], k.GetAuthority()) {
+			return errorsmod.Wrapf(types.ErrInvalidSigner, "%s must be signed by the module authority only", sdk.MsgTypeURL(msg))
+		}
+	}
+
+	return nil
+}
+
+// HoldProposalMessages files timelocked messages under the proposal x/gov is executing,
+// until its veto window ends. The proposal must have passed its tally and be ending in
+// this block. It runs in the context x/gov executes the proposal in, so nothing is held
+// when the execution fails.
+func (k Keeper) HoldProposalMessages(ctx context.Context, messages []*codectypes.Any) error {
+	proposalID, err := k.TimelockProposal.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "timelocked messages can only be executed by a passed proposal")
+		}
+		return err
+	}
+
+	// x/gov updates the status of the proposal after executing its messages
+	proposal, err := k.getProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if proposal.Status != v1.StatusVotingPeriod || proposal.VotingEndTime == nil || proposal.VotingEndTime.After(sdkCtx.BlockTime()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "proposal %d is not being executed", proposalID)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// a proposal may hold several MsgTimelockMessages
+	timelock, err := k.Timelocks.Get(ctx, proposalID)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	timelock.ProposalId = proposalID
+	timelock.Messages = append(timelock.Messages, messages...)
+	timelock.ExecuteAfter = sdkCtx.BlockTime().Unix() + int64(params.VetoWindow)
+	if err := k.SetTimelock(ctx, timelock); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalTimelocked,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyExecuteAfter, fmt.Sprintf("%d", timelock.ExecuteAfter)),
+		),
+	)
+
+	return nil
+}
+
+// IsVetoHolder reports whether the address holds a role with the veto capability. Suspended
+// roles cannot veto.
+func (k Keeper) IsVetoHolder(ctx context.Context, address string) (bool, error) {
+	var vetoHolder bool
+	err := k.VoterRole.Walk(ctx, nil, func(_ uint64, role types.VoterRole) (bool, error) {
+		vetoHolder = role.Address == address && role.VetoHolder && !role.IsSuspended()
+		return vetoHolder, nil
+	})
+	return vetoHolder, err
+}
+
+// IsVetoed reports whether the veto holders that vetoed hold more than the veto threshold
+// of the multipliers of all veto holders.
+func (k Keeper) IsVetoed(ctx context.Context, timelock types.Timelock, threshold math.LegacyDec) (bool, error) {
+	total, vetoed := math.LegacyZeroDec(), math.LegacyZeroDec()
+	err := k.VoterRole.Walk(ctx, nil, func(_ uint64, role types.VoterRole) (bool, error) {
+		if !role.VetoHolder || role.IsSuspended() {
+			return false, nil
+		}
+
+		multiplier, err := math.LegacyNewDecFromStr(role.Multiplier)
+		if err != nil {
+			multiplier = math.LegacyOneDec()
+		}
+
+		total = total.Add(multiplier)
+		if slices.Contains(timelock.Vetoes, role.Address) {
+			vetoed = vetoed.Add(multiplier)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if !total.IsPositive() {
+		return false, nil
+	}
+
+	return vetoed.Quo(total).GT(threshold), nil
+}
+
+// ProcessTimelocks executes the held messages of every timelock whose veto window ended.
+// Like x/gov, the messages of a timelock are executed atomically: if one fails, none of
+// them is applied and the failure is reported in an event.
+func (k Keeper) ProcessTimelocks(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime().Unix()
+
+	rng := new(collections.Range[collections.Pair[int64, uint64]]).
+		EndExclusive(collections.PairPrefix[int64, uint64](now + 1))
+	var due []collections.Pair[int64, uint64]
+	if err := k.TimelockQueue.Walk(ctx, rng, func(key collections.Pair[int64, uint64]) (bool, error) {
+		due = append(due, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		timelock, err := k.Timelocks.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		if err := k.RemoveTimelock(ctx, timelock); err != nil {
+			return err
+		}
+
+		result, errMsg := "executed", ""
+		if err := k.executeTimelock(sdkCtx, timelock); err != nil {
+			result, errMsg = "failed", err.Error()
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTimelockExecuted,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", timelock.ProposalId)),
+				sdk.NewAttribute(types.AttributeKeyResult, result),
+				sdk.NewAttribute(types.AttributeKeyError, errMsg),
+			),
+		)
+	}
+
+	return nil
+}
+
+func (k Keeper) executeTimelock(ctx sdk.Context, timelock types.Timelock) error {
+	messages, err := timelock.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	var events sdk.Events
+	cacheCtx, writeCache := ctx.CacheContext()
+	for i, msg := range messages {
+		res, err := k.safeExecute(cacheCtx, msg)
+		if err != nil {
+			return fmt.Errorf("msg %d (%s) failed on execution: %w", i, sdk.MsgTypeURL(msg), err)
+		}
+		events = append(events, res.GetEvents()...)
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(events)
+
+	return nil
+}
+
+// safeExecute routes a message to its handler and turns a panic into an error.
+func (k Keeper) safeExecute(ctx sdk.Context, msg sdk.Msg) (res *sdk.Result, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handling x/voting timelocked message: %v", r)
+		}
+	}()
+
+	if k.router == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "message router not set")
+	}
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+	}
+
+	return handler(ctx, msg)
+}
@@ -0,0 +1,242 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+
+	"cosmos-weighted-governance-sdk/x/voting/keeper"
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// setVetoWindow stores the veto window in the module params.
+func setVetoWindow(t *testing.T, f *fixture, window uint32) {
+	t.Helper()
+
+	params := types.DefaultParams()
+	params.VetoWindow = window
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+}
+
+// setVetoHolder stores a voter role with the veto capability and returns its address.
+func setVetoHolder(t *testing.T, f *fixture, id uint64, name string) string {
+	t.Helper()
+
+	address := setRoleHolder(t, f, id, name, "council", "1.0")
+	role, err := f.keeper.VoterRole.Get(f.ctx, id)
+	require.NoError(t, err)
+	role.VetoHolder = true
+	require.NoError(t, f.keeper.VoterRole.Set(f.ctx, id, role))
+
+	return address
+}
+
+// authorityMsg returns a module message signed by the module authority.
+func authorityMsg(t *testing.T, f *fixture) *types.MsgUpdateParams {
+	t.Helper()
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	return &types.MsgUpdateParams{Authority: authority, Params: types.DefaultParams()}
+}
+
+func withBlockTime(f *fixture, unix int64) sdk.Context {
+	return sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(unix, 0))
+}
+
+func TestValidateTimelockedProposal(t *testing.T) {
+	f := initFixture(t)
+	held := authorityMsg(t, f)
+	f.router.Register(sdk.MsgTypeURL(held), nil)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString(testAccAddress("other"))
+	require.NoError(t, err)
+
+	newProposal := func(msgs ...sdk.Msg) v1.Proposal {
+		anys, err := sdktx.SetMsgs(msgs)
+		require.NoError(t, err)
+		return v1.Proposal{Id: 1, Messages: anys}
+	}
+	wrap := func(msgs ...sdk.Msg) sdk.Msg {
+		wrapper, err := types.NewMsgTimelockMessages(authority, msgs)
+		require.NoError(t, err)
+		return wrapper
+	}
+
+	// a disabled veto window does not inspect the proposal messages
+	require.NoError(t, f.keeper.ValidateTimelockedProposal(f.ctx, v1.Proposal{
+		Id:       1,
+		Messages: []*codectypes.Any{{TypeUrl: msgSendURL}},
+	}))
+
+	setVetoWindow(t, f, 100)
+
+	for _, tc := range []struct {
+		desc     string
+		proposal v1.Proposal
+		err      error
+	}{
+		{
+			desc:     "timelocked",
+			proposal: newProposal(wrap(held)),
+		},
+		{
+			desc:     "not timelocked",
+			proposal: newProposal(held),
+			err:      types.ErrTimelockRequired,
+		},
+		{
+			desc:     "nested",
+			proposal: newProposal(wrap(wrap(held))),
+			err:      sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:     "unroutable",
+			proposal: newProposal(wrap(types.NewMsgVetoProposal(authority, 1))),
+			err:      sdkerrors.ErrUnknownRequest,
+		},
+		{
+			desc:     "not signed by the authority",
+			proposal: newProposal(wrap(&types.MsgUpdateParams{Authority: other, Params: types.DefaultParams()})),
+			err:      types.ErrInvalidSigner,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := f.keeper.ValidateTimelockedProposal(f.ctx, tc.proposal)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTimelockExecution(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	setVetoWindow(t, f, 100)
+
+	held := authorityMsg(t, f)
+	f.router.Register(sdk.MsgTypeURL(held), nil)
+
+	wrapper, err := types.NewMsgTimelockMessages(held.Authority, []sdk.Msg{held})
+	require.NoError(t, err)
+
+	// only the module authority can timelock messages
+	other, err := f.addressCodec.BytesToString(testAccAddress("other"))
+	require.NoError(t, err)
+	_, err = srv.TimelockMessages(f.ctx, &types.MsgTimelockMessages{Authority: other, Messages: wrapper.Messages})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	// the messages are only held while x/gov executes a tallied proposal
+	ctx := withBlockTime(f, 1000)
+	_, err = srv.TimelockMessages(ctx, wrapper)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// the proposal x/gov passed must be the one it is ending
+	ending := func(id uint64, end int64) {
+		endTime := time.Unix(end, 0)
+		f.govKeeper.proposals[id] = v1.Proposal{Id: id, Status: v1.StatusVotingPeriod, VotingEndTime: &endTime}
+	}
+	ending(1, 1001)
+	require.NoError(t, f.keeper.TimelockProposal.Set(ctx, 1))
+	_, err = srv.TimelockMessages(ctx, wrapper)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	f.govKeeper.proposals[1] = v1.Proposal{Id: 1, Status: v1.StatusPassed}
+	_, err = srv.TimelockMessages(ctx, wrapper)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// the handler files the messages under the proposal x/gov passed last
+	ending(1, 1000)
+	ending(2, 1000)
+	ending(3, 1000)
+	_, err = srv.TimelockMessages(ctx, wrapper)
+	require.NoError(t, err)
+	timelock, err := f.keeper.Timelocks.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(1100), timelock.ExecuteAfter)
+	require.Equal(t, wrapper.Messages, timelock.Messages)
+
+	// nothing is held when the execution of the proposal fails and its state is discarded
+	require.NoError(t, f.keeper.TimelockProposal.Set(ctx, 2))
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = srv.TimelockMessages(cacheCtx, wrapper)
+	require.NoError(t, err)
+	has, err := f.keeper.Timelocks.Has(ctx, 2)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the next proposal does not inherit the messages of the failed one
+	require.NoError(t, f.keeper.TimelockProposal.Set(ctx, 3))
+	_, err = srv.TimelockMessages(ctx, wrapper)
+	require.NoError(t, err)
+	timelock, err = f.keeper.Timelocks.Get(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, wrapper.Messages, timelock.Messages)
+
+	// the messages are held during the veto window
+	require.NoError(t, f.keeper.ProcessTimelocks(withBlockTime(f, 1099)))
+	require.Empty(t, f.router.executed)
+
+	require.NoError(t, f.keeper.ProcessTimelocks(withBlockTime(f, 1100)))
+	require.Equal(t, []sdk.Msg{held, held}, f.router.executed)
+
+	for _, proposalID := range []uint64{1, 3} {
+		has, err = f.keeper.Timelocks.Has(ctx, proposalID)
+		require.NoError(t, err)
+		require.False(t, has)
+	}
+}
+
+func TestVetoProposalMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	alice := setVetoHolder(t, f, 0, "alice")
+	bob := setVetoHolder(t, f, 1, "bob")
+	setVetoHolder(t, f, 2, "carol")
+	dave := setRoleHolder(t, f, 3, "dave", "core_contributor", "2.0")
+
+	anys, err := sdktx.SetMsgs([]sdk.Msg{authorityMsg(t, f)})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.SetTimelock(f.ctx, types.Timelock{ProposalId: 1, Messages: anys, ExecuteAfter: 1100}))
+	require.NoError(t, f.keeper.SetTimelock(f.ctx, types.Timelock{ProposalId: 2, Messages: anys, ExecuteAfter: 1000}))
+	ctx := withBlockTime(f, 1000)
+
+	_, err = srv.VetoProposal(ctx, types.NewMsgVetoProposal(dave, 1))
+	require.ErrorIs(t, err, types.ErrNotVetoHolder)
+
+	_, err = srv.VetoProposal(ctx, types.NewMsgVetoProposal(alice, 3))
+	require.ErrorIs(t, err, types.ErrTimelockNotFound)
+
+	_, err = srv.VetoProposal(ctx, types.NewMsgVetoProposal(alice, 2))
+	require.ErrorIs(t, err, types.ErrVetoWindowClosed)
+
+	// one of three veto holders does not exceed the default threshold of one half
+	res, err := srv.VetoProposal(ctx, types.NewMsgVetoProposal(alice, 1))
+	require.NoError(t, err)
+	require.False(t, res.Vetoed)
+
+	_, err = srv.VetoProposal(ctx, types.NewMsgVetoProposal(alice, 1))
+	require.ErrorIs(t, err, types.ErrAlreadyVetoed)
+
+	res, err = srv.VetoProposal(ctx, types.NewMsgVetoProposal(bob, 1))
+	require.NoError(t, err)
+	require.True(t, res.Vetoed)
+
+	// a vetoed proposal never executes
+	has, err := f.keeper.Timelocks.Has(ctx, 1)
+	require.NoError(t, err)
+	require.False(t, has)
+	require.NoError(t, f.keeper.ProcessTimelocks(withBlockTime(f, 2000)))
+	require.Empty(t, f.router.executed)
+}
@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// SetVoteDelegation stores a vote delegation, replacing any previous delegation of the delegator.
+func (k Keeper) SetVoteDelegation(ctx context.Context, delegation types.VoteDelegation) error {
+	previous, err := k.VoteDelegations.Get(ctx, delegation.Delegator)
+	switch {
+	case err == nil:
+		if err := k.VoteDelegationsByRepresentative.Remove(ctx, collections.Join(previous.Representative, previous.Delegator)); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	if err := k.VoteDelegations.Set(ctx, delegation.Delegator, delegation); err != nil {
+		return err
+	}
+
+	return k.VoteDelegationsByRepresentative.Set(ctx, collections.Join(delegation.Representative, delegation.Delegator))
+}
+
+// RemoveVoteDelegation removes the vote delegation of a delegator.
+func (k Keeper) RemoveVoteDelegation(ctx context.Context, delegator string) (types.VoteDelegation, error) {
+	delegation, err := k.VoteDelegations.Get(ctx, delegator)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.VoteDelegation{}, errorsmod.Wrapf(types.ErrVoteDelegationNotFound, "delegator %s", delegator)
+		}
+		return types.VoteDelegation{}, err
+	}
+
+	if err := k.VoteDelegations.Remove(ctx, delegator); err != nil {
+		return types.VoteDelegation{}, err
+	}
+
+	if err := k.VoteDelegationsByRepresentative.Remove(ctx, collections.Join(delegation.Representative, delegator)); err != nil {
+		return types.VoteDelegation{}, err
+	}
+
+	return delegation, nil
+}
+
+// RemoveVoteDelegationsFor removes every vote delegation made by or to an address.
+// It is called when the address loses its voter role.
+func (k Keeper) RemoveVoteDelegationsFor(ctx context.Context, address string) error {
+	if _, err := k.RemoveVoteDelegation(ctx, address); err != nil && !errors.Is(err, types.ErrVoteDelegationNotFound) {
+		return err
+	}
+
+	delegators, err := k.delegatorsOf(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	for _, delegator := range delegators {
+		if _, err := k.RemoveVoteDelegation(ctx, delegator); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateVoteDelegation checks that delegating from delegator to representative neither
+// creates a cycle nor makes any delegation chain longer than maxDepth. Scopes are ignored
+// so that the delegation graph stays acyclic for every proposal. Both walks stop once the
+// chain is known to be too long.
+func (k Keeper) ValidateVoteDelegation(ctx context.Context, delegator, representative string, maxDepth uint32) error {
+	depthErr := func(depth uint32) error {
+		return errorsmod.Wrapf(types.ErrVoteDelegationDepth, "chain length %d exceeds max %d", depth, maxDepth)
+	}
+
+	// walk down from the representative
+	below := uint32(0)
+	current := representative
+	for {
+		if current == delegator {
+			return errorsmod.Wrapf(types.ErrVoteDelegationCycle, "%s already delegates to %s", representative, delegator)
+		}
+
+		next, err := k.VoteDelegations.Get(ctx, current)
+		if errors.Is(err, collections.ErrNotFound) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		below++
+		if below > maxDepth {
+			return depthErr(1 + below)
+		}
+		current = next.Representative
+	}
+	if 1+below > maxDepth {
+		return depthErr(1 + below)
+	}
+
+	above, err := k.longestIncomingChain(ctx, delegator, maxDepth-1-below)
+	if err != nil {
+		return err
+	}
+
+	if depth := above + 1 + below; depth > maxDepth {
+		return depthErr(depth)
+	}
+
+	return nil
+}
+
+// longestIncomingChain returns the length of the longest delegation chain ending at
+// address. The walk stops once a chain is longer than limit, returning limit+1.
+func (k Keeper) longestIncomingChain(ctx context.Context, address string, limit uint32) (uint32, error) {
+	delegators, err := k.delegatorsOf(ctx, address)
+	if err != nil {
+		return 0, err
+	}
+	if len(delegators) == 0 {
+		return 0, nil
+	}
+	if limit == 0 {
+		return 1, nil
+	}
+
+	var longest uint32
+	for _, delegator := range delegators {
+		length, err := k.longestIncomingChain(ctx, delegator, limit-1)
+		if err != nil {
+			return 0, err
+		}
+		if length+1 > longest {
+			longest = length + 1
+		}
+		if longest > limit {
+			break
+		}
+	}
+
+	return longest, nil
+}
+
+// delegatorsOf returns the addresses delegating their vote directly to representative.
+func (k Keeper) delegatorsOf(ctx context.Context, representative string) ([]string, error) {
+	var delegators []string
+	rng := collections.NewPrefixedPairRange[string, string](representative)
+	err := k.VoteDelegationsByRepresentative.Walk(ctx, rng, func(key collections.Pair[string, string]) (bool, error) {
+		delegators = append(delegators, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to walk vote delegations")
+	}
+
+	return delegators, nil
+}
+
+// VoteDelegationApplies reports whether a delegation covers the proposal. A scoped
+// delegation only applies when every proposal message has one of its type URLs.
+func VoteDelegationApplies(delegation types.VoteDelegation, proposal v1.Proposal) bool {
+	if len(delegation.MsgTypeUrls) == 0 {
+		return true
+	}
+
+	if len(proposal.Messages) == 0 {
+		return false
+	}
+
+	inScope := make(map[string]bool, len(delegation.MsgTypeUrls))
+	for _, typeURL := range delegation.MsgTypeUrls {
+		inScope[typeURL] = true
+	}
+
+	for _, msg := range proposal.Messages {
+		if !inScope[msg.TypeUrl] {
+			return false
+		}
+	}
+
+	return true
+}
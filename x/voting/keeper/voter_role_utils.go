@@ -4,36 +4,40 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"cosmos-weighted-governance-sdk/x/voting/types"
 
-	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
-// GetVoterRoleByAddress retrieves a voter role by address
+// GetVoterRoleByAddress retrieves the voter role of an address, the one with the lowest id
+// if it holds several
 func (k Keeper) GetVoterRoleByAddress(ctx context.Context, address string) (*types.VoterRole, error) {
-	var foundRole *types.VoterRole
+	iter, err := k.VoterRole.Indexes.Address.MatchExact(ctx, address)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to search voter roles")
+	}
+	defer iter.Close()
 
-	err := k.VoterRole.Walk(ctx, nil, func(key uint64, role types.VoterRole) (bool, error) {
-		if role.Address == address {
-			foundRole = &role
-			return true, nil // stop iteration
-		}
-		return false, nil // continue iteration
-	})
+	if !iter.Valid() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "voter role not found for address")
+	}
 
+	id, err := iter.PrimaryKey()
 	if err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to search voter roles")
 	}
 
-	if foundRole == nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "voter role not found for address")
+	role, err := k.VoterRole.Get(ctx, id)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to search voter roles")
 	}
 
-	return foundRole, nil
+	return &role, nil
 }
 
 // GetVotingMultiplier returns the voting multiplier for a given address
@@ -41,12 +45,17 @@ func (k Keeper) GetVotingMultiplier(ctx context.Context, address string) (math.L
 	role, err := k.GetVoterRoleByAddress(ctx, address)
 	if err != nil {
 		// If no role found, return default multiplier of 1.0
-		if errors.Is(err, collections.ErrNotFound) {
+		if errors.Is(err, sdkerrors.ErrKeyNotFound) {
 			return math.LegacyOneDec(), nil
 		}
 		return math.LegacyDec{}, err
 	}
 
+	// a suspended role counts like no role
+	if role.IsSuspended() {
+		return math.LegacyOneDec(), nil
+	}
+
 	// Parse the multiplier string to decimal
 	multiplier, err := math.LegacyNewDecFromStr(role.Multiplier)
 	if err != nil {
@@ -65,6 +74,16 @@ func (k Keeper) ValidateVoterRole(address, role, multiplier string) error {
 	}
 
 	// Validate role type
+	if err := validateRole(role); err != nil {
+		return err
+	}
+
+	// Validate multiplier
+	return validateMultiplier(multiplier)
+}
+
+// validateRole checks that the role is one of the valid role types
+func validateRole(role string) error {
 	validRoles := map[string]bool{
 		"core_contributor":  true,
 		"validator":         true,
@@ -77,7 +96,11 @@ func (k Keeper) ValidateVoterRole(address, role, multiplier string) error {
 			fmt.Sprintf("invalid role: %s. Valid roles are: core_contributor, validator, community_member, strategic_partner", role))
 	}
 
-	// Validate multiplier
+	return nil
+}
+
+// validateMultiplier checks the multiplier format and bounds
+func validateMultiplier(multiplier string) error {
 	multiplierDec, err := math.LegacyNewDecFromStr(multiplier)
 	if err != nil {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest,
@@ -96,6 +119,77 @@ func (k Keeper) ValidateVoterRole(address, role, multiplier string) error {
 	return nil
 }
 
+// ValidateMsgTypeMultipliers validates per message type multiplier overrides
+func (k Keeper) ValidateMsgTypeMultipliers(overrides []types.MsgTypeMultiplier) error {
+	seen := make(map[string]bool, len(overrides))
+	for _, override := range overrides {
+		if !strings.HasPrefix(override.MsgTypeUrl, "/") || len(override.MsgTypeUrl) == 1 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid msg type url: %q", override.MsgTypeUrl)
+		}
+		if seen[override.MsgTypeUrl] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate multiplier override for %s", override.MsgTypeUrl)
+		}
+		seen[override.MsgTypeUrl] = true
+
+		if err := validateMultiplier(override.Multiplier); err != nil {
+			return errorsmod.Wrapf(err, "override for %s", override.MsgTypeUrl)
+		}
+	}
+
+	return nil
+}
+
+// EffectiveMultiplier resolves the multiplier of a role on a proposal. Each proposal
+// message counts with its override, or the base multiplier when it has none, and the
+// lowest of them applies. It also returns the message type whose override decided the
+// result, empty when the base multiplier applies. A suspended role counts with 1.0.
+func EffectiveMultiplier(role types.VoterRole, proposal v1.Proposal) (math.LegacyDec, string, error) {
+	if role.IsSuspended() {
+		return math.LegacyOneDec(), "", nil
+	}
+
+	base, err := math.LegacyNewDecFromStr(role.Multiplier)
+	if err != nil {
+		return math.LegacyDec{}, "", errorsmod.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("invalid multiplier format: %s", role.Multiplier))
+	}
+
+	if len(role.MsgTypeMultipliers) == 0 || len(proposal.Messages) == 0 {
+		return base, "", nil
+	}
+
+	overrides := make(map[string]math.LegacyDec, len(role.MsgTypeMultipliers))
+	for _, override := range role.MsgTypeMultipliers {
+		multiplier, err := math.LegacyNewDecFromStr(override.Multiplier)
+		if err != nil {
+			return math.LegacyDec{}, "", errorsmod.Wrap(sdkerrors.ErrInvalidRequest,
+				fmt.Sprintf("invalid multiplier format: %s", override.Multiplier))
+		}
+		overrides[override.MsgTypeUrl] = multiplier
+	}
+
+	var (
+		effective math.LegacyDec
+		decidedBy string
+	)
+	for i, msg := range proposal.Messages {
+		multiplier, overridden := overrides[msg.TypeUrl]
+		if !overridden {
+			multiplier = base
+		}
+
+		if i == 0 || multiplier.LT(effective) {
+			effective = multiplier
+			decidedBy = ""
+			if overridden {
+				decidedBy = msg.TypeUrl
+			}
+		}
+	}
+
+	return effective, decidedBy, nil
+}
+
 // GetDefaultMultipliers returns the default multipliers for each role type
 func (k Keeper) GetDefaultMultipliers() map[string]string {
 	return map[string]string{
@@ -149,13 +243,16 @@ func (k Keeper) GetVoterRoleStats(ctx context.Context) (map[string]int, error) {
 // CountRolesForAddress counts the number of roles assigned to a specific address
 func (k Keeper) CountRolesForAddress(ctx context.Context, address string) uint32 {
 	var count uint32
-	
-	k.VoterRole.Walk(ctx, nil, func(id uint64, vr types.VoterRole) (stop bool, err error) {
-		if vr.Address == address {
-			count++
-		}
-		return false, nil
-	})
-	
+
+	iter, err := k.VoterRole.Indexes.Address.MatchExact(ctx, address)
+	if err != nil {
+		return 0
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+
 	return count
 }
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+)
+
+// GetVotingPower returns how the weighted tally derives the voting power of an address
+// from its current delegations to bonded validators.
+func (k Keeper) GetVotingPower(ctx context.Context, address string) (*types.QueryVotingPowerResponse, error) {
+	addr, err := k.addressCodec.StringToBytes(address)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rawPower, _, err := k.bondedStake(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	transformedPower, err := TransformPower(params, rawPower)
+	if err != nil {
+		return nil, err
+	}
+
+	multiplier, err := k.GetVotingMultiplier(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVotingPowerResponse{
+		RawPower:         rawPower,
+		TransformedPower: transformedPower,
+		Multiplier:       multiplier,
+		WeightedPower:    transformedPower.Mul(multiplier),
+		PowerTransform:   params.PowerTransform,
+	}, nil
+}
+
+// bondedStake sums the tokens an address has delegated to bonded validators, and returns
+// whether it has any delegation at all.
+func (k Keeper) bondedStake(ctx context.Context, delegator sdk.AccAddress) (math.LegacyDec, bool, error) {
+	var (
+		stake     = math.LegacyZeroDec()
+		delegated bool
+		iterErr   error
+	)
+
+	err := k.stakingKeeper.IterateDelegations(ctx, delegator, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+		delegated = true
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.GetValidatorAddr())
+		if err != nil {
+			iterErr = err
+			return true
+		}
+
+		validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			iterErr = err
+			return true
+		}
+
+		if validator.IsBonded() {
+			stake = stake.Add(validator.TokensFromShares(delegation.GetShares()))
+		}
+		return false
+	})
+	if err != nil {
+		return math.LegacyZeroDec(), false, err
+	}
+
+	return stake, delegated, iterErr
+}
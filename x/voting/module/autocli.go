@@ -29,6 +29,107 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Alias:          []string{"show-voter-role"},
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
 				},
+				{
+					RpcMethod:      "VoteDelegationFrom",
+					Use:            "vote-delegation-from [delegator]",
+					Short:          "Shows the vote delegation made by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegator"}},
+				},
+				{
+					RpcMethod:      "VoteDelegationsTo",
+					Use:            "vote-delegations-to [representative]",
+					Short:          "List the vote delegations made to a representative",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "representative"}},
+				},
+				{
+					RpcMethod:      "VotingPower",
+					Use:            "voting-power [address]",
+					Short:          "Shows the raw, transformed and weighted voting power of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "EffectiveMultiplier",
+					Use:            "effective-multiplier [address] [proposal-id]",
+					Short:          "Shows the multiplier applied to an address on a proposal",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "proposal_id"}},
+				},
+				{
+					RpcMethod:      "ChamberTally",
+					Use:            "chamber-tally [proposal-id]",
+					Short:          "Shows the token house and role house results of a proposal tallied in bicameral mode",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "proposal_id"}},
+				},
+				{
+					RpcMethod:      "TallyBreakdown",
+					Use:            "tally-breakdown [proposal-id]",
+					Short:          "Shows the participation of each role in an active proposal and which role quorums are unmet",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "proposal_id"}},
+				},
+				{
+					RpcMethod: "ListTimelock",
+					Use:       "list-timelock",
+					Short:     "List all timelocked proposal messages",
+				},
+				{
+					RpcMethod:      "GetTimelock",
+					Use:            "show-timelock [proposal-id]",
+					Short:          "Shows the timelocked messages of a passed proposal and its vetoes",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "proposal_id"}},
+				},
+				{
+					RpcMethod:      "ProposalRoleTally",
+					Use:            "proposal-role-tally [proposal-id]",
+					Short:          "Shows how the holders of each role voted on a finished proposal",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "proposal_id"}},
+				},
+				{
+					RpcMethod:      "TallyPreview",
+					Use:            "tally-preview [proposal-id]",
+					Short:          "Shows the stake-only and role-weighted outcome of an active proposal if voting ended now",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "proposal_id"}},
+				},
+				{
+					RpcMethod:      "RoleChangeImpact",
+					Use:            "role-change-impact [proposal-id]",
+					Short:          "Shows how the role changes of an active proposal would swing the other active proposals",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "proposal_id"}},
+				},
+				{
+					RpcMethod:      "Participation",
+					Use:            "participation [address]",
+					Short:          "Shows whether a role holder voted on the proposals that ended while it held its role",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "ListNomination",
+					Use:       "list-nomination",
+					Short:     "List the pending voter role nominations",
+				},
+				{
+					RpcMethod:      "GetNomination",
+					Use:            "get-nomination [id]",
+					Short:          "Gets a pending voter role nomination by id",
+					Alias:          []string{"show-nomination"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "ListRoleDistribution",
+					Use:       "list-role-distribution",
+					Short:     "List the Merkle roots of voter roles published by governance",
+				},
+				{
+					RpcMethod:      "GetRoleDistribution",
+					Use:            "get-role-distribution [id]",
+					Short:          "Gets a role distribution by id",
+					Alias:          []string{"show-role-distribution"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "RoleClaimed",
+					Use:            "role-claimed [distribution-id] [address]",
+					Short:          "Shows whether an address claimed its role from a role distribution",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "distribution_id"}, {ProtoField: "address"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -58,6 +159,59 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Delete VoterRole",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
 				},
+				{
+					RpcMethod:      "DelegateVote",
+					Use:            "delegate-vote [representative]",
+					Short:          "Delegate your role-weighted voting power to a representative",
+					Long:           "Delegate your role-weighted voting power to another role holder. Use --msg-type-urls to limit the delegation to proposals made only of those message types. Voting directly on a proposal overrides the delegation.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "representative"}},
+				},
+				{
+					RpcMethod: "RevokeVoteDelegation",
+					Use:       "revoke-vote-delegation",
+					Short:     "Revoke your vote delegation",
+				},
+				{
+					RpcMethod: "TimelockMessages",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "VetoProposal",
+					Use:            "veto-proposal [proposal-id]",
+					Short:          "Veto the timelocked messages of a passed proposal as a veto holder",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "proposal_id"}},
+				},
+				{
+					RpcMethod: "SuspendVoterRole",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "ReinstateVoterRole",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "NominateVoterRole",
+					Use:            "nominate-voter-role [address] [role] [justification]",
+					Short:          "Nominate an address for a voter role",
+					Long:           "Nominate an address for a voter role. The --deposit must cover the min nomination deposit; it is refunded when the nomination is approved, rejected or expires, unless it is rejected as spam.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "role"}, {ProtoField: "justification"}},
+				},
+				{
+					RpcMethod: "ApproveNomination",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RejectNomination",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "CreateRoleDistribution",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "ClaimVoterRole",
+					Skip:      true, // the claim-voter-role custom command reads the proof from a claims file
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
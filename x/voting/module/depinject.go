@@ -1,13 +1,24 @@
 package voting
 
 import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 
 	"cosmos-weighted-governance-sdk/x/voting/keeper"
@@ -23,6 +34,7 @@ func init() {
 	appconfig.Register(
 		&types.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetGovKeeper),
 	)
 }
 
@@ -34,8 +46,12 @@ type ModuleInputs struct {
 	Cdc          codec.Codec
 	AddressCodec address.Codec
 
-	AuthKeeper types.AuthKeeper
-	BankKeeper types.BankKeeper
+	AuthKeeper    types.AuthKeeper
+	BankKeeper    types.BankKeeper
+	DistrKeeper   types.DistrKeeper
+	StakingKeeper types.StakingKeeper
+
+	MsgServiceRouter baseapp.MessageRouter
 
 	IBCKeeperFn func() *ibckeeper.Keeper `optional:"true"`
 }
@@ -45,6 +61,15 @@ type ModuleOutputs struct {
 
 	VotingKeeper keeper.Keeper
 	Module       appmodule.AppModule
+
+	// CalculateVoteResultsAndVotingPowerFn replaces the x/gov tally with the role-weighted tally.
+	CalculateVoteResultsAndVotingPowerFn govkeeper.CalculateVoteResultsAndVotingPowerFn
+	// GovHooks enforce and fill the timelock of passed proposals.
+	GovHooks govtypes.GovHooksWrapper
+	// StakingHooks grant and revoke the validator role as validators bond and unbond.
+	StakingHooks stakingtypes.StakingHooksWrapper
+	// EpochHooks grant and revoke the community role at the end of the configured epoch.
+	EpochHooks epochstypes.EpochHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -58,9 +83,67 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.Cdc,
 		in.AddressCodec,
 		authority,
+		in.StakingKeeper,
+		in.BankKeeper,
+		in.DistrKeeper,
+		in.MsgServiceRouter,
 		in.IBCKeeperFn,
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
-	return ModuleOutputs{VotingKeeper: k, Module: m}
+	return ModuleOutputs{
+		VotingKeeper:                         k,
+		Module:                               m,
+		CalculateVoteResultsAndVotingPowerFn: k.CalculateVoteResultsAndVotingPower,
+		GovHooks:                             govtypes.GovHooksWrapper{GovHooks: keeper.NewGovHooksWrapper(k, nil)},
+		StakingHooks:                         stakingtypes.StakingHooksWrapper{StakingHooks: keeper.NewStakingHooks(k)},
+		EpochHooks:                           epochstypes.EpochHooksWrapper{EpochHooks: keeper.NewEpochHooks(k)},
+	}
+}
+
+// InvokeSetGovKeeper wires the gov keeper into the voting keeper. x/gov consumes the
+// voting tally, so the gov keeper can only be set after both have been built.
+func InvokeSetGovKeeper(k keeper.Keeper, gk *govkeeper.Keeper) {
+	if gk == nil {
+		return
+	}
+
+	k.SetGovKeeper(govKeeperAdapter{gk})
+}
+
+// govKeeperAdapter exposes the gov keeper collections through types.GovKeeper.
+type govKeeperAdapter struct {
+	gk *govkeeper.Keeper
+}
+
+func (a govKeeperAdapter) GetProposal(ctx context.Context, proposalID uint64) (v1.Proposal, error) {
+	return a.gk.Proposals.Get(ctx, proposalID)
+}
+
+func (a govKeeperAdapter) GetVotes(ctx context.Context, proposalID uint64) ([]v1.Vote, error) {
+	var votes []v1.Vote
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
+	err := a.gk.Votes.Walk(ctx, rng, func(_ collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+		votes = append(votes, vote)
+		return false, nil
+	})
+	return votes, err
+}
+
+func (a govKeeperAdapter) GetParams(ctx context.Context) (v1.Params, error) {
+	return a.gk.Params.Get(ctx)
+}
+
+// GetActiveProposals returns the proposals in voting period, by voting end time.
+func (a govKeeperAdapter) GetActiveProposals(ctx context.Context) ([]v1.Proposal, error) {
+	var proposals []v1.Proposal
+	err := a.gk.ActiveProposalsQueue.Walk(ctx, nil, func(_ collections.Pair[time.Time, uint64], proposalID uint64) (bool, error) {
+		proposal, err := a.gk.Proposals.Get(ctx, proposalID)
+		if err != nil {
+			return true, err
+		}
+		proposals = append(proposals, proposal)
+		return false, nil
+	})
+	return proposals, err
 }
@@ -0,0 +1,504 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmosweightedgovernancesdk/voting/v1/chamber_tally.proto
+
+package types
+
+import (
+	fmt "fmt"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ChamberTally holds the final results of both chambers of a proposal tallied in bicameral mode.
+type ChamberTally struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// token_house is the stake-weighted chamber.
+	TokenHouse v1.TallyResult `protobuf:"bytes,2,opt,name=token_house,json=tokenHouse,proto3" json:"token_house"`
+	// role_house is the chamber where each role holder counts with its multiplier.
+	RoleHouse        v1.TallyResult `protobuf:"bytes,3,opt,name=role_house,json=roleHouse,proto3" json:"role_house"`
+	TokenHousePassed bool           `protobuf:"varint,4,opt,name=token_house_passed,json=tokenHousePassed,proto3" json:"token_house_passed,omitempty"`
+	RoleHousePassed  bool           `protobuf:"varint,5,opt,name=role_house_passed,json=roleHousePassed,proto3" json:"role_house_passed,omitempty"`
+}
+
+func (m *ChamberTally) Reset()         { *m = ChamberTally{} }
+func (m *ChamberTally) String() string { return proto.CompactTextString(m) }
+func (*ChamberTally) ProtoMessage()    {}
+func (*ChamberTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d5f93109e8fe11b, []int{0}
+}
+func (m *ChamberTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChamberTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChamberTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChamberTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChamberTally.Merge(m, src)
+}
+func (m *ChamberTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChamberTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChamberTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChamberTally proto.InternalMessageInfo
+
+func (m *ChamberTally) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ChamberTally) GetTokenHouse() v1.TallyResult {
+	if m != nil {
+		return m.TokenHouse
+	}
+	return v1.TallyResult{}
+}
+
+func (m *ChamberTally) GetRoleHouse() v1.TallyResult {
+	if m != nil {
+		return m.RoleHouse
+	}
+	return v1.TallyResult{}
+}
+
+func (m *ChamberTally) GetTokenHousePassed() bool {
+	if m != nil {
+		return m.TokenHousePassed
+	}
+	return false
+}
+
+func (m *ChamberTally) GetRoleHousePassed() bool {
+	if m != nil {
+		return m.RoleHousePassed
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*ChamberTally)(nil), "cosmosweightedgovernancesdk.voting.v1.ChamberTally")
+}
+
+func init() {
+	proto.RegisterFile("cosmosweightedgovernancesdk/voting/v1/chamber_tally.proto", fileDescriptor_8d5f93109e8fe11b)
+}
+
+var fileDescriptor_8d5f93109e8fe11b = []byte{
+	// 323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x41, 0x4b, 0xc3, 0x30,
+	0x1c, 0xc5, 0x9b, 0x39, 0x45, 0x33, 0x41, 0x0d, 0x82, 0x63, 0x87, 0x6c, 0x08, 0xc2, 0x10, 0x9b,
+	0x32, 0x3d, 0x79, 0x12, 0xe7, 0x41, 0xbd, 0x49, 0xf1, 0xe4, 0xa5, 0x74, 0x6b, 0xc8, 0xc6, 0xba,
+	0xfe, 0x4b, 0x93, 0x45, 0xf7, 0x21, 0x04, 0x3f, 0xd6, 0x8e, 0x3b, 0x7a, 0x12, 0xd9, 0xbe, 0x88,
+	0x24, 0xb1, 0xd6, 0x93, 0x78, 0x0b, 0x8f, 0xf7, 0xde, 0xef, 0x4f, 0x1e, 0xbe, 0x1c, 0x82, 0x9c,
+	0x82, 0x7c, 0xe6, 0x63, 0x31, 0x52, 0x3c, 0x11, 0xa0, 0x79, 0x91, 0xc5, 0xd9, 0x90, 0xcb, 0x64,
+	0x12, 0x68, 0x50, 0xe3, 0x4c, 0x04, 0xba, 0x17, 0x0c, 0x47, 0xf1, 0x74, 0xc0, 0x8b, 0x48, 0xc5,
+	0x69, 0x3a, 0x67, 0x79, 0x01, 0x0a, 0xc8, 0xc9, 0x1f, 0x51, 0xe6, 0xa2, 0x4c, 0xf7, 0x5a, 0x47,
+	0xce, 0x16, 0x08, 0xd0, 0xa6, 0x49, 0x80, 0x76, 0xf9, 0xd6, 0xa1, 0x00, 0x01, 0xf6, 0x19, 0x98,
+	0x97, 0x53, 0x8f, 0x5f, 0x6b, 0x78, 0xf7, 0xc6, 0xd1, 0x1e, 0x0d, 0x8c, 0xb4, 0x71, 0x23, 0x2f,
+	0x20, 0x07, 0x19, 0xa7, 0xd1, 0x38, 0x69, 0xa2, 0x0e, 0xea, 0xd6, 0x43, 0x5c, 0x4a, 0xf7, 0x09,
+	0xb9, 0xc6, 0x0d, 0x05, 0x13, 0x9e, 0x45, 0x23, 0x98, 0x49, 0xde, 0xac, 0x75, 0x50, 0xb7, 0x71,
+	0xde, 0x62, 0x0e, 0xcb, 0x0c, 0x4f, 0xf7, 0x98, 0xed, 0x0a, 0xb9, 0x9c, 0xa5, 0xaa, 0x5f, 0x5f,
+	0x7c, 0xb4, 0xbd, 0x10, 0xdb, 0xd0, 0x9d, 0xc9, 0x90, 0x2b, 0x8c, 0x0b, 0x48, 0xf9, 0x77, 0xc3,
+	0xc6, 0x3f, 0x1b, 0x76, 0x4c, 0xc6, 0x15, 0x9c, 0x61, 0xf2, 0xeb, 0x86, 0x28, 0x8f, 0xa5, 0xe4,
+	0x49, 0xb3, 0xde, 0x41, 0xdd, 0xed, 0x70, 0xbf, 0x02, 0x3d, 0x58, 0x9d, 0x9c, 0xe2, 0x83, 0x0a,
+	0x57, 0x9a, 0x37, 0xad, 0x79, 0xef, 0xa7, 0xd3, 0x79, 0xfb, 0xb7, 0x8b, 0x15, 0x45, 0xcb, 0x15,
+	0x45, 0x9f, 0x2b, 0x8a, 0xde, 0xd6, 0xd4, 0x5b, 0xae, 0xa9, 0xf7, 0xbe, 0xa6, 0xde, 0x93, 0xef,
+	0xee, 0xf3, 0xcb, 0x01, 0xfc, 0x6a, 0x01, 0xdf, 0xac, 0xf7, 0x52, 0xee, 0xa7, 0xe6, 0x39, 0x97,
+	0x83, 0x2d, 0xfb, 0xbf, 0x17, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa8, 0xe1, 0x76, 0x56, 0xf2,
+	0x01, 0x00, 0x00,
+}
+
+func (m *ChamberTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChamberTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChamberTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RoleHousePassed {
+		i--
+		if m.RoleHousePassed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TokenHousePassed {
+		i--
+		if m.TokenHousePassed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.RoleHouse.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChamberTally(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TokenHouse.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChamberTally(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ProposalId != 0 {
+		i = encodeVarintChamberTally(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintChamberTally(dAtA []byte, offset int, v uint64) int {
+	offset -= sovChamberTally(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChamberTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovChamberTally(uint64(m.ProposalId))
+	}
+	l = m.TokenHouse.Size()
+	n += 1 + l + sovChamberTally(uint64(l))
+	l = m.RoleHouse.Size()
+	n += 1 + l + sovChamberTally(uint64(l))
+	if m.TokenHousePassed {
+		n += 2
+	}
+	if m.RoleHousePassed {
+		n += 2
+	}
+	return n
+}
+
+func sovChamberTally(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozChamberTally(x uint64) (n int) {
+	return sovChamberTally(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChamberTally) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChamberTally
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChamberTally: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChamberTally: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChamberTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenHouse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChamberTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChamberTally
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChamberTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenHouse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleHouse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChamberTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChamberTally
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChamberTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RoleHouse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenHousePassed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChamberTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TokenHousePassed = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleHousePassed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChamberTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RoleHousePassed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChamberTally(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChamberTally
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipChamberTally(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowChamberTally
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChamberTally
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChamberTally
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthChamberTally
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupChamberTally
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthChamberTally
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthChamberTally        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowChamberTally          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupChamberTally = fmt.Errorf("proto: unexpected end of group")
+)
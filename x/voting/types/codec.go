@@ -11,6 +11,23 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgCreateVoterRole{},
 		&MsgUpdateVoterRole{},
 		&MsgDeleteVoterRole{},
+		&MsgSuspendVoterRole{},
+		&MsgReinstateVoterRole{},
+		&MsgNominateVoterRole{},
+		&MsgApproveNomination{},
+		&MsgRejectNomination{},
+		&MsgCreateRoleDistribution{},
+		&MsgClaimVoterRole{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDelegateVote{},
+		&MsgRevokeVoteDelegation{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTimelockMessages{},
+		&MsgVetoProposal{},
 	)
 
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
@@ -8,7 +8,25 @@ import (
 
 // x/voting module sentinel errors
 var (
-	ErrInvalidSigner        = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	ErrInvalidPacketTimeout = errors.Register(ModuleName, 1500, "invalid packet timeout")
-	ErrInvalidVersion       = errors.Register(ModuleName, 1501, "invalid version")
+	ErrInvalidSigner          = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrNoVoterRole            = errors.Register(ModuleName, 1101, "address has no voter role")
+	ErrVoteDelegationCycle    = errors.Register(ModuleName, 1102, "vote delegation would create a cycle")
+	ErrVoteDelegationDepth    = errors.Register(ModuleName, 1103, "vote delegation chain too deep")
+	ErrVoteDelegationNotFound = errors.Register(ModuleName, 1104, "vote delegation not found")
+	ErrNotVetoHolder          = errors.Register(ModuleName, 1105, "address is not a veto holder")
+	ErrTimelockNotFound       = errors.Register(ModuleName, 1106, "timelock not found")
+	ErrVetoWindowClosed       = errors.Register(ModuleName, 1107, "veto window closed")
+	ErrAlreadyVetoed          = errors.Register(ModuleName, 1108, "proposal already vetoed by address")
+	ErrTimelockRequired       = errors.Register(ModuleName, 1109, "proposal messages must be wrapped in MsgTimelockMessages")
+	ErrMixedRegistryProposal  = errors.Register(ModuleName, 1110, "voting module messages cannot be mixed with other messages in a proposal")
+	ErrRoleSuspended          = errors.Register(ModuleName, 1111, "voter role is suspended")
+	ErrRoleNotSuspended       = errors.Register(ModuleName, 1112, "voter role is not suspended")
+	ErrUnauthorizedRoleChange = errors.Register(ModuleName, 1113, "role change outside the scope of the role authority")
+	ErrInsufficientDeposit    = errors.Register(ModuleName, 1114, "deposit below the min nomination deposit")
+	ErrRoleBondRequired       = errors.Register(ModuleName, 1115, "voter role requires a bond")
+	ErrInvalidMerkleProof     = errors.Register(ModuleName, 1116, "invalid merkle proof")
+	ErrRoleAlreadyClaimed     = errors.Register(ModuleName, 1117, "voter role already claimed")
+	ErrClaimWindowClosed      = errors.Register(ModuleName, 1118, "claim window closed")
+	ErrInvalidPacketTimeout   = errors.Register(ModuleName, 1500, "invalid packet timeout")
+	ErrInvalidVersion         = errors.Register(ModuleName, 1501, "invalid version")
 )
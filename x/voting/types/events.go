@@ -6,6 +6,29 @@ const (
 	EventTypeVoterRoleUpdated = "voter_role_updated"
 	EventTypeVoterRoleDeleted = "voter_role_deleted"
 
+	EventTypeVoterRoleDowngraded = "voter_role_downgraded"
+	EventTypeVoterRoleRestored   = "voter_role_restored"
+
+	EventTypeVoterRoleSuspended  = "voter_role_suspended"
+	EventTypeVoterRoleReinstated = "voter_role_reinstated"
+
+	EventTypeVoterRoleNominated = "voter_role_nominated"
+	EventTypeNominationApproved = "nomination_approved"
+	EventTypeNominationRejected = "nomination_rejected"
+	EventTypeNominationExpired  = "nomination_expired"
+
+	EventTypeRoleDistributionCreated = "role_distribution_created"
+	EventTypeVoterRoleClaimed        = "voter_role_claimed"
+	EventTypeVoterRoleExpired        = "voter_role_expired"
+
+	EventTypeVoteDelegated         = "vote_delegated"
+	EventTypeVoteDelegationRevoked = "vote_delegation_revoked"
+
+	EventTypeProposalTimelocked = "proposal_timelocked"
+	EventTypeProposalVetoCast   = "proposal_veto_cast"
+	EventTypeProposalVetoed     = "proposal_vetoed"
+	EventTypeTimelockExecuted   = "timelock_executed"
+
 	AttributeKeyRoleID      = "role_id"
 	AttributeKeyAddress     = "address"
 	AttributeKeyRole        = "role"
@@ -14,4 +37,38 @@ const (
 	AttributeKeyAddedAt     = "added_at"
 	AttributeKeyDeletedBy   = "deleted_by"
 	AttributeKeyUpdatedBy   = "updated_by"
+
+	AttributeKeyDelegator      = "delegator"
+	AttributeKeyRepresentative = "representative"
+	AttributeKeyMsgTypeURLs    = "msg_type_urls"
+
+	AttributeKeyProposalID   = "proposal_id"
+	AttributeKeyExecuteAfter = "execute_after"
+	AttributeKeyVoter        = "voter"
+	AttributeKeyResult       = "result"
+	AttributeKeyError        = "error"
+
+	AttributeKeyParticipationRate = "participation_rate"
+
+	AttributeKeyReason       = "reason"
+	AttributeKeyReinstateAt  = "reinstate_at"
+	AttributeKeySuspendedBy  = "suspended_by"
+	AttributeKeyReinstatedBy = "reinstated_by"
+
+	AttributeKeyAuthority = "authority"
+
+	AttributeKeyNominationID = "nomination_id"
+	AttributeKeyNominator    = "nominator"
+	AttributeKeyDeposit      = "deposit"
+	AttributeKeyBurned       = "burned"
+
+	AttributeKeyBond     = "bond"
+	AttributeKeyForCause = "for_cause"
+	AttributeKeySlashed  = "slashed"
+
+	AttributeKeyDistributionID = "distribution_id"
+	AttributeKeyMerkleRoot     = "merkle_root"
+	AttributeKeyClaimStart     = "claim_start"
+	AttributeKeyClaimEnd       = "claim_end"
+	AttributeKeyExpiresAt      = "expires_at"
 )
\ No newline at end of file
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 )
 
@@ -10,7 +11,10 @@ import (
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Params: DefaultParams(),
-		PortId: PortID, VoterRoleList: []VoterRole{}}
+		PortId: PortID, VoterRoleList: []VoterRole{}, VoteDelegationList: []VoteDelegation{},
+		ChamberTallyList: []ChamberTally{}, TimelockList: []Timelock{}, ProposalRoleTallyList: []ProposalRoleTally{},
+		StakeRecordList: []StakeRecord{}, ParticipationList: []Participation{}, ProposalVoterList: []ProposalVoter{},
+		NominationList: []Nomination{}, RoleDistributionList: []RoleDistribution{}, RoleClaimList: []RoleClaim{}}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
@@ -20,6 +24,7 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 	voterRoleIdMap := make(map[uint64]bool)
+	roleHolders := make(map[string]bool)
 	voterRoleCount := gs.GetVoterRoleCount()
 	for _, elem := range gs.VoterRoleList {
 		if _, ok := voterRoleIdMap[elem.Id]; ok {
@@ -29,7 +34,144 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("voterRole id should be lower or equal than the last id")
 		}
 		voterRoleIdMap[elem.Id] = true
+		roleHolders[elem.Address] = true
+	}
+
+	representatives := make(map[string]string)
+	for _, elem := range gs.VoteDelegationList {
+		if _, ok := representatives[elem.Delegator]; ok {
+			return fmt.Errorf("duplicated vote delegation for delegator %s", elem.Delegator)
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Delegator); err != nil {
+			return fmt.Errorf("invalid vote delegator address %s: %w", elem.Delegator, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Representative); err != nil {
+			return fmt.Errorf("invalid vote representative address %s: %w", elem.Representative, err)
+		}
+		if elem.Delegator == elem.Representative {
+			return fmt.Errorf("delegator %s delegates its vote to itself", elem.Delegator)
+		}
+		// liquid democracy only works between role holders
+		if !roleHolders[elem.Delegator] {
+			return fmt.Errorf("vote delegator %s holds no voter role", elem.Delegator)
+		}
+		if !roleHolders[elem.Representative] {
+			return fmt.Errorf("vote representative %s holds no voter role", elem.Representative)
+		}
+		representatives[elem.Delegator] = elem.Representative
+	}
+	if err := validateVoteDelegationChains(representatives, gs.Params.MaxVoteDelegationDepth); err != nil {
+		return err
+	}
+
+	chamberTallies := make(map[uint64]bool)
+	for _, elem := range gs.ChamberTallyList {
+		if chamberTallies[elem.ProposalId] {
+			return fmt.Errorf("duplicated chamber tally for proposal %d", elem.ProposalId)
+		}
+		chamberTallies[elem.ProposalId] = true
+	}
+
+	timelocks := make(map[uint64]bool)
+	for _, elem := range gs.TimelockList {
+		if timelocks[elem.ProposalId] {
+			return fmt.Errorf("duplicated timelock for proposal %d", elem.ProposalId)
+		}
+		timelocks[elem.ProposalId] = true
+	}
+
+	proposalRoleTallies := make(map[uint64]bool)
+	for _, elem := range gs.ProposalRoleTallyList {
+		if proposalRoleTallies[elem.ProposalId] {
+			return fmt.Errorf("duplicated role tally for proposal %d", elem.ProposalId)
+		}
+		proposalRoleTallies[elem.ProposalId] = true
+	}
+
+	stakeRecords := make(map[string]bool)
+	for _, elem := range gs.StakeRecordList {
+		if stakeRecords[elem.Address] {
+			return fmt.Errorf("duplicated stake record for address %s", elem.Address)
+		}
+		stakeRecords[elem.Address] = true
+	}
+
+	participations := make(map[string]bool)
+	for _, elem := range gs.ParticipationList {
+		if participations[elem.Address] {
+			return fmt.Errorf("duplicated participation for address %s", elem.Address)
+		}
+		participations[elem.Address] = true
+	}
+
+	proposalVoters := make(map[ProposalVoter]bool)
+	for _, elem := range gs.ProposalVoterList {
+		if proposalVoters[elem] {
+			return fmt.Errorf("duplicated voter %s on proposal %d", elem.Voter, elem.ProposalId)
+		}
+		proposalVoters[elem] = true
+	}
+
+	nominations := make(map[uint64]bool)
+	for _, elem := range gs.NominationList {
+		if nominations[elem.Id] {
+			return fmt.Errorf("duplicated id for nomination")
+		}
+		if elem.Id >= gs.NominationCount {
+			return fmt.Errorf("nomination id should be lower or equal than the last id")
+		}
+		nominations[elem.Id] = true
+	}
+
+	roleDistributions := make(map[uint64]bool)
+	for _, elem := range gs.RoleDistributionList {
+		if roleDistributions[elem.Id] {
+			return fmt.Errorf("duplicated id for role distribution")
+		}
+		if elem.Id >= gs.RoleDistributionCount {
+			return fmt.Errorf("role distribution id should be lower or equal than the last id")
+		}
+		if len(elem.MerkleRoot) != MerkleHashSize {
+			return fmt.Errorf("invalid merkle root for role distribution %d", elem.Id)
+		}
+		roleDistributions[elem.Id] = true
+	}
+
+	roleClaims := make(map[RoleClaim]bool)
+	for _, elem := range gs.RoleClaimList {
+		if !roleDistributions[elem.DistributionId] {
+			return fmt.Errorf("role claim of %s for unknown role distribution %d", elem.Address, elem.DistributionId)
+		}
+		if roleClaims[elem] {
+			return fmt.Errorf("duplicated role claim of %s for role distribution %d", elem.Address, elem.DistributionId)
+		}
+		roleClaims[elem] = true
 	}
 
 	return gs.Params.Validate()
 }
+
+// validateVoteDelegationChains checks that the delegations, keyed by delegator, hold no
+// cycle and no chain longer than maxDepth. Each walk stops after maxDepth delegations.
+func validateVoteDelegationChains(representatives map[string]string, maxDepth uint32) error {
+	for delegator := range representatives {
+		visited := map[string]bool{delegator: true}
+		current, depth := delegator, uint32(0)
+		for {
+			representative, ok := representatives[current]
+			if !ok {
+				break
+			}
+			if visited[representative] {
+				return fmt.Errorf("vote delegation cycle through %s", representative)
+			}
+			if depth++; depth > maxDepth {
+				return fmt.Errorf("vote delegation chain from %s is longer than %d", delegator, maxDepth)
+			}
+			visited[representative] = true
+			current = representative
+		}
+	}
+
+	return nil
+}
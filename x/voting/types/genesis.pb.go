@@ -27,10 +27,22 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the voting module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params         Params      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PortId         string      `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
-	VoterRoleList  []VoterRole `protobuf:"bytes,3,rep,name=voter_role_list,json=voterRoleList,proto3" json:"voter_role_list"`
-	VoterRoleCount uint64      `protobuf:"varint,4,opt,name=voter_role_count,json=voterRoleCount,proto3" json:"voter_role_count,omitempty"`
+	Params                Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PortId                string              `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	VoterRoleList         []VoterRole         `protobuf:"bytes,3,rep,name=voter_role_list,json=voterRoleList,proto3" json:"voter_role_list"`
+	VoterRoleCount        uint64              `protobuf:"varint,4,opt,name=voter_role_count,json=voterRoleCount,proto3" json:"voter_role_count,omitempty"`
+	VoteDelegationList    []VoteDelegation    `protobuf:"bytes,5,rep,name=vote_delegation_list,json=voteDelegationList,proto3" json:"vote_delegation_list"`
+	ChamberTallyList      []ChamberTally      `protobuf:"bytes,6,rep,name=chamber_tally_list,json=chamberTallyList,proto3" json:"chamber_tally_list"`
+	TimelockList          []Timelock          `protobuf:"bytes,7,rep,name=timelock_list,json=timelockList,proto3" json:"timelock_list"`
+	ProposalRoleTallyList []ProposalRoleTally `protobuf:"bytes,8,rep,name=proposal_role_tally_list,json=proposalRoleTallyList,proto3" json:"proposal_role_tally_list"`
+	StakeRecordList       []StakeRecord       `protobuf:"bytes,9,rep,name=stake_record_list,json=stakeRecordList,proto3" json:"stake_record_list"`
+	ParticipationList     []Participation     `protobuf:"bytes,10,rep,name=participation_list,json=participationList,proto3" json:"participation_list"`
+	ProposalVoterList     []ProposalVoter     `protobuf:"bytes,11,rep,name=proposal_voter_list,json=proposalVoterList,proto3" json:"proposal_voter_list"`
+	NominationList        []Nomination        `protobuf:"bytes,12,rep,name=nomination_list,json=nominationList,proto3" json:"nomination_list"`
+	NominationCount       uint64              `protobuf:"varint,13,opt,name=nomination_count,json=nominationCount,proto3" json:"nomination_count,omitempty"`
+	RoleDistributionList  []RoleDistribution  `protobuf:"bytes,14,rep,name=role_distribution_list,json=roleDistributionList,proto3" json:"role_distribution_list"`
+	RoleDistributionCount uint64              `protobuf:"varint,15,opt,name=role_distribution_count,json=roleDistributionCount,proto3" json:"role_distribution_count,omitempty"`
+	RoleClaimList         []RoleClaim         `protobuf:"bytes,16,rep,name=role_claim_list,json=roleClaimList,proto3" json:"role_claim_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -94,6 +106,90 @@ func (m *GenesisState) GetVoterRoleCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetVoteDelegationList() []VoteDelegation {
+	if m != nil {
+		return m.VoteDelegationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChamberTallyList() []ChamberTally {
+	if m != nil {
+		return m.ChamberTallyList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTimelockList() []Timelock {
+	if m != nil {
+		return m.TimelockList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposalRoleTallyList() []ProposalRoleTally {
+	if m != nil {
+		return m.ProposalRoleTallyList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStakeRecordList() []StakeRecord {
+	if m != nil {
+		return m.StakeRecordList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParticipationList() []Participation {
+	if m != nil {
+		return m.ParticipationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposalVoterList() []ProposalVoter {
+	if m != nil {
+		return m.ProposalVoterList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNominationList() []Nomination {
+	if m != nil {
+		return m.NominationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNominationCount() uint64 {
+	if m != nil {
+		return m.NominationCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRoleDistributionList() []RoleDistribution {
+	if m != nil {
+		return m.RoleDistributionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoleDistributionCount() uint64 {
+	if m != nil {
+		return m.RoleDistributionCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRoleClaimList() []RoleClaim {
+	if m != nil {
+		return m.RoleClaimList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmosweightedgovernancesdk.voting.v1.GenesisState")
 }
@@ -103,28 +199,51 @@ func init() {
 }
 
 var fileDescriptor_03c0bcffab0c3a8e = []byte{
-	// 332 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4e, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0x2e, 0x4f, 0xcd, 0x4c, 0xcf, 0x28, 0x49, 0x4d, 0x49, 0xcf, 0x2f, 0x4b, 0x2d, 0xca,
-	0x4b, 0xcc, 0x4b, 0x4e, 0x2d, 0x4e, 0xc9, 0xd6, 0x2f, 0xcb, 0x2f, 0xc9, 0xcc, 0x4b, 0xd7, 0x2f,
-	0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x52, 0xc5, 0xa3, 0x49, 0x0f, 0xa2, 0x49, 0xaf, 0xcc, 0x50, 0x4a, 0x30, 0x31, 0x37, 0x33, 0x2f,
-	0x5f, 0x1f, 0x4c, 0x42, 0x74, 0x4a, 0x19, 0x11, 0x67, 0x5d, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4,
-	0x36, 0x29, 0x33, 0xe2, 0xf4, 0x94, 0xe5, 0x97, 0xa4, 0x16, 0xc5, 0x17, 0xe5, 0xe7, 0xa4, 0x42,
-	0xf5, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16, 0x44, 0x54, 0xa9, 0x99, 0x89,
-	0x8b, 0xc7, 0x1d, 0xe2, 0x9b, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0xa1, 0x00, 0x2e, 0x36, 0x88, 0x75,
-	0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xba, 0x7a, 0x44, 0xf9, 0x4e, 0x2f, 0x00, 0xac, 0xc9,
-	0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x41, 0xcd, 0x11, 0x12,
-	0xe7, 0x62, 0x2f, 0xc8, 0x2f, 0x2a, 0x89, 0xcf, 0x4c, 0x91, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x0c,
-	0x62, 0x03, 0x71, 0x3d, 0x53, 0x84, 0xe2, 0xb8, 0xf8, 0x11, 0xae, 0x8c, 0xcf, 0xc9, 0x2c, 0x2e,
-	0x91, 0x60, 0x56, 0x60, 0xd6, 0xe0, 0x36, 0x32, 0x20, 0xd2, 0xce, 0x30, 0x90, 0xee, 0xa0, 0xfc,
-	0x9c, 0x54, 0x27, 0x16, 0x90, 0xb5, 0x41, 0xbc, 0x65, 0x30, 0x01, 0x9f, 0xcc, 0xe2, 0x12, 0x21,
-	0x0d, 0x2e, 0x01, 0x24, 0xf3, 0x93, 0xf3, 0x4b, 0xf3, 0x4a, 0x24, 0x58, 0x14, 0x18, 0x35, 0x58,
-	0x82, 0xf8, 0xe0, 0x0a, 0x9d, 0x41, 0xa2, 0x4e, 0xee, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24,
-	0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78,
-	0x2c, 0xc7, 0x10, 0xa5, 0x0b, 0x71, 0x89, 0x2e, 0xcc, 0x29, 0xba, 0x08, 0xb7, 0xe8, 0x82, 0x02,
-	0xbc, 0x02, 0x16, 0xe4, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x50, 0x35, 0x06, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x20, 0x84, 0x06, 0x5e, 0x48, 0x02, 0x00, 0x00,
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x4f, 0x13, 0x4f,
+	0x18, 0xc6, 0xbb, 0x7f, 0xf8, 0x17, 0x99, 0x02, 0x2d, 0x2b, 0x48, 0xc3, 0xa1, 0x36, 0x26, 0x26,
+	0xd5, 0xa4, 0xad, 0x14, 0x44, 0x8c, 0x31, 0x26, 0x40, 0x42, 0x4c, 0x8c, 0x21, 0x85, 0x78, 0xe0,
+	0x40, 0x1d, 0x76, 0x27, 0xcb, 0xc8, 0xee, 0xce, 0x66, 0x66, 0x58, 0xe4, 0x13, 0x78, 0xf5, 0x63,
+	0x78, 0xf4, 0x63, 0x70, 0xe4, 0xe8, 0xc9, 0x18, 0x38, 0xf8, 0x35, 0xcc, 0xbc, 0x33, 0xdb, 0xdd,
+	0x16, 0x63, 0xa6, 0x97, 0x66, 0xfb, 0xb6, 0xcf, 0xf3, 0x7b, 0x76, 0xe6, 0x7d, 0x5f, 0xb4, 0xee,
+	0x31, 0x11, 0x31, 0x71, 0x41, 0x68, 0x70, 0x2a, 0x89, 0x1f, 0xb0, 0x94, 0xf0, 0x18, 0xc7, 0x1e,
+	0x11, 0xfe, 0x59, 0x37, 0x65, 0x92, 0xc6, 0x41, 0x37, 0x5d, 0xeb, 0x06, 0x24, 0x26, 0x82, 0x8a,
+	0x4e, 0xc2, 0x99, 0x64, 0xee, 0xe3, 0x7f, 0x88, 0x3a, 0x5a, 0xd4, 0x49, 0xd7, 0x56, 0x17, 0x71,
+	0x44, 0x63, 0xd6, 0x85, 0x4f, 0xad, 0x5c, 0x7d, 0x69, 0x87, 0xf3, 0x4e, 0x71, 0x74, 0x42, 0xf8,
+	0x40, 0xe2, 0x30, 0xbc, 0x34, 0xd2, 0x4d, 0x3b, 0x69, 0xcc, 0x22, 0x1a, 0x63, 0x49, 0x59, 0x6c,
+	0x74, 0x3d, 0x3b, 0x5d, 0x82, 0x39, 0x8e, 0xc4, 0x64, 0x31, 0x13, 0xcc, 0x25, 0xf5, 0x68, 0x52,
+	0xc4, 0xbd, 0xb1, 0x94, 0x72, 0x96, 0x30, 0x81, 0xc3, 0x01, 0x67, 0x21, 0x19, 0x79, 0xcf, 0xd7,
+	0x76, 0x06, 0xa0, 0xf3, 0xa9, 0x90, 0x9c, 0x9e, 0x9c, 0x17, 0xf8, 0x5b, 0x76, 0x72, 0x21, 0xf1,
+	0x19, 0x19, 0x70, 0xe2, 0x31, 0xee, 0x1b, 0xe5, 0x86, 0x9d, 0x52, 0xd2, 0x88, 0x84, 0xcc, 0x3b,
+	0x33, 0xaa, 0x57, 0x76, 0xaa, 0x94, 0x49, 0x32, 0xf0, 0x49, 0x48, 0x82, 0xe2, 0x61, 0x6d, 0xda,
+	0x8b, 0x39, 0x9c, 0x94, 0xd1, 0x2d, 0x05, 0x2c, 0x60, 0xf0, 0xd8, 0x55, 0x4f, 0xba, 0xfa, 0xe8,
+	0x4b, 0x05, 0xcd, 0xed, 0xe9, 0x46, 0x3d, 0x90, 0x58, 0x12, 0x77, 0x1f, 0x95, 0xf5, 0xb5, 0xd6,
+	0x9d, 0xa6, 0xd3, 0xaa, 0xf4, 0xda, 0x1d, 0xab, 0xc6, 0xed, 0xec, 0x83, 0x68, 0x7b, 0xf6, 0xea,
+	0xe7, 0xc3, 0xd2, 0xb7, 0xdf, 0xdf, 0x9f, 0x3a, 0x7d, 0xe3, 0xe3, 0xae, 0xa0, 0x99, 0x84, 0x71,
+	0x39, 0xa0, 0x7e, 0xfd, 0xbf, 0xa6, 0xd3, 0x9a, 0xed, 0x97, 0xd5, 0xd7, 0xb7, 0xbe, 0x7b, 0x8c,
+	0xaa, 0x79, 0xca, 0x41, 0x48, 0x85, 0xac, 0x4f, 0x35, 0xa7, 0x5a, 0x95, 0xde, 0x33, 0x4b, 0xe6,
+	0x07, 0xa5, 0xee, 0xb3, 0x90, 0x6c, 0x4f, 0x2b, 0x6c, 0x7f, 0x3e, 0xcd, 0x0a, 0xef, 0xa8, 0x90,
+	0x6e, 0x0b, 0xd5, 0x0a, 0xfe, 0x1e, 0x3b, 0x8f, 0x65, 0x7d, 0xba, 0xe9, 0xb4, 0xa6, 0xfb, 0x0b,
+	0xc3, 0x3f, 0xee, 0xa8, 0xaa, 0x1b, 0xa1, 0xa5, 0xb1, 0xc3, 0xd6, 0x71, 0xfe, 0x87, 0x38, 0xcf,
+	0x27, 0x88, 0xb3, 0x3b, 0x74, 0x30, 0x99, 0xdc, 0x74, 0xa4, 0x0a, 0xc1, 0x02, 0xe4, 0x8e, 0x4c,
+	0xab, 0x86, 0x95, 0x01, 0xb6, 0x6e, 0x09, 0xdb, 0xd1, 0x06, 0x87, 0x4a, 0x6f, 0x50, 0x35, 0xaf,
+	0x50, 0x03, 0xd0, 0x11, 0x9a, 0xcf, 0x5a, 0x4f, 0x33, 0x66, 0x80, 0xd1, 0xb5, 0x64, 0x1c, 0x1a,
+	0xad, 0xf1, 0x9f, 0xcb, 0xbc, 0xc0, 0xfb, 0x02, 0xd5, 0xff, 0x32, 0x90, 0x1a, 0x73, 0x0f, 0x30,
+	0x5b, 0xb6, 0xad, 0x63, 0x6c, 0xd4, 0x7d, 0x14, 0xdf, 0x67, 0x39, 0x19, 0xff, 0x01, 0xc0, 0x3e,
+	0x5a, 0x2c, 0x4e, 0xa2, 0x26, 0xce, 0x02, 0xb1, 0x67, 0x49, 0x3c, 0x50, 0xfa, 0x3e, 0xc8, 0x0d,
+	0xab, 0x2a, 0xf2, 0x12, 0x50, 0x28, 0x72, 0x47, 0x56, 0x95, 0xc6, 0x20, 0xc0, 0x6c, 0xd8, 0xcf,
+	0x44, 0x6e, 0x60, 0x40, 0x8b, 0x23, 0xae, 0x80, 0xfa, 0x84, 0xee, 0x0f, 0x4f, 0x52, 0x37, 0x2c,
+	0xb0, 0x2a, 0x93, 0xb1, 0x8c, 0x03, 0xcc, 0xc4, 0x90, 0x55, 0x2c, 0x02, 0xeb, 0x23, 0xaa, 0xe6,
+	0xdb, 0x5e, 0x73, 0xe6, 0x80, 0xb3, 0x66, 0xc9, 0x79, 0x3f, 0x54, 0x1b, 0xc8, 0x42, 0xee, 0x07,
+	0x84, 0x27, 0xa8, 0x56, 0x20, 0xe8, 0xa9, 0x9b, 0x87, 0xa9, 0x2b, 0x90, 0xf5, 0xd8, 0x09, 0xf4,
+	0xe0, 0xce, 0x4a, 0xd6, 0x99, 0x16, 0x20, 0xd3, 0x0b, 0xcb, 0x4c, 0xaa, 0x3f, 0x76, 0x0b, 0x1e,
+	0x26, 0xd9, 0x12, 0x1f, 0xab, 0x43, 0xbe, 0x4d, 0xb4, 0x72, 0x17, 0xaa, 0x63, 0x56, 0x21, 0xe6,
+	0xf2, 0xb8, 0x4c, 0x87, 0x3d, 0x46, 0x55, 0xbd, 0x47, 0x42, 0x4c, 0x23, 0x9d, 0xb2, 0x36, 0xd1,
+	0xb6, 0x82, 0x75, 0xa3, 0xc4, 0xd9, 0xb6, 0xe2, 0x59, 0x41, 0xe5, 0xda, 0xde, 0xbb, 0xba, 0x69,
+	0x38, 0xd7, 0x37, 0x0d, 0xe7, 0xd7, 0x4d, 0xc3, 0xf9, 0x7a, 0xdb, 0x28, 0x5d, 0xdf, 0x36, 0x4a,
+	0x3f, 0x6e, 0x1b, 0xa5, 0xa3, 0xb6, 0xf6, 0x6f, 0x67, 0x80, 0x76, 0x4e, 0x68, 0xab, 0xa5, 0xff,
+	0x39, 0x5b, 0xfb, 0xf2, 0x32, 0x21, 0xe2, 0xa4, 0x0c, 0x9b, 0x7d, 0xfd, 0x4f, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0x47, 0x2c, 0xe3, 0x5c, 0xa7, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -147,6 +266,158 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RoleClaimList) > 0 {
+		for iNdEx := len(m.RoleClaimList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleClaimList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.RoleDistributionCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RoleDistributionCount))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.RoleDistributionList) > 0 {
+		for iNdEx := len(m.RoleDistributionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleDistributionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.NominationCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NominationCount))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.NominationList) > 0 {
+		for iNdEx := len(m.NominationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NominationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ProposalVoterList) > 0 {
+		for iNdEx := len(m.ProposalVoterList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalVoterList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ParticipationList) > 0 {
+		for iNdEx := len(m.ParticipationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParticipationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.StakeRecordList) > 0 {
+		for iNdEx := len(m.StakeRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ProposalRoleTallyList) > 0 {
+		for iNdEx := len(m.ProposalRoleTallyList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalRoleTallyList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TimelockList) > 0 {
+		for iNdEx := len(m.TimelockList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimelockList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ChamberTallyList) > 0 {
+		for iNdEx := len(m.ChamberTallyList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChamberTallyList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.VoteDelegationList) > 0 {
+		for iNdEx := len(m.VoteDelegationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteDelegationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.VoterRoleCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.VoterRoleCount))
 		i--
@@ -218,6 +489,72 @@ func (m *GenesisState) Size() (n int) {
 	if m.VoterRoleCount != 0 {
 		n += 1 + sovGenesis(uint64(m.VoterRoleCount))
 	}
+	if len(m.VoteDelegationList) > 0 {
+		for _, e := range m.VoteDelegationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChamberTallyList) > 0 {
+		for _, e := range m.ChamberTallyList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TimelockList) > 0 {
+		for _, e := range m.TimelockList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProposalRoleTallyList) > 0 {
+		for _, e := range m.ProposalRoleTallyList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StakeRecordList) > 0 {
+		for _, e := range m.StakeRecordList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ParticipationList) > 0 {
+		for _, e := range m.ParticipationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProposalVoterList) > 0 {
+		for _, e := range m.ProposalVoterList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NominationList) > 0 {
+		for _, e := range m.NominationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NominationCount != 0 {
+		n += 1 + sovGenesis(uint64(m.NominationCount))
+	}
+	if len(m.RoleDistributionList) > 0 {
+		for _, e := range m.RoleDistributionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RoleDistributionCount != 0 {
+		n += 1 + sovGenesis(uint64(m.RoleDistributionCount))
+	}
+	if len(m.RoleClaimList) > 0 {
+		for _, e := range m.RoleClaimList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -374,6 +711,384 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDelegationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteDelegationList = append(m.VoteDelegationList, VoteDelegation{})
+			if err := m.VoteDelegationList[len(m.VoteDelegationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChamberTallyList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChamberTallyList = append(m.ChamberTallyList, ChamberTally{})
+			if err := m.ChamberTallyList[len(m.ChamberTallyList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimelockList = append(m.TimelockList, Timelock{})
+			if err := m.TimelockList[len(m.TimelockList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalRoleTallyList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalRoleTallyList = append(m.ProposalRoleTallyList, ProposalRoleTally{})
+			if err := m.ProposalRoleTallyList[len(m.ProposalRoleTallyList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeRecordList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeRecordList = append(m.StakeRecordList, StakeRecord{})
+			if err := m.StakeRecordList[len(m.StakeRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipationList = append(m.ParticipationList, Participation{})
+			if err := m.ParticipationList[len(m.ParticipationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalVoterList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalVoterList = append(m.ProposalVoterList, ProposalVoter{})
+			if err := m.ProposalVoterList[len(m.ProposalVoterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NominationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NominationList = append(m.NominationList, Nomination{})
+			if err := m.NominationList[len(m.NominationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NominationCount", wireType)
+			}
+			m.NominationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NominationCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleDistributionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleDistributionList = append(m.RoleDistributionList, RoleDistribution{})
+			if err := m.RoleDistributionList[len(m.RoleDistributionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleDistributionCount", wireType)
+			}
+			m.RoleDistributionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoleDistributionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleClaimList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleClaimList = append(m.RoleClaimList, RoleClaim{})
+			if err := m.RoleClaimList[len(m.RoleClaimList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,12 +3,18 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"cosmos-weighted-governance-sdk/x/voting/types"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	alice, bob, carol, dave := testAddress("alice"), testAddress("bob"), testAddress("carol"), testAddress("dave")
+	roleHolders := []types.VoterRole{{Id: 0, Address: alice}, {Id: 1, Address: bob}, {Id: 2, Address: carol}, {Id: 3, Address: dave}}
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -24,11 +30,384 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{
 				PortId: types.PortID,
 				Params: types.Params{
-					MaxVoterRolesPerAddress: 1,
-					RoleCreationCooldown:    300,
+					MaxVoterRolesPerAddress:  1,
+					RoleCreationCooldown:     300,
+					MaxVoteDelegationDepth:   3,
+					ValidatorMultiplierScope: types.ValidatorMultiplierScopeAllInherited,
+					PowerTransform:           types.PowerTransformCapped,
+					PowerCap:                 math.NewInt(1000),
+					TallyMode:                types.TallyModeBicameral,
+					TokenHouse:               types.DefaultChamberParams(),
+					RoleHouse:                types.NewChamberParams(math.LegacyZeroDec(), math.LegacyOneDec()),
+					RoleQuorums: []types.RoleQuorum{
+						{Role: "core_contributor", MinParticipation: math.LegacyOneDec(), Basis: types.RoleQuorumBasisHolders},
+						{Role: "validator", MinParticipation: math.LegacyNewDecWithPrec(5, 1), Basis: types.RoleQuorumBasisPower},
+					},
+					VetoWindow:    86400,
+					VetoThreshold: math.LegacyOneDec(),
+					RecusalMode:   types.RecusalModeExclude,
+					RegistryTally: types.NewRegistryTallyParams(math.LegacyOneDec(), math.LegacyOneDec(), math.LegacyNewDecWithPrec(1, 1)),
+					CommunityRole: types.NewCommunityRoleParams(true, "week", math.NewInt(100), 0, 10),
+					Participation: types.NewParticipationParams(5, math.LegacyOneDec(), true, math.LegacyNewDecWithPrec(5, 1)),
+					Nomination:    types.NewNominationParams(nil, 3600),
+					RoleBond: types.NewRoleBondParams("ustake", math.LegacyOneDec(),
+						[]types.RoleBond{{Role: "core_contributor", Amount: math.NewInt(1000)}}),
 				},
-				VoterRoleList: []types.VoterRole{{Id: 0}, {Id: 1}}, VoterRoleCount: 2,
+				VoterRoleList: roleHolders, VoterRoleCount: 4,
+				VoteDelegationList: []types.VoteDelegation{
+					{Delegator: alice, Representative: bob}, {Delegator: bob, Representative: carol}, {Delegator: carol, Representative: dave},
+				},
+				ChamberTallyList:      []types.ChamberTally{{ProposalId: 1}, {ProposalId: 2}},
+				TimelockList:          []types.Timelock{{ProposalId: 1}, {ProposalId: 2}},
+				ProposalRoleTallyList: []types.ProposalRoleTally{{ProposalId: 1}, {ProposalId: 2}},
+				StakeRecordList:       []types.StakeRecord{{Address: "alice"}, {Address: "bob"}},
+				ParticipationList:     []types.Participation{{Address: "alice"}, {Address: "bob"}},
+				ProposalVoterList:     []types.ProposalVoter{{ProposalId: 1, Voter: "alice"}, {ProposalId: 2, Voter: "alice"}},
+				NominationList:        []types.Nomination{{Id: 0}, {Id: 1}}, NominationCount: 2,
+				RoleDistributionList: []types.RoleDistribution{{Id: 0, MerkleRoot: make([]byte, types.MerkleHashSize)}}, RoleDistributionCount: 1,
+				RoleClaimList: []types.RoleClaim{{DistributionId: 0, Address: "alice"}, {DistributionId: 0, Address: "bob"}},
 			}, valid: true,
+		}, {
+			desc: "invalid validator multiplier scope",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(1, 300, 3, "everything", types.PowerTransformLinear, math.ZeroInt(),
+					types.TallyModeWeighted, types.DefaultChamberParams(), types.DefaultChamberParams(), nil, 0, types.DefaultVetoThreshold, types.DefaultRecusalMode, types.DefaultRegistryTallyParams(), false, types.DefaultCommunityRoleParams(), types.DefaultParticipationParams(), nil, types.DefaultNominationParams(), types.DefaultRoleBondParams()),
+			},
+			valid: false,
+		}, {
+			desc: "invalid power transform",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(1, 300, 3, types.ValidatorMultiplierScopeNone, "cubic", math.ZeroInt(),
+					types.TallyModeWeighted, types.DefaultChamberParams(), types.DefaultChamberParams(), nil, 0, types.DefaultVetoThreshold, types.DefaultRecusalMode, types.DefaultRegistryTallyParams(), false, types.DefaultCommunityRoleParams(), types.DefaultParticipationParams(), nil, types.DefaultNominationParams(), types.DefaultRoleBondParams()),
+			},
+			valid: false,
+		}, {
+			desc: "capped without cap",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(1, 300, 3, types.ValidatorMultiplierScopeNone, types.PowerTransformCapped, math.ZeroInt(),
+					types.TallyModeWeighted, types.DefaultChamberParams(), types.DefaultChamberParams(), nil, 0, types.DefaultVetoThreshold, types.DefaultRecusalMode, types.DefaultRegistryTallyParams(), false, types.DefaultCommunityRoleParams(), types.DefaultParticipationParams(), nil, types.DefaultNominationParams(), types.DefaultRoleBondParams()),
+			},
+			valid: false,
+		}, {
+			desc: "invalid tally mode",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(1, 300, 3, types.ValidatorMultiplierScopeNone, types.PowerTransformLinear, math.ZeroInt(),
+					"tricameral", types.DefaultChamberParams(), types.DefaultChamberParams(), nil, 0, types.DefaultVetoThreshold, types.DefaultRecusalMode, types.DefaultRegistryTallyParams(), false, types.DefaultCommunityRoleParams(), types.DefaultParticipationParams(), nil, types.DefaultNominationParams(), types.DefaultRoleBondParams()),
+			},
+			valid: false,
+		}, {
+			desc: "role house quorum above one",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(1, 300, 3, types.ValidatorMultiplierScopeNone, types.PowerTransformLinear, math.ZeroInt(),
+					types.TallyModeBicameral, types.DefaultChamberParams(), types.NewChamberParams(math.LegacyNewDec(2), types.DefaultChamberThreshold), nil, 0, types.DefaultVetoThreshold, types.DefaultRecusalMode, types.DefaultRegistryTallyParams(), false, types.DefaultCommunityRoleParams(), types.DefaultParticipationParams(), nil, types.DefaultNominationParams(), types.DefaultRoleBondParams()),
+			},
+			valid: false,
+		}, {
+			desc: "token house zero threshold",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(1, 300, 3, types.ValidatorMultiplierScopeNone, types.PowerTransformLinear, math.ZeroInt(),
+					types.TallyModeBicameral, types.NewChamberParams(types.DefaultChamberQuorum, math.LegacyZeroDec()), types.DefaultChamberParams(), nil, 0, types.DefaultVetoThreshold, types.DefaultRecusalMode, types.DefaultRegistryTallyParams(), false, types.DefaultCommunityRoleParams(), types.DefaultParticipationParams(), nil, types.DefaultNominationParams(), types.DefaultRoleBondParams()),
+			},
+			valid: false,
+		}, {
+			desc: "duplicated role quorum",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(1, 300, 3, types.ValidatorMultiplierScopeNone, types.PowerTransformLinear, math.ZeroInt(),
+					types.TallyModeWeighted, types.DefaultChamberParams(), types.DefaultChamberParams(), []types.RoleQuorum{
+						{Role: "validator", MinParticipation: math.LegacyOneDec(), Basis: types.RoleQuorumBasisHolders},
+						{Role: "validator", MinParticipation: math.LegacyOneDec(), Basis: types.RoleQuorumBasisPower},
+					}, 0, types.DefaultVetoThreshold, types.DefaultRecusalMode, types.DefaultRegistryTallyParams(), false, types.DefaultCommunityRoleParams(), types.DefaultParticipationParams(), nil, types.DefaultNominationParams(), types.DefaultRoleBondParams()),
+			},
+			valid: false,
+		}, {
+			desc: "invalid role quorum basis",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(1, 300, 3, types.ValidatorMultiplierScopeNone, types.PowerTransformLinear, math.ZeroInt(),
+					types.TallyModeWeighted, types.DefaultChamberParams(), types.DefaultChamberParams(), []types.RoleQuorum{
+						{Role: "validator", MinParticipation: math.LegacyOneDec(), Basis: "stake"},
+					}, 0, types.DefaultVetoThreshold, types.DefaultRecusalMode, types.DefaultRegistryTallyParams(), false, types.DefaultCommunityRoleParams(), types.DefaultParticipationParams(), nil, types.DefaultNominationParams(), types.DefaultRoleBondParams()),
+			},
+			valid: false,
+		}, {
+			desc: "zero role quorum",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(1, 300, 3, types.ValidatorMultiplierScopeNone, types.PowerTransformLinear, math.ZeroInt(),
+					types.TallyModeWeighted, types.DefaultChamberParams(), types.DefaultChamberParams(), []types.RoleQuorum{
+						{Role: "validator", MinParticipation: math.LegacyZeroDec(), Basis: types.RoleQuorumBasisHolders},
+					}, 0, types.DefaultVetoThreshold, types.DefaultRecusalMode, types.DefaultRegistryTallyParams(), false, types.DefaultCommunityRoleParams(), types.DefaultParticipationParams(), nil, types.DefaultNominationParams(), types.DefaultRoleBondParams()),
+			},
+			valid: false,
+		}, {
+			desc: "zero veto threshold",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(1, 300, 3, types.ValidatorMultiplierScopeNone, types.PowerTransformLinear, math.ZeroInt(),
+					types.TallyModeWeighted, types.DefaultChamberParams(), types.DefaultChamberParams(), nil, 3600, math.LegacyZeroDec(), types.DefaultRecusalMode, types.DefaultRegistryTallyParams(), false, types.DefaultCommunityRoleParams(), types.DefaultParticipationParams(), nil, types.DefaultNominationParams(), types.DefaultRoleBondParams()),
+			},
+			valid: false,
+		}, {
+			desc: "invalid recusal mode",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(1, 300, 3, types.ValidatorMultiplierScopeNone, types.PowerTransformLinear, math.ZeroInt(),
+					types.TallyModeWeighted, types.DefaultChamberParams(), types.DefaultChamberParams(), nil, 0, types.DefaultVetoThreshold, "abstain", types.DefaultRegistryTallyParams(), false, types.DefaultCommunityRoleParams(), types.DefaultParticipationParams(), nil, types.DefaultNominationParams(), types.DefaultRoleBondParams()),
+			},
+			valid: false,
+		}, {
+			desc: "zero registry veto threshold",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(1, 300, 3, types.ValidatorMultiplierScopeNone, types.PowerTransformLinear, math.ZeroInt(),
+					types.TallyModeWeighted, types.DefaultChamberParams(), types.DefaultChamberParams(), nil, 0, types.DefaultVetoThreshold, types.DefaultRecusalMode,
+					types.NewRegistryTallyParams(types.DefaultRegistryQuorum, types.DefaultRegistryThreshold, math.LegacyZeroDec()), false, types.DefaultCommunityRoleParams(), types.DefaultParticipationParams(), nil, types.DefaultNominationParams(), types.DefaultRoleBondParams()),
+			},
+			valid: false,
+		}, {
+			desc: "enabled community role without batch size",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(1, 300, 3, types.ValidatorMultiplierScopeNone, types.PowerTransformLinear, math.ZeroInt(),
+					types.TallyModeWeighted, types.DefaultChamberParams(), types.DefaultChamberParams(), nil, 0, types.DefaultVetoThreshold, types.DefaultRecusalMode,
+					types.DefaultRegistryTallyParams(), false, types.NewCommunityRoleParams(true, "day", math.NewInt(100), 30, 0), types.DefaultParticipationParams(), nil, types.DefaultNominationParams(), types.DefaultRoleBondParams()),
+			},
+			valid: false,
+		}, {
+			desc: "zero participation window",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(1, 300, 3, types.ValidatorMultiplierScopeNone, types.PowerTransformLinear, math.ZeroInt(),
+					types.TallyModeWeighted, types.DefaultChamberParams(), types.DefaultChamberParams(), nil, 0, types.DefaultVetoThreshold, types.DefaultRecusalMode,
+					types.DefaultRegistryTallyParams(), false, types.DefaultCommunityRoleParams(),
+					types.NewParticipationParams(0, types.DefaultParticipationMinRate, false, types.DefaultDowngradeMultiplier), nil, types.DefaultNominationParams(), types.DefaultRoleBondParams()),
+			},
+			valid: false,
+		}, {
+			desc: "role authority with inverted multiplier band",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(1, 300, 3, types.ValidatorMultiplierScopeNone, types.PowerTransformLinear, math.ZeroInt(),
+					types.TallyModeWeighted, types.DefaultChamberParams(), types.DefaultChamberParams(), nil, 0, types.DefaultVetoThreshold, types.DefaultRecusalMode,
+					types.DefaultRegistryTallyParams(), false, types.DefaultCommunityRoleParams(), types.DefaultParticipationParams(),
+					[]types.RoleAuthority{types.NewRoleAuthority(sdk.AccAddress("council").String(), []string{types.RoleOperationCreate}, nil,
+						math.LegacyNewDec(2), math.LegacyOneDec())}, types.DefaultNominationParams(), types.DefaultRoleBondParams()),
+			},
+			valid: false,
+		}, {
+			desc: "role authority with unknown operation",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(1, 300, 3, types.ValidatorMultiplierScopeNone, types.PowerTransformLinear, math.ZeroInt(),
+					types.TallyModeWeighted, types.DefaultChamberParams(), types.DefaultChamberParams(), nil, 0, types.DefaultVetoThreshold, types.DefaultRecusalMode,
+					types.DefaultRegistryTallyParams(), false, types.DefaultCommunityRoleParams(), types.DefaultParticipationParams(),
+					[]types.RoleAuthority{types.NewRoleAuthority(sdk.AccAddress("council").String(), []string{"veto"}, nil,
+						math.LegacyOneDec(), math.LegacyOneDec())}, types.DefaultNominationParams(), types.DefaultRoleBondParams()),
+			},
+			valid: false,
+		}, {
+			desc: "duplicated participation",
+			genState: &types.GenesisState{
+				PortId:            types.PortID,
+				Params:            types.DefaultParams(),
+				ParticipationList: []types.Participation{{Address: "alice"}, {Address: "alice"}},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated nomination",
+			genState: &types.GenesisState{
+				PortId:          types.PortID,
+				Params:          types.DefaultParams(),
+				NominationList:  []types.Nomination{{Id: 0}, {Id: 0}},
+				NominationCount: 2,
+			},
+			valid: false,
+		}, {
+			desc: "invalid nomination count",
+			genState: &types.GenesisState{
+				PortId:          types.PortID,
+				Params:          types.DefaultParams(),
+				NominationList:  []types.Nomination{{Id: 1}},
+				NominationCount: 1,
+			},
+			valid: false,
+		}, {
+			desc: "invalid role distribution merkle root",
+			genState: &types.GenesisState{
+				PortId:                types.PortID,
+				Params:                types.DefaultParams(),
+				RoleDistributionList:  []types.RoleDistribution{{Id: 0, MerkleRoot: []byte("root")}},
+				RoleDistributionCount: 1,
+			},
+			valid: false,
+		}, {
+			desc: "duplicated role claim",
+			genState: &types.GenesisState{
+				PortId:                types.PortID,
+				Params:                types.DefaultParams(),
+				RoleDistributionList:  []types.RoleDistribution{{Id: 0, MerkleRoot: make([]byte, types.MerkleHashSize)}},
+				RoleDistributionCount: 1,
+				RoleClaimList:         []types.RoleClaim{{DistributionId: 0, Address: "alice"}, {DistributionId: 0, Address: "alice"}},
+			},
+			valid: false,
+		}, {
+			desc: "role claim of unknown distribution",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.DefaultParams(),
+				RoleClaimList: []types.RoleClaim{{DistributionId: 0, Address: "alice"}},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated role bond",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(1, 300, 3, types.ValidatorMultiplierScopeNone, types.PowerTransformLinear, math.ZeroInt(),
+					types.TallyModeWeighted, types.DefaultChamberParams(), types.DefaultChamberParams(), nil, 0, types.DefaultVetoThreshold, types.DefaultRecusalMode,
+					types.DefaultRegistryTallyParams(), false, types.DefaultCommunityRoleParams(), types.DefaultParticipationParams(), nil,
+					types.DefaultNominationParams(), types.NewRoleBondParams("stake", types.DefaultRoleBondSlashFraction, []types.RoleBond{
+						{Role: "core_contributor", Amount: math.NewInt(1000)},
+						{Role: "core_contributor", Amount: math.NewInt(2000)},
+					})),
+			},
+			valid: false,
+		}, {
+			desc: "slash fraction above one",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(1, 300, 3, types.ValidatorMultiplierScopeNone, types.PowerTransformLinear, math.ZeroInt(),
+					types.TallyModeWeighted, types.DefaultChamberParams(), types.DefaultChamberParams(), nil, 0, types.DefaultVetoThreshold, types.DefaultRecusalMode,
+					types.DefaultRegistryTallyParams(), false, types.DefaultCommunityRoleParams(), types.DefaultParticipationParams(), nil,
+					types.DefaultNominationParams(), types.NewRoleBondParams("stake", math.LegacyNewDec(2), nil)),
+			},
+			valid: false,
+		}, {
+			desc: "zero nomination expiry",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams(1, 300, 3, types.ValidatorMultiplierScopeNone, types.PowerTransformLinear, math.ZeroInt(),
+					types.TallyModeWeighted, types.DefaultChamberParams(), types.DefaultChamberParams(), nil, 0, types.DefaultVetoThreshold, types.DefaultRecusalMode,
+					types.DefaultRegistryTallyParams(), false, types.DefaultCommunityRoleParams(), types.DefaultParticipationParams(), nil,
+					types.NewNominationParams(types.DefaultNominationMinDeposit, 0), types.DefaultRoleBondParams()),
+			},
+			valid: false,
+		}, {
+			desc: "duplicated proposal voter",
+			genState: &types.GenesisState{
+				PortId:            types.PortID,
+				Params:            types.DefaultParams(),
+				ProposalVoterList: []types.ProposalVoter{{ProposalId: 1, Voter: "alice"}, {ProposalId: 1, Voter: "alice"}},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated stake record",
+			genState: &types.GenesisState{
+				PortId:          types.PortID,
+				Params:          types.DefaultParams(),
+				StakeRecordList: []types.StakeRecord{{Address: "alice"}, {Address: "alice"}},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated proposal role tally",
+			genState: &types.GenesisState{
+				PortId:                types.PortID,
+				Params:                types.DefaultParams(),
+				ProposalRoleTallyList: []types.ProposalRoleTally{{ProposalId: 1}, {ProposalId: 1}},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated timelock",
+			genState: &types.GenesisState{
+				PortId:       types.PortID,
+				Params:       types.DefaultParams(),
+				TimelockList: []types.Timelock{{ProposalId: 1}, {ProposalId: 1}},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated chamber tally",
+			genState: &types.GenesisState{
+				PortId:           types.PortID,
+				Params:           types.DefaultParams(),
+				ChamberTallyList: []types.ChamberTally{{ProposalId: 1}, {ProposalId: 1}},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated vote delegation",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.DefaultParams(),
+				VoteDelegationList: []types.VoteDelegation{
+					{Delegator: alice, Representative: bob},
+					{Delegator: alice, Representative: carol},
+				},
+			},
+			valid: false,
+		}, {
+			desc: "invalid vote delegator address",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.DefaultParams(),
+				VoterRoleList: roleHolders, VoterRoleCount: 4,
+				VoteDelegationList: []types.VoteDelegation{{Delegator: "alice", Representative: bob}},
+			},
+			valid: false,
+		}, {
+			desc: "invalid vote representative address",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.DefaultParams(),
+				VoterRoleList: roleHolders, VoterRoleCount: 4,
+				VoteDelegationList: []types.VoteDelegation{{Delegator: alice, Representative: "bob"}},
+			},
+			valid: false,
+		}, {
+			desc: "vote representative without role",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.DefaultParams(),
+				VoterRoleList: roleHolders[:1], VoterRoleCount: 1,
+				VoteDelegationList: []types.VoteDelegation{{Delegator: alice, Representative: bob}},
+			},
+			valid: false,
+		}, {
+			desc: "vote delegation cycle",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.DefaultParams(),
+				VoterRoleList: roleHolders, VoterRoleCount: 4,
+				VoteDelegationList: []types.VoteDelegation{
+					{Delegator: alice, Representative: bob}, {Delegator: bob, Representative: carol}, {Delegator: carol, Representative: alice},
+				},
+			},
+			valid: false,
+		}, {
+			desc: "vote delegation chain too deep",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.DefaultParams(),
+				VoterRoleList: append([]types.VoterRole{{Id: 4, Address: testAddress("erin")}}, roleHolders...), VoterRoleCount: 5,
+				VoteDelegationList: []types.VoteDelegation{
+					{Delegator: alice, Representative: bob}, {Delegator: bob, Representative: carol}, {Delegator: carol, Representative: dave},
+					{Delegator: testAddress("erin"), Representative: alice},
+				},
+			},
+			valid: false,
+		}, {
+			desc: "self vote delegation",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.DefaultParams(),
+				VoterRoleList: roleHolders, VoterRoleCount: 4,
+				VoteDelegationList: []types.VoteDelegation{{Delegator: alice, Representative: alice}},
+			},
+			valid: false,
 		}, {
 			desc: "duplicated voterRole",
 			genState: &types.GenesisState{
@@ -66,3 +445,9 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func testAddress(name string) string {
+	addr := make([]byte, 20)
+	copy(addr, name)
+	return sdk.AccAddress(addr).String()
+}
@@ -32,4 +32,68 @@ var ParamsKey = collections.NewPrefix("p_voting")
 var (
 	VoterRoleKey      = collections.NewPrefix("voterrole/value/")
 	VoterRoleCountKey = collections.NewPrefix("voterrole/count/")
+	// VoterRoleByAddressKey is the prefix of the (address, role id) index of voter roles
+	VoterRoleByAddressKey = collections.NewPrefix("voterrole/address/")
+)
+
+var (
+	VoteDelegationKey                 = collections.NewPrefix("votedelegation/value/")
+	VoteDelegationByRepresentativeKey = collections.NewPrefix("votedelegation/representative/")
+)
+
+// ChamberTallyKey is the prefix of the per-chamber results of bicameral tallies
+var ChamberTallyKey = collections.NewPrefix("chambertally/value/")
+
+var (
+	// ProposalRoleTallyKey is the prefix of the per-role votes of finished proposals
+	ProposalRoleTallyKey = collections.NewPrefix("proposalroletally/value/")
+	// PendingRoleTallyKey is the prefix of the per-role votes of tallied proposals, until
+	// their voting period ended hook stores them
+	PendingRoleTallyKey = collections.NewPrefix("proposalroletally/pending/")
+)
+
+var (
+	TimelockKey      = collections.NewPrefix("timelock/value/")
+	TimelockQueueKey = collections.NewPrefix("timelock/queue/")
+	// TimelockProposalKey is the prefix of the id of the proposal x/gov passed last, whose
+	// messages it is executing
+	TimelockProposalKey = collections.NewPrefix("timelock/proposal/")
+)
+
+var (
+	// StakeRecordKey is the prefix of the stake records of the automatic community role
+	StakeRecordKey = collections.NewPrefix("stakerecord/value/")
+	// CommunityRoleCandidateKey is the prefix of the delegators checked for the automatic
+	// community role
+	CommunityRoleCandidateKey = collections.NewPrefix("stakerecord/candidate/")
+	// CommunityRoleCursorKey is the prefix of the last candidate checked for the automatic
+	// community role
+	CommunityRoleCursorKey = collections.NewPrefix("stakerecord/cursor/")
+)
+
+var (
+	// ParticipationKey is the prefix of the participation of role holders
+	ParticipationKey = collections.NewPrefix("participation/value/")
+	// ProposalVoterKey is the prefix of the role holders that voted on proposals in voting period
+	ProposalVoterKey = collections.NewPrefix("participation/voter/")
+)
+
+// SuspensionQueueKey is the prefix of the (reinstate at, role id) index of suspended roles
+var SuspensionQueueKey = collections.NewPrefix("voterrole/suspension/")
+
+var (
+	NominationKey      = collections.NewPrefix("nomination/value/")
+	NominationCountKey = collections.NewPrefix("nomination/count/")
+	// NominationQueueKey is the prefix of the (expires at, nomination id) index of nominations
+	NominationQueueKey = collections.NewPrefix("nomination/queue/")
+)
+
+// RoleExpiryQueueKey is the prefix of the (expires at, role id) index of expiring roles
+var RoleExpiryQueueKey = collections.NewPrefix("voterrole/expiry/")
+
+var (
+	RoleDistributionKey      = collections.NewPrefix("roledistribution/value/")
+	RoleDistributionCountKey = collections.NewPrefix("roledistribution/count/")
+	// RoleClaimKey is the prefix of the (distribution id, address) roles claimed
+	RoleClaimKey = collections.NewPrefix("roledistribution/claim/")
 )
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// MerkleHashSize is the size of the leaves, nodes and root of a role distribution tree.
+const MerkleHashSize = sha256.Size
+
+// Domain separators keep a leaf from being passed off as an inner node.
+const (
+	merkleLeafPrefix byte = 0x00
+	merkleNodePrefix byte = 0x01
+)
+
+// RoleLeafHash hashes the (address, role, multiplier, expires_at) leaf of a role
+// distribution. Strings are length prefixed so that field boundaries cannot shift.
+func RoleLeafHash(address, role, multiplier string, expiresAt int64) []byte {
+	h := sha256.New()
+	h.Write([]byte{merkleLeafPrefix})
+	for _, field := range []string{address, role, multiplier} {
+		_ = binary.Write(h, binary.BigEndian, uint64(len(field)))
+		h.Write([]byte(field))
+	}
+	_ = binary.Write(h, binary.BigEndian, expiresAt)
+	return h.Sum(nil)
+}
+
+// hashMerkleNode hashes two sibling nodes in sorted order, so proofs need no positions.
+func hashMerkleNode(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+
+	h := sha256.New()
+	h.Write([]byte{merkleNodePrefix})
+	h.Write(a)
+	h.Write(b)
+	return h.Sum(nil)
+}
+
+// RoleMerkleTree is a binary Merkle tree over the leaves of a role distribution. A node
+// without sibling is carried up to the next level unchanged.
+type RoleMerkleTree struct {
+	// levels holds the nodes from the leaves up to the root
+	levels [][][]byte
+}
+
+// NewRoleMerkleTree builds the tree over the given leaf hashes, in order.
+func NewRoleMerkleTree(leaves [][]byte) *RoleMerkleTree {
+	if len(leaves) == 0 {
+		return &RoleMerkleTree{}
+	}
+
+	levels := [][][]byte{leaves}
+	for level := leaves; len(level) > 1; {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			next = append(next, hashMerkleNode(level[i], level[i+1]))
+		}
+		levels = append(levels, next)
+		level = next
+	}
+
+	return &RoleMerkleTree{levels: levels}
+}
+
+// Root returns the root of the tree, nil for an empty tree.
+func (t *RoleMerkleTree) Root() []byte {
+	if len(t.levels) == 0 {
+		return nil
+	}
+	return t.levels[len(t.levels)-1][0]
+}
+
+// Proof returns the sibling hashes from the leaf at index up to the root.
+func (t *RoleMerkleTree) Proof(index int) [][]byte {
+	var proof [][]byte
+	for _, level := range t.levels[:max(len(t.levels)-1, 0)] {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		index /= 2
+	}
+	return proof
+}
+
+// VerifyRoleMerkleProof checks that the proof leads from the leaf to the root.
+func VerifyRoleMerkleProof(root, leaf []byte, proof [][]byte) bool {
+	node := leaf
+	for _, sibling := range proof {
+		if len(sibling) != MerkleHashSize {
+			return false
+		}
+		node = hashMerkleNode(node, sibling)
+	}
+	return len(root) == MerkleHashSize && bytes.Equal(node, root)
+}
@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmos-weighted-governance-sdk/x/voting/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleMerkleTree(t *testing.T) {
+	require.Nil(t, types.NewRoleMerkleTree(nil).Root())
+
+	for _, size := range []int{1, 2, 3, 5, 8} {
+		t.Run(fmt.Sprintf("%d leaves", size), func(t *testing.T) {
+			var leaves [][]byte
+			for i := 0; i < size; i++ {
+				leaves = append(leaves, types.RoleLeafHash(fmt.Sprintf("address%d", i), "community_member", "1.0", 0))
+			}
+			tree := types.NewRoleMerkleTree(leaves)
+			root := tree.Root()
+			require.Len(t, root, types.MerkleHashSize)
+
+			for i, leaf := range leaves {
+				proof := tree.Proof(i)
+				require.True(t, types.VerifyRoleMerkleProof(root, leaf, proof))
+
+				// any other leaf field fails the proof
+				other := types.RoleLeafHash(fmt.Sprintf("address%d", i), "community_member", "2.0", 0)
+				require.False(t, types.VerifyRoleMerkleProof(root, other, proof))
+			}
+		})
+	}
+
+	// a shifted field boundary hashes to another leaf
+	require.NotEqual(t, types.RoleLeafHash("ab", "c", "1.0", 0), types.RoleLeafHash("a", "bc", "1.0", 0))
+	// a proof must lead all the way up to the root
+	leaves := [][]byte{
+		types.RoleLeafHash("a", "core_contributor", "2.0", 0),
+		types.RoleLeafHash("b", "core_contributor", "2.0", 0),
+		types.RoleLeafHash("c", "core_contributor", "2.0", 0),
+		types.RoleLeafHash("d", "core_contributor", "2.0", 0),
+	}
+	tree := types.NewRoleMerkleTree(leaves)
+	require.Len(t, tree.Proof(0), 2)
+	require.False(t, types.VerifyRoleMerkleProof(tree.Root(), leaves[0], tree.Proof(0)[:1]))
+}
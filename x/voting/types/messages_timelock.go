@@ -0,0 +1,62 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = (*MsgTimelockMessages)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*Timelock)(nil)
+	_ codectypes.UnpackInterfacesMessage = GenesisState{}
+)
+
+func NewMsgTimelockMessages(authority string, messages []sdk.Msg) (*MsgTimelockMessages, error) {
+	anys, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgTimelockMessages{
+		Authority: authority,
+		Messages:  anys,
+	}, nil
+}
+
+// GetMsgs unpacks the wrapped messages.
+func (msg MsgTimelockMessages) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Messages, "timelocked messages")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgTimelockMessages) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, msg.Messages)
+}
+
+func NewMsgVetoProposal(voter string, proposalID uint64) *MsgVetoProposal {
+	return &MsgVetoProposal{
+		Voter:      voter,
+		ProposalId: proposalID,
+	}
+}
+
+// GetMsgs unpacks the held messages.
+func (t Timelock) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(t.Messages, "timelocked messages")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (t Timelock) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, t.Messages)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, timelock := range gs.TimelockList {
+		if err := timelock.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
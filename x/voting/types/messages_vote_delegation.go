@@ -0,0 +1,15 @@
+package types
+
+func NewMsgDelegateVote(delegator string, representative string, msgTypeURLs []string) *MsgDelegateVote {
+	return &MsgDelegateVote{
+		Delegator:      delegator,
+		Representative: representative,
+		MsgTypeUrls:    msgTypeURLs,
+	}
+}
+
+func NewMsgRevokeVoteDelegation(delegator string) *MsgRevokeVoteDelegation {
+	return &MsgRevokeVoteDelegation{
+		Delegator: delegator,
+	}
+}
@@ -1,5 +1,7 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 func NewMsgCreateVoterRole(creator string, address string, role string, multiplier string, addedAt int64, addedBy string) *MsgCreateVoterRole {
 	return &MsgCreateVoterRole{
 		Creator:    creator,
@@ -29,3 +31,65 @@ func NewMsgDeleteVoterRole(creator string, id uint64) *MsgDeleteVoterRole {
 		Creator: creator,
 	}
 }
+
+func NewMsgSuspendVoterRole(authority string, id uint64, reason string, reinstateAt int64) *MsgSuspendVoterRole {
+	return &MsgSuspendVoterRole{
+		Authority:   authority,
+		Id:          id,
+		Reason:      reason,
+		ReinstateAt: reinstateAt,
+	}
+}
+
+func NewMsgReinstateVoterRole(authority string, id uint64) *MsgReinstateVoterRole {
+	return &MsgReinstateVoterRole{
+		Authority: authority,
+		Id:        id,
+	}
+}
+
+func NewMsgNominateVoterRole(nominator string, address string, role string, justification string, deposit sdk.Coins) *MsgNominateVoterRole {
+	return &MsgNominateVoterRole{
+		Nominator:     nominator,
+		Address:       address,
+		Role:          role,
+		Justification: justification,
+		Deposit:       deposit,
+	}
+}
+
+func NewMsgApproveNomination(authority string, id uint64, multiplier string) *MsgApproveNomination {
+	return &MsgApproveNomination{
+		Authority:  authority,
+		Id:         id,
+		Multiplier: multiplier,
+	}
+}
+
+func NewMsgRejectNomination(authority string, id uint64, burnDeposit bool) *MsgRejectNomination {
+	return &MsgRejectNomination{
+		Authority:   authority,
+		Id:          id,
+		BurnDeposit: burnDeposit,
+	}
+}
+
+func NewMsgCreateRoleDistribution(authority string, merkleRoot []byte, claimStart, claimEnd int64) *MsgCreateRoleDistribution {
+	return &MsgCreateRoleDistribution{
+		Authority:  authority,
+		MerkleRoot: merkleRoot,
+		ClaimStart: claimStart,
+		ClaimEnd:   claimEnd,
+	}
+}
+
+func NewMsgClaimVoterRole(claimant string, distributionID uint64, role string, multiplier string, expiresAt int64, proof [][]byte) *MsgClaimVoterRole {
+	return &MsgClaimVoterRole{
+		Claimant:       claimant,
+		DistributionId: distributionID,
+		Role:           role,
+		Multiplier:     multiplier,
+		ExpiresAt:      expiresAt,
+		Proof:          proof,
+	}
+}
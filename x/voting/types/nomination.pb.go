@@ -0,0 +1,728 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmosweightedgovernancesdk/voting/v1/nomination.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Nomination is a pending application of an address for a voter role, waiting for a role
+// authority to approve or reject it.
+type Nomination struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nominator string `protobuf:"bytes,2,opt,name=nominator,proto3" json:"nominator,omitempty"`
+	// address is the nominee
+	Address       string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Role          string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Justification string `protobuf:"bytes,5,opt,name=justification,proto3" json:"justification,omitempty"`
+	// deposit is held by the module account until the nomination is settled
+	Deposit     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	SubmittedAt int64                                    `protobuf:"varint,7,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	// expires_at is the unix time the nomination expires and its deposit is refunded
+	ExpiresAt int64 `protobuf:"varint,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// bond is the bond of the role, held until the nomination is settled and kept by the
+	// role once approved
+	Bond github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=bond,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bond"`
+}
+
+func (m *Nomination) Reset()         { *m = Nomination{} }
+func (m *Nomination) String() string { return proto.CompactTextString(m) }
+func (*Nomination) ProtoMessage()    {}
+func (*Nomination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acbee4c796d87568, []int{0}
+}
+func (m *Nomination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Nomination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Nomination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Nomination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Nomination.Merge(m, src)
+}
+func (m *Nomination) XXX_Size() int {
+	return m.Size()
+}
+func (m *Nomination) XXX_DiscardUnknown() {
+	xxx_messageInfo_Nomination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Nomination proto.InternalMessageInfo
+
+func (m *Nomination) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Nomination) GetNominator() string {
+	if m != nil {
+		return m.Nominator
+	}
+	return ""
+}
+
+func (m *Nomination) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Nomination) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *Nomination) GetJustification() string {
+	if m != nil {
+		return m.Justification
+	}
+	return ""
+}
+
+func (m *Nomination) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *Nomination) GetSubmittedAt() int64 {
+	if m != nil {
+		return m.SubmittedAt
+	}
+	return 0
+}
+
+func (m *Nomination) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *Nomination) GetBond() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Bond
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Nomination)(nil), "cosmosweightedgovernancesdk.voting.v1.Nomination")
+}
+
+func init() {
+	proto.RegisterFile("cosmosweightedgovernancesdk/voting/v1/nomination.proto", fileDescriptor_acbee4c796d87568)
+}
+
+var fileDescriptor_acbee4c796d87568 = []byte{
+	// 446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x52, 0xb1, 0x6e, 0x13, 0x41,
+	0x10, 0xf5, 0xd9, 0x26, 0xc6, 0x9b, 0x80, 0xc4, 0x2a, 0xc5, 0xc6, 0x12, 0x17, 0x83, 0x40, 0x3a,
+	0x45, 0xf2, 0x9d, 0x0c, 0x52, 0x7a, 0x1b, 0x09, 0x3a, 0x8a, 0xa3, 0xa3, 0xb1, 0xee, 0x6e, 0x97,
+	0xcd, 0x40, 0x6e, 0xc7, 0xba, 0x5d, 0x1f, 0x89, 0xf8, 0x09, 0x6a, 0xbe, 0x00, 0x51, 0xa5, 0xe0,
+	0x23, 0x52, 0x46, 0x54, 0x54, 0x01, 0xd9, 0x45, 0x7e, 0x03, 0xdd, 0xee, 0x5e, 0x2c, 0x1a, 0x4a,
+	0x9a, 0xbb, 0x99, 0x37, 0xf3, 0x66, 0x9e, 0x66, 0x1f, 0x39, 0x2e, 0x50, 0x97, 0xa8, 0x3f, 0x0a,
+	0x90, 0x27, 0x46, 0x70, 0x89, 0xb5, 0xa8, 0x54, 0xa6, 0x0a, 0xa1, 0xf9, 0x87, 0xa4, 0x46, 0x03,
+	0x4a, 0x26, 0xf5, 0x34, 0x51, 0x58, 0x82, 0xca, 0x0c, 0xa0, 0x8a, 0x97, 0x15, 0x1a, 0xa4, 0x4f,
+	0xff, 0xc1, 0x8b, 0x1d, 0x2f, 0xae, 0xa7, 0xa3, 0x07, 0x59, 0x09, 0x0a, 0x13, 0xfb, 0x75, 0xcc,
+	0x51, 0xe8, 0x98, 0x49, 0x9e, 0x69, 0x91, 0xd4, 0xd3, 0x5c, 0x98, 0x6c, 0x9a, 0x14, 0x08, 0x7e,
+	0xf2, 0xe8, 0xc0, 0xd5, 0x17, 0x36, 0x4b, 0x5c, 0xe2, 0x4b, 0xfb, 0x12, 0x25, 0x3a, 0xbc, 0x89,
+	0x1c, 0xfa, 0xf8, 0xba, 0x47, 0xc8, 0xeb, 0x5b, 0x7d, 0xf4, 0x3e, 0xe9, 0x02, 0x67, 0xc1, 0x38,
+	0x88, 0xfa, 0x69, 0x17, 0x38, 0x3d, 0x26, 0x43, 0xaf, 0x1e, 0x2b, 0xd6, 0x1d, 0x07, 0xd1, 0x70,
+	0xce, 0x7e, 0x7c, 0x9f, 0xec, 0xfb, 0xc9, 0x33, 0xce, 0x2b, 0xa1, 0xf5, 0x1b, 0x53, 0x81, 0x92,
+	0xe9, 0xb6, 0x95, 0x32, 0x32, 0xc8, 0x5c, 0x8d, 0xf5, 0x1a, 0x56, 0xda, 0xa6, 0x94, 0x92, 0x7e,
+	0x85, 0xa7, 0x82, 0xf5, 0x2d, 0x6c, 0x63, 0xfa, 0x84, 0xdc, 0x7b, 0xbf, 0xd2, 0x06, 0xde, 0x41,
+	0x61, 0x65, 0xb0, 0x3b, 0xb6, 0xf8, 0x37, 0x48, 0x3f, 0x91, 0x01, 0x17, 0x4b, 0xd4, 0x60, 0xd8,
+	0xce, 0xb8, 0x17, 0xed, 0x3e, 0x3b, 0x88, 0xbd, 0x8c, 0xe6, 0x1a, 0xb1, 0xbf, 0x46, 0xfc, 0x02,
+	0x41, 0xcd, 0x5f, 0x5e, 0x5e, 0x1f, 0x76, 0xbe, 0xfd, 0x3a, 0x8c, 0x24, 0x98, 0x93, 0x55, 0x1e,
+	0x17, 0x58, 0xfa, 0x6b, 0xf8, 0xdf, 0xa4, 0x79, 0x22, 0x73, 0xbe, 0x14, 0xda, 0x12, 0xf4, 0x97,
+	0x9b, 0x8b, 0xa3, 0xbd, 0x53, 0x21, 0xb3, 0xe2, 0x7c, 0xd1, 0xdc, 0x53, 0x7f, 0xbd, 0xb9, 0x38,
+	0x0a, 0xd2, 0x76, 0x23, 0x7d, 0x44, 0xf6, 0xf4, 0x2a, 0x2f, 0xc1, 0x18, 0xc1, 0x17, 0x99, 0x61,
+	0x83, 0x71, 0x10, 0xf5, 0xd2, 0xdd, 0x5b, 0x6c, 0x66, 0xe8, 0x43, 0x42, 0xc4, 0xd9, 0x12, 0x2a,
+	0xa1, 0x9b, 0x86, 0xbb, 0xb6, 0x61, 0xe8, 0x91, 0x99, 0xa1, 0x2b, 0xd2, 0xcf, 0x51, 0x71, 0x36,
+	0xfc, 0x5f, 0xda, 0xed, 0xba, 0xf9, 0xab, 0xcb, 0x75, 0x18, 0x5c, 0xad, 0xc3, 0xe0, 0xf7, 0x3a,
+	0x0c, 0x3e, 0x6f, 0xc2, 0xce, 0xd5, 0x26, 0xec, 0xfc, 0xdc, 0x84, 0x9d, 0xb7, 0x13, 0x3f, 0xad,
+	0xb5, 0xe1, 0x64, 0xeb, 0x43, 0xbb, 0xe1, 0xac, 0xb5, 0xb0, 0x5d, 0x95, 0xef, 0x58, 0xc3, 0x3c,
+	0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x37, 0x42, 0x3f, 0xf5, 0x02, 0x00, 0x00,
+}
+
+func (m *Nomination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Nomination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Nomination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bond) > 0 {
+		for iNdEx := len(m.Bond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNomination(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintNomination(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SubmittedAt != 0 {
+		i = encodeVarintNomination(dAtA, i, uint64(m.SubmittedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNomination(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Justification) > 0 {
+		i -= len(m.Justification)
+		copy(dAtA[i:], m.Justification)
+		i = encodeVarintNomination(dAtA, i, uint64(len(m.Justification)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintNomination(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintNomination(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Nominator) > 0 {
+		i -= len(m.Nominator)
+		copy(dAtA[i:], m.Nominator)
+		i = encodeVarintNomination(dAtA, i, uint64(len(m.Nominator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintNomination(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNomination(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNomination(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Nomination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovNomination(uint64(m.Id))
+	}
+	l = len(m.Nominator)
+	if l > 0 {
+		n += 1 + l + sovNomination(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovNomination(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovNomination(uint64(l))
+	}
+	l = len(m.Justification)
+	if l > 0 {
+		n += 1 + l + sovNomination(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovNomination(uint64(l))
+		}
+	}
+	if m.SubmittedAt != 0 {
+		n += 1 + sovNomination(uint64(m.SubmittedAt))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovNomination(uint64(m.ExpiresAt))
+	}
+	if len(m.Bond) > 0 {
+		for _, e := range m.Bond {
+			l = e.Size()
+			n += 1 + l + sovNomination(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovNomination(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNomination(x uint64) (n int) {
+	return sovNomination(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Nomination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNomination
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Nomination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Nomination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNomination
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nominator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNomination
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNomination
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNomination
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nominator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNomination
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNomination
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNomination
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNomination
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNomination
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNomination
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Justification", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNomination
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNomination
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNomination
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Justification = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNomination
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNomination
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNomination
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAt", wireType)
+			}
+			m.SubmittedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNomination
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmittedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNomination
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNomination
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNomination
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNomination
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bond = append(m.Bond, types.Coin{})
+			if err := m.Bond[len(m.Bond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNomination(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNomination
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipNomination(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowNomination
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNomination
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNomination
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthNomination
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupNomination
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthNomination
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthNomination        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowNomination          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupNomination = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,6 +1,11 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// DefaultMaxVoterRolesPerAddress is the default maximum number of roles per address
@@ -8,19 +13,298 @@ const (
 	
 	// DefaultRoleCreationCooldown is the default cooldown period in seconds
 	DefaultRoleCreationCooldown uint32 = 300 // 5 minutes
+
+	// DefaultMaxVoteDelegationDepth is the default longest vote delegation chain followed during tally
+	DefaultMaxVoteDelegationDepth uint32 = 3
+
+	// DefaultValidatorMultiplierScope is the default validator multiplier scope
+	DefaultValidatorMultiplierScope = ValidatorMultiplierScopeSelfBondOnly
+
+	// DefaultPowerTransform is the default voting power transform
+	DefaultPowerTransform = PowerTransformLinear
+
+	// DefaultTallyMode is the default tally mode
+	DefaultTallyMode = TallyModeWeighted
+
+	// DefaultVetoWindow is the default veto window in seconds, 0 disables the timelock
+	DefaultVetoWindow uint32 = 0
+
+	// DefaultRecusalMode is the default recusal mode
+	DefaultRecusalMode = RecusalModeNeutral
+
+	// DefaultAutoValidatorRole is whether validators are granted the validator role by default
+	DefaultAutoValidatorRole = false
+
+	// DefaultCommunityRoleEpoch is the default epoch at whose end community roles are recomputed
+	DefaultCommunityRoleEpoch = "day"
+
+	// DefaultCommunityRoleMinDays is the default number of days the min stake must be kept
+	DefaultCommunityRoleMinDays uint32 = 30
+
+	// DefaultCommunityRoleBatchSize is the default number of community role candidates checked per epoch
+	DefaultCommunityRoleBatchSize uint32 = 100
+
+	// DefaultParticipationWindow is the default number of proposals participation is measured over
+	DefaultParticipationWindow uint32 = 10
+
+	// DefaultParticipationDowngrade is whether inactive role holders are downgraded by default
+	DefaultParticipationDowngrade = false
+
+	// DefaultNominationExpiry is the default time in seconds a nomination stays pending (14 days)
+	DefaultNominationExpiry uint32 = 14 * 24 * 60 * 60
+)
+
+var (
+	// DefaultChamberQuorum is the default quorum of a chamber, matching x/gov
+	DefaultChamberQuorum = math.LegacyNewDecWithPrec(334, 3)
+	// DefaultChamberThreshold is the default threshold of a chamber, matching x/gov
+	DefaultChamberThreshold = math.LegacyNewDecWithPrec(5, 1)
+	// DefaultVetoThreshold is the default share of the veto holders needed to veto a proposal
+	DefaultVetoThreshold = math.LegacyNewDecWithPrec(5, 1)
+	// DefaultRegistryQuorum is the default quorum of registry proposals
+	DefaultRegistryQuorum = math.LegacyNewDecWithPrec(5, 1)
+	// DefaultRegistryThreshold is the default threshold of registry proposals
+	DefaultRegistryThreshold = math.LegacyNewDecWithPrec(667, 3)
+	// DefaultRegistryVetoThreshold is the default veto threshold of registry proposals, matching x/gov
+	DefaultRegistryVetoThreshold = math.LegacyNewDecWithPrec(334, 3)
+	// DefaultCommunityRoleMinStake is the default bonded stake required for the community role
+	DefaultCommunityRoleMinStake = math.NewInt(1_000_000)
+	// DefaultParticipationMinRate is the default share of the window role holders must vote on
+	DefaultParticipationMinRate = math.LegacyNewDecWithPrec(1, 1)
+	// DefaultDowngradeMultiplier is the default multiplier of downgraded role holders
+	DefaultDowngradeMultiplier = math.LegacyOneDec()
+	// DefaultNominationMinDeposit is the default deposit of a nomination
+	DefaultNominationMinDeposit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1_000_000)))
+	// DefaultRoleBondSlashFraction is the default share of a bond slashed on revocation for cause
+	DefaultRoleBondSlashFraction = math.LegacyNewDecWithPrec(5, 1)
+)
+
+// Role quorum bases.
+const (
+	// RoleQuorumBasisHolders measures participation as the share of holders that voted
+	RoleQuorumBasisHolders = "holders"
+	// RoleQuorumBasisPower measures participation as the share of role-weighted power that voted
+	RoleQuorumBasisPower = "power"
+)
+
+// Recusal modes.
+const (
+	// RecusalModeNeutral counts a recused role holder with the default multiplier of 1.0
+	RecusalModeNeutral = "neutral"
+	// RecusalModeExclude does not count the vote of a recused role holder
+	RecusalModeExclude = "exclude"
+)
+
+// Tally modes.
+const (
+	// TallyModeWeighted tallies proposals with the role-weighted stake only
+	TallyModeWeighted = "weighted"
+	// TallyModeBicameral requires proposals to pass both the token house and the role house
+	TallyModeBicameral = "bicameral"
+)
+
+// Voting power transforms.
+const (
+	// PowerTransformLinear counts stake as is
+	PowerTransformLinear = "linear"
+	// PowerTransformSqrt counts the square root of the stake (quadratic voting)
+	PowerTransformSqrt = "sqrt"
+	// PowerTransformLog counts log2(1 + stake)
+	PowerTransformLog = "log"
+	// PowerTransformCapped counts stake up to power_cap
+	PowerTransformCapped = "capped"
+)
+
+// Role operations a role authority may be allowed.
+const (
+	// RoleOperationCreate allows MsgCreateVoterRole
+	RoleOperationCreate = "create"
+	// RoleOperationUpdate allows MsgUpdateVoterRole
+	RoleOperationUpdate = "update"
+	// RoleOperationDelete allows MsgDeleteVoterRole
+	RoleOperationDelete = "delete"
+	// RoleOperationSuspend allows MsgSuspendVoterRole
+	RoleOperationSuspend = "suspend"
+	// RoleOperationReinstate allows MsgReinstateVoterRole
+	RoleOperationReinstate = "reinstate"
+)
+
+// Validator multiplier scopes.
+const (
+	// ValidatorMultiplierScopeSelfBondOnly applies a validator's multiplier to its own delegations only
+	ValidatorMultiplierScopeSelfBondOnly = "self_bond_only"
+	// ValidatorMultiplierScopeAllInherited also applies it to the power inherited from delegators that did not vote
+	ValidatorMultiplierScopeAllInherited = "all_inherited"
+	// ValidatorMultiplierScopeNone ignores the multiplier of validators
+	ValidatorMultiplierScopeNone = "none"
 )
 
 // NewParams creates a new Params instance.
-func NewParams(maxRolesPerAddress, cooldown uint32) Params {
+func NewParams(
+	maxRolesPerAddress, cooldown, maxVoteDelegationDepth uint32,
+	validatorMultiplierScope, powerTransform string,
+	powerCap math.Int,
+	tallyMode string,
+	tokenHouse, roleHouse ChamberParams,
+	roleQuorums []RoleQuorum,
+	vetoWindow uint32,
+	vetoThreshold math.LegacyDec,
+	recusalMode string,
+	registryTally RegistryTallyParams,
+	autoValidatorRole bool,
+	communityRole CommunityRoleParams,
+	participation ParticipationParams,
+	roleAuthorities []RoleAuthority,
+	nomination NominationParams,
+	roleBond RoleBondParams,
+) Params {
 	return Params{
-		MaxVoterRolesPerAddress: maxRolesPerAddress,
-		RoleCreationCooldown:    cooldown,
+		MaxVoterRolesPerAddress:  maxRolesPerAddress,
+		RoleCreationCooldown:     cooldown,
+		MaxVoteDelegationDepth:   maxVoteDelegationDepth,
+		ValidatorMultiplierScope: validatorMultiplierScope,
+		PowerTransform:           powerTransform,
+		PowerCap:                 powerCap,
+		TallyMode:                tallyMode,
+		TokenHouse:               tokenHouse,
+		RoleHouse:                roleHouse,
+		RoleQuorums:              roleQuorums,
+		VetoWindow:               vetoWindow,
+		VetoThreshold:            vetoThreshold,
+		RecusalMode:              recusalMode,
+		RegistryTally:            registryTally,
+		AutoValidatorRole:        autoValidatorRole,
+		CommunityRole:            communityRole,
+		Participation:            participation,
+		RoleAuthorities:          roleAuthorities,
+		Nomination:               nomination,
+		RoleBond:                 roleBond,
+	}
+}
+
+// NewChamberParams creates a new ChamberParams instance.
+func NewChamberParams(quorum, threshold math.LegacyDec) ChamberParams {
+	return ChamberParams{
+		Quorum:    quorum,
+		Threshold: threshold,
+	}
+}
+
+// DefaultChamberParams returns the default quorum and threshold of a chamber.
+func DefaultChamberParams() ChamberParams {
+	return NewChamberParams(DefaultChamberQuorum, DefaultChamberThreshold)
+}
+
+// NewRegistryTallyParams creates a new RegistryTallyParams instance.
+func NewRegistryTallyParams(quorum, threshold, vetoThreshold math.LegacyDec) RegistryTallyParams {
+	return RegistryTallyParams{
+		Quorum:        quorum,
+		Threshold:     threshold,
+		VetoThreshold: vetoThreshold,
+	}
+}
+
+// DefaultRegistryTallyParams returns the default quorum, threshold and veto threshold of
+// registry proposals.
+func DefaultRegistryTallyParams() RegistryTallyParams {
+	return NewRegistryTallyParams(DefaultRegistryQuorum, DefaultRegistryThreshold, DefaultRegistryVetoThreshold)
+}
+
+// NewCommunityRoleParams creates a new CommunityRoleParams instance.
+func NewCommunityRoleParams(enabled bool, epochIdentifier string, minStake math.Int, minDays, batchSize uint32) CommunityRoleParams {
+	return CommunityRoleParams{
+		Enabled:         enabled,
+		EpochIdentifier: epochIdentifier,
+		MinStake:        minStake,
+		MinDays:         minDays,
+		BatchSize:       batchSize,
+	}
+}
+
+// DefaultCommunityRoleParams returns the default, disabled, automatic community role params.
+func DefaultCommunityRoleParams() CommunityRoleParams {
+	return NewCommunityRoleParams(false, DefaultCommunityRoleEpoch, DefaultCommunityRoleMinStake,
+		DefaultCommunityRoleMinDays, DefaultCommunityRoleBatchSize)
+}
+
+// NewParticipationParams creates a new ParticipationParams instance.
+func NewParticipationParams(window uint32, minRate math.LegacyDec, downgrade bool, downgradeMultiplier math.LegacyDec) ParticipationParams {
+	return ParticipationParams{
+		Window:              window,
+		MinRate:             minRate,
+		Downgrade:           downgrade,
+		DowngradeMultiplier: downgradeMultiplier,
+	}
+}
+
+// DefaultParticipationParams returns the default participation window, with downgrades disabled.
+func DefaultParticipationParams() ParticipationParams {
+	return NewParticipationParams(DefaultParticipationWindow, DefaultParticipationMinRate,
+		DefaultParticipationDowngrade, DefaultDowngradeMultiplier)
+}
+
+// NewNominationParams creates a new NominationParams instance.
+func NewNominationParams(minDeposit sdk.Coins, expiry uint32) NominationParams {
+	return NominationParams{
+		MinDeposit: minDeposit,
+		Expiry:     expiry,
 	}
 }
 
+// DefaultNominationParams returns the default nomination deposit and expiry.
+func DefaultNominationParams() NominationParams {
+	return NewNominationParams(DefaultNominationMinDeposit, DefaultNominationExpiry)
+}
+
+// NewRoleBondParams creates a new RoleBondParams instance.
+func NewRoleBondParams(stakeDenom string, slashFraction math.LegacyDec, bonds []RoleBond) RoleBondParams {
+	return RoleBondParams{
+		StakeDenom:    stakeDenom,
+		SlashFraction: slashFraction,
+		Bonds:         bonds,
+	}
+}
+
+// DefaultRoleBondParams returns the default role bond params, with no role requiring a bond.
+func DefaultRoleBondParams() RoleBondParams {
+	return NewRoleBondParams(sdk.DefaultBondDenom, DefaultRoleBondSlashFraction, nil)
+}
+
+// Required returns the bond required to hold a role, empty when the role requires none.
+func (p RoleBondParams) Required(role string) sdk.Coins {
+	for _, bond := range p.Bonds {
+		if bond.Role == role {
+			return sdk.NewCoins(sdk.NewCoin(p.StakeDenom, bond.Amount))
+		}
+	}
+
+	return nil
+}
+
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams(DefaultMaxVoterRolesPerAddress, DefaultRoleCreationCooldown)
+	return NewParams(
+		DefaultMaxVoterRolesPerAddress,
+		DefaultRoleCreationCooldown,
+		DefaultMaxVoteDelegationDepth,
+		DefaultValidatorMultiplierScope,
+		DefaultPowerTransform,
+		math.ZeroInt(),
+		DefaultTallyMode,
+		DefaultChamberParams(),
+		DefaultChamberParams(),
+		nil,
+		DefaultVetoWindow,
+		DefaultVetoThreshold,
+		DefaultRecusalMode,
+		DefaultRegistryTallyParams(),
+		DefaultAutoValidatorRole,
+		DefaultCommunityRoleParams(),
+		DefaultParticipationParams(),
+		nil,
+		DefaultNominationParams(),
+		DefaultRoleBondParams(),
+	)
 }
 
 // Validate validates the set of params.
@@ -28,7 +312,280 @@ func (p Params) Validate() error {
 	if p.MaxVoterRolesPerAddress == 0 {
 		return fmt.Errorf("max voter roles per address must be greater than 0")
 	}
+
+	if p.MaxVoteDelegationDepth == 0 {
+		return fmt.Errorf("max vote delegation depth must be greater than 0")
+	}
+
+	switch p.ValidatorMultiplierScope {
+	case ValidatorMultiplierScopeSelfBondOnly, ValidatorMultiplierScopeAllInherited, ValidatorMultiplierScopeNone:
+	default:
+		return fmt.Errorf("invalid validator multiplier scope %q", p.ValidatorMultiplierScope)
+	}
+
+	switch p.PowerTransform {
+	case PowerTransformLinear, PowerTransformSqrt, PowerTransformLog:
+	case PowerTransformCapped:
+		if p.PowerCap.IsNil() || !p.PowerCap.IsPositive() {
+			return fmt.Errorf("power cap must be positive for the capped power transform")
+		}
+	default:
+		return fmt.Errorf("invalid power transform %q", p.PowerTransform)
+	}
+
+	if !p.PowerCap.IsNil() && p.PowerCap.IsNegative() {
+		return fmt.Errorf("power cap cannot be negative")
+	}
+
+	switch p.TallyMode {
+	case TallyModeWeighted, TallyModeBicameral:
+	default:
+		return fmt.Errorf("invalid tally mode %q", p.TallyMode)
+	}
+
+	if err := p.TokenHouse.Validate(); err != nil {
+		return fmt.Errorf("token house: %w", err)
+	}
+
+	if err := p.RoleHouse.Validate(); err != nil {
+		return fmt.Errorf("role house: %w", err)
+	}
+
+	roles := make(map[string]bool, len(p.RoleQuorums))
+	for _, roleQuorum := range p.RoleQuorums {
+		if roles[roleQuorum.Role] {
+			return fmt.Errorf("duplicated quorum for role %s", roleQuorum.Role)
+		}
+		roles[roleQuorum.Role] = true
+
+		if err := roleQuorum.Validate(); err != nil {
+			return fmt.Errorf("role quorum %s: %w", roleQuorum.Role, err)
+		}
+	}
+
+	if p.VetoThreshold.IsNil() || !p.VetoThreshold.IsPositive() || p.VetoThreshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("veto threshold must be greater than 0 and at most 1")
+	}
+
+	switch p.RecusalMode {
+	case RecusalModeNeutral, RecusalModeExclude:
+	default:
+		return fmt.Errorf("invalid recusal mode %q", p.RecusalMode)
+	}
+
+	if err := p.RegistryTally.Validate(); err != nil {
+		return fmt.Errorf("registry tally: %w", err)
+	}
+
+	if err := p.CommunityRole.Validate(); err != nil {
+		return fmt.Errorf("community role: %w", err)
+	}
+
+	if err := p.Participation.Validate(); err != nil {
+		return fmt.Errorf("participation: %w", err)
+	}
+
+	authorities := make(map[string]bool, len(p.RoleAuthorities))
+	for _, authority := range p.RoleAuthorities {
+		if authorities[authority.Address] {
+			return fmt.Errorf("duplicated role authority %s", authority.Address)
+		}
+		authorities[authority.Address] = true
+
+		if err := authority.Validate(); err != nil {
+			return fmt.Errorf("role authority %s: %w", authority.Address, err)
+		}
+	}
+
+	if err := p.Nomination.Validate(); err != nil {
+		return fmt.Errorf("nomination: %w", err)
+	}
+
+	if err := p.RoleBond.Validate(); err != nil {
+		return fmt.Errorf("role bond: %w", err)
+	}
 	
 	// Cooldown can be 0 to disable the feature
 	return nil
 }
+
+// Validate validates the quorum and threshold of a chamber.
+func (c ChamberParams) Validate() error {
+	if c.Quorum.IsNil() || c.Quorum.IsNegative() || c.Quorum.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("quorum must be between 0 and 1")
+	}
+
+	if c.Threshold.IsNil() || !c.Threshold.IsPositive() || c.Threshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("threshold must be greater than 0 and at most 1")
+	}
+
+	return nil
+}
+
+// Validate validates the quorum, threshold and veto threshold of registry proposals.
+func (r RegistryTallyParams) Validate() error {
+	if err := r.ChamberParams().Validate(); err != nil {
+		return err
+	}
+
+	if r.VetoThreshold.IsNil() || !r.VetoThreshold.IsPositive() || r.VetoThreshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("veto threshold must be greater than 0 and at most 1")
+	}
+
+	return nil
+}
+
+// ChamberParams returns the quorum and threshold of registry proposals, which replace the
+// params of every chamber in bicameral mode.
+func (r RegistryTallyParams) ChamberParams() ChamberParams {
+	return NewChamberParams(r.Quorum, r.Threshold)
+}
+
+// Validate validates the automatic community role params.
+func (c CommunityRoleParams) Validate() error {
+	if c.MinStake.IsNil() || c.MinStake.IsNegative() {
+		return fmt.Errorf("min stake cannot be negative")
+	}
+
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.EpochIdentifier == "" {
+		return fmt.Errorf("epoch identifier cannot be empty")
+	}
+
+	if !c.MinStake.IsPositive() {
+		return fmt.Errorf("min stake must be positive")
+	}
+
+	if c.BatchSize == 0 {
+		return fmt.Errorf("batch size must be greater than 0")
+	}
+
+	return nil
+}
+
+// Validate validates the participation params.
+func (p ParticipationParams) Validate() error {
+	if p.Window == 0 {
+		return fmt.Errorf("window must be greater than 0")
+	}
+
+	if p.MinRate.IsNil() || p.MinRate.IsNegative() || p.MinRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("min rate must be between 0 and 1")
+	}
+
+	// same bounds as the role multipliers
+	if p.DowngradeMultiplier.IsNil() || p.DowngradeMultiplier.LT(math.LegacyNewDecWithPrec(1, 1)) || p.DowngradeMultiplier.GT(math.LegacyNewDec(10)) {
+		return fmt.Errorf("downgrade multiplier must be between 0.1 and 10.0")
+	}
+
+	return nil
+}
+
+// Validate validates the nomination params.
+func (p NominationParams) Validate() error {
+	if err := p.MinDeposit.Validate(); err != nil {
+		return fmt.Errorf("invalid min deposit: %w", err)
+	}
+
+	if p.Expiry == 0 {
+		return fmt.Errorf("expiry must be greater than 0")
+	}
+
+	return nil
+}
+
+// Validate validates the role bond params.
+func (p RoleBondParams) Validate() error {
+	if err := sdk.ValidateDenom(p.StakeDenom); err != nil {
+		return fmt.Errorf("invalid stake denom: %w", err)
+	}
+
+	if p.SlashFraction.IsNil() || p.SlashFraction.IsNegative() || p.SlashFraction.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("slash fraction must be between 0 and 1")
+	}
+
+	roles := make(map[string]bool, len(p.Bonds))
+	for _, bond := range p.Bonds {
+		if bond.Role == "" {
+			return fmt.Errorf("role cannot be empty")
+		}
+		if roles[bond.Role] {
+			return fmt.Errorf("duplicated bond for role %s", bond.Role)
+		}
+		roles[bond.Role] = true
+
+		if bond.Amount.IsNil() || !bond.Amount.IsPositive() {
+			return fmt.Errorf("bond of role %s must be positive", bond.Role)
+		}
+	}
+
+	return nil
+}
+
+// NewRoleAuthority creates a new RoleAuthority instance.
+func NewRoleAuthority(address string, operations, roles []string, minMultiplier, maxMultiplier math.LegacyDec) RoleAuthority {
+	return RoleAuthority{
+		Address:       address,
+		Operations:    operations,
+		Roles:         roles,
+		MinMultiplier: minMultiplier,
+		MaxMultiplier: maxMultiplier,
+	}
+}
+
+// Validate validates a role authority.
+func (a RoleAuthority) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+
+	if len(a.Operations) == 0 {
+		return fmt.Errorf("operations cannot be empty")
+	}
+	for _, operation := range a.Operations {
+		switch operation {
+		case RoleOperationCreate, RoleOperationUpdate, RoleOperationDelete, RoleOperationSuspend, RoleOperationReinstate:
+		default:
+			return fmt.Errorf("invalid operation %q", operation)
+		}
+	}
+
+	for _, role := range a.Roles {
+		if role == "" {
+			return fmt.Errorf("role cannot be empty")
+		}
+	}
+
+	// same bounds as the role multipliers
+	minBound, maxBound := math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDec(10)
+	if a.MinMultiplier.IsNil() || a.MinMultiplier.LT(minBound) || a.MinMultiplier.GT(maxBound) {
+		return fmt.Errorf("min multiplier must be between 0.1 and 10.0")
+	}
+	if a.MaxMultiplier.IsNil() || a.MaxMultiplier.LT(a.MinMultiplier) || a.MaxMultiplier.GT(maxBound) {
+		return fmt.Errorf("max multiplier must be between the min multiplier and 10.0")
+	}
+
+	return nil
+}
+
+// Validate validates a role quorum.
+func (q RoleQuorum) Validate() error {
+	if q.Role == "" {
+		return fmt.Errorf("role cannot be empty")
+	}
+
+	if q.MinParticipation.IsNil() || !q.MinParticipation.IsPositive() || q.MinParticipation.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("min participation must be greater than 0 and at most 1")
+	}
+
+	switch q.Basis {
+	case RoleQuorumBasisHolders, RoleQuorumBasisPower:
+	default:
+		return fmt.Errorf("invalid basis %q", q.Basis)
+	}
+
+	return nil
+}
@@ -4,7 +4,11 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,6 +34,52 @@ type Params struct {
 	MaxVoterRolesPerAddress uint32 `protobuf:"varint,1,opt,name=max_voter_roles_per_address,json=maxVoterRolesPerAddress,proto3" json:"max_voter_roles_per_address,omitempty"`
 	// role_creation_cooldown is the minimum time (in seconds) between role creations by governance
 	RoleCreationCooldown uint32 `protobuf:"varint,2,opt,name=role_creation_cooldown,json=roleCreationCooldown,proto3" json:"role_creation_cooldown,omitempty"`
+	// max_vote_delegation_depth is the longest chain of vote delegations followed during tally
+	MaxVoteDelegationDepth uint32 `protobuf:"varint,3,opt,name=max_vote_delegation_depth,json=maxVoteDelegationDepth,proto3" json:"max_vote_delegation_depth,omitempty"`
+	// validator_multiplier_scope selects which stake a validator's role multiplier applies to in the tally:
+	// self_bond_only, all_inherited or none
+	ValidatorMultiplierScope string `protobuf:"bytes,4,opt,name=validator_multiplier_scope,json=validatorMultiplierScope,proto3" json:"validator_multiplier_scope,omitempty"`
+	// power_transform is applied to each voter's stake before the role multiplier:
+	// linear, sqrt, log or capped
+	PowerTransform string `protobuf:"bytes,5,opt,name=power_transform,json=powerTransform,proto3" json:"power_transform,omitempty"`
+	// power_cap is the maximum stake counted per voter when power_transform is capped
+	PowerCap cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=power_cap,json=powerCap,proto3,customtype=cosmossdk.io/math.Int" json:"power_cap"`
+	// tally_mode selects how proposals are tallied: weighted or bicameral
+	TallyMode string `protobuf:"bytes,7,opt,name=tally_mode,json=tallyMode,proto3" json:"tally_mode,omitempty"`
+	// token_house is the quorum and threshold of the stake-weighted chamber in bicameral mode
+	TokenHouse ChamberParams `protobuf:"bytes,8,opt,name=token_house,json=tokenHouse,proto3" json:"token_house"`
+	// role_house is the quorum and threshold of the role chamber in bicameral mode
+	RoleHouse ChamberParams `protobuf:"bytes,9,opt,name=role_house,json=roleHouse,proto3" json:"role_house"`
+	// role_quorums are the minimum participation required from the holders of specific roles
+	RoleQuorums []RoleQuorum `protobuf:"bytes,10,rep,name=role_quorums,json=roleQuorums,proto3" json:"role_quorums"`
+	// veto_window is the time (in seconds) veto holders have to veto a passed proposal before
+	// its messages are executed, 0 disables the timelock
+	VetoWindow uint32 `protobuf:"varint,11,opt,name=veto_window,json=vetoWindow,proto3" json:"veto_window,omitempty"`
+	// veto_threshold is the share of the veto holders' multipliers needed to veto a proposal
+	VetoThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=veto_threshold,json=vetoThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"veto_threshold"`
+	// recusal_mode sets how the tally counts a role holder on a proposal that creates,
+	// updates or deletes its own role: neutral (multiplier 1.0) or exclude (vote not counted)
+	RecusalMode string `protobuf:"bytes,13,opt,name=recusal_mode,json=recusalMode,proto3" json:"recusal_mode,omitempty"`
+	// registry_tally replaces the quorum, threshold and veto threshold of proposals that
+	// contain voting module messages
+	RegistryTally RegistryTallyParams `protobuf:"bytes,14,opt,name=registry_tally,json=registryTally,proto3" json:"registry_tally"`
+	// auto_validator_role grants the validator role to the operator account of validators
+	// when they bond, and revokes it when they unbond; roles set by governance are kept
+	AutoValidatorRole bool `protobuf:"varint,15,opt,name=auto_validator_role,json=autoValidatorRole,proto3" json:"auto_validator_role,omitempty"`
+	// community_role grants the community_member role to accounts that kept a minimum bonded
+	// stake for a minimum number of days
+	CommunityRole CommunityRoleParams `protobuf:"bytes,16,opt,name=community_role,json=communityRole,proto3" json:"community_role"`
+	// participation sets the window role holder participation is measured over, and whether
+	// inactive holders are downgraded
+	Participation ParticipationParams `protobuf:"bytes,17,opt,name=participation,proto3" json:"participation"`
+	// role_authorities are accounts, such as a group policy acting as a role council, allowed
+	// to change voter roles within a scope; the module authority keeps every permission
+	RoleAuthorities []RoleAuthority `protobuf:"bytes,18,rep,name=role_authorities,json=roleAuthorities,proto3" json:"role_authorities"`
+	// nomination sets the deposit and the expiry of voter role nominations
+	Nomination NominationParams `protobuf:"bytes,19,opt,name=nomination,proto3" json:"nomination"`
+	// role_bond sets the roles whose holders must post a bond, and the share of the bond
+	// slashed when a role is revoked for cause
+	RoleBond RoleBondParams `protobuf:"bytes,20,opt,name=role_bond,json=roleBond,proto3" json:"role_bond"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,129 +129,3876 @@ func (m *Params) GetRoleCreationCooldown() uint32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "cosmosweightedgovernancesdk.voting.v1.Params")
+func (m *Params) GetMaxVoteDelegationDepth() uint32 {
+	if m != nil {
+		return m.MaxVoteDelegationDepth
+	}
+	return 0
 }
 
-func init() {
-	proto.RegisterFile("cosmosweightedgovernancesdk/voting/v1/params.proto", fileDescriptor_b6c70f471d48698f)
+func (m *Params) GetValidatorMultiplierScope() string {
+	if m != nil {
+		return m.ValidatorMultiplierScope
+	}
+	return ""
 }
 
-var fileDescriptor_b6c70f471d48698f = []byte{
-	// 280 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4a, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0x2e, 0x4f, 0xcd, 0x4c, 0xcf, 0x28, 0x49, 0x4d, 0x49, 0xcf, 0x2f, 0x4b, 0x2d, 0xca,
-	0x4b, 0xcc, 0x4b, 0x4e, 0x2d, 0x4e, 0xc9, 0xd6, 0x2f, 0xcb, 0x2f, 0xc9, 0xcc, 0x4b, 0xd7, 0x2f,
-	0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52,
-	0xc5, 0xa3, 0x47, 0x0f, 0xa2, 0x47, 0xaf, 0xcc, 0x50, 0x4a, 0x30, 0x31, 0x37, 0x33, 0x2f, 0x5f,
-	0x1f, 0x4c, 0x42, 0x74, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16, 0x44,
-	0x54, 0x69, 0x13, 0x23, 0x17, 0x5b, 0x00, 0xd8, 0x02, 0x21, 0x1b, 0x2e, 0xe9, 0xdc, 0xc4, 0x8a,
-	0xf8, 0xb2, 0xfc, 0x92, 0xd4, 0xa2, 0xf8, 0xa2, 0xfc, 0x9c, 0xd4, 0xe2, 0xf8, 0x82, 0xd4, 0xa2,
-	0xf8, 0xc4, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xde, 0x20, 0xf1,
-	0xdc, 0xc4, 0x8a, 0x30, 0x90, 0x8a, 0x20, 0x90, 0x82, 0x80, 0xd4, 0x22, 0x47, 0x88, 0xb4, 0x90,
-	0x09, 0x97, 0x18, 0x48, 0x4f, 0x7c, 0x72, 0x51, 0x6a, 0x62, 0x49, 0x66, 0x7e, 0x5e, 0x7c, 0x72,
-	0x7e, 0x7e, 0x4e, 0x4a, 0x7e, 0x79, 0x9e, 0x04, 0x13, 0x58, 0xa3, 0x08, 0x48, 0xd6, 0x19, 0x2a,
-	0xe9, 0x0c, 0x95, 0xb3, 0x32, 0x79, 0xb1, 0x40, 0x9e, 0xb1, 0xeb, 0xf9, 0x06, 0x2d, 0x6d, 0x7c,
-	0x61, 0x51, 0x01, 0x0b, 0x0d, 0x88, 0x4b, 0x9d, 0xdc, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
-	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
-	0x58, 0x8e, 0x21, 0x4a, 0x17, 0x62, 0x8c, 0x2e, 0xcc, 0x1c, 0x5d, 0x84, 0x41, 0xba, 0x28, 0x26,
-	0x95, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x03, 0xc1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff,
-	0x98, 0x53, 0x09, 0x8f, 0x8a, 0x01, 0x00, 0x00,
+func (m *Params) GetPowerTransform() string {
+	if m != nil {
+		return m.PowerTransform
+	}
+	return ""
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *Params) GetTallyMode() string {
+	if m != nil {
+		return m.TallyMode
 	}
+	return ""
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *Params) GetTokenHouse() ChamberParams {
+	if m != nil {
+		return m.TokenHouse
+	}
+	return ChamberParams{}
+}
+
+func (m *Params) GetRoleHouse() ChamberParams {
+	if m != nil {
+		return m.RoleHouse
+	}
+	return ChamberParams{}
+}
+
+func (m *Params) GetRoleQuorums() []RoleQuorum {
+	if m != nil {
+		return m.RoleQuorums
+	}
+	return nil
+}
+
+func (m *Params) GetVetoWindow() uint32 {
+	if m != nil {
+		return m.VetoWindow
+	}
+	return 0
+}
+
+func (m *Params) GetRecusalMode() string {
+	if m != nil {
+		return m.RecusalMode
+	}
+	return ""
+}
+
+func (m *Params) GetRegistryTally() RegistryTallyParams {
+	if m != nil {
+		return m.RegistryTally
+	}
+	return RegistryTallyParams{}
+}
+
+func (m *Params) GetAutoValidatorRole() bool {
+	if m != nil {
+		return m.AutoValidatorRole
+	}
+	return false
+}
+
+func (m *Params) GetCommunityRole() CommunityRoleParams {
+	if m != nil {
+		return m.CommunityRole
+	}
+	return CommunityRoleParams{}
+}
+
+func (m *Params) GetParticipation() ParticipationParams {
+	if m != nil {
+		return m.Participation
+	}
+	return ParticipationParams{}
+}
+
+func (m *Params) GetRoleAuthorities() []RoleAuthority {
+	if m != nil {
+		return m.RoleAuthorities
+	}
+	return nil
+}
+
+func (m *Params) GetNomination() NominationParams {
+	if m != nil {
+		return m.Nomination
+	}
+	return NominationParams{}
+}
+
+func (m *Params) GetRoleBond() RoleBondParams {
+	if m != nil {
+		return m.RoleBond
+	}
+	return RoleBondParams{}
+}
+
+// ChamberParams defines the quorum and threshold of one chamber in bicameral tally mode.
+type ChamberParams struct {
+	// quorum is the minimum share of the chamber's power that must vote
+	Quorum cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=quorum,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"quorum"`
+	// threshold is the share of non-abstaining power that must vote yes
+	Threshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"threshold"`
+}
+
+func (m *ChamberParams) Reset()         { *m = ChamberParams{} }
+func (m *ChamberParams) String() string { return proto.CompactTextString(m) }
+func (*ChamberParams) ProtoMessage()    {}
+func (*ChamberParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6c70f471d48698f, []int{1}
+}
+func (m *ChamberParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChamberParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChamberParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *ChamberParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChamberParams.Merge(m, src)
+}
+func (m *ChamberParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChamberParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChamberParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChamberParams proto.InternalMessageInfo
+
+// RegistryTallyParams defines the elevated quorum, threshold and veto threshold of proposals
+// that change the voter role registry or the voting params.
+type RegistryTallyParams struct {
+	// quorum is the minimum share of the bonded power that must vote
+	Quorum cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=quorum,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"quorum"`
+	// threshold is the share of non-abstaining power that must vote yes
+	Threshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"threshold"`
+	// veto_threshold is the share of the voted power voting no with veto that rejects the proposal
+	VetoThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"veto_threshold"`
+}
+
+func (m *RegistryTallyParams) Reset()         { *m = RegistryTallyParams{} }
+func (m *RegistryTallyParams) String() string { return proto.CompactTextString(m) }
+func (*RegistryTallyParams) ProtoMessage()    {}
+func (*RegistryTallyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6c70f471d48698f, []int{2}
+}
+func (m *RegistryTallyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegistryTallyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegistryTallyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.MaxVoterRolesPerAddress != that1.MaxVoterRolesPerAddress {
-		return false
+}
+func (m *RegistryTallyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistryTallyParams.Merge(m, src)
+}
+func (m *RegistryTallyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegistryTallyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistryTallyParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegistryTallyParams proto.InternalMessageInfo
+
+// CommunityRoleParams defines when accounts are granted the community_member role automatically.
+type CommunityRoleParams struct {
+	// enabled turns the automatic community_member role on
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// epoch_identifier is the x/epochs epoch at whose end eligibility is recomputed
+	EpochIdentifier string `protobuf:"bytes,2,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// min_stake is the bonded stake an account must keep
+	MinStake cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=min_stake,json=minStake,proto3,customtype=cosmossdk.io/math.Int" json:"min_stake"`
+	// min_days is how long an account must keep min_stake bonded
+	MinDays uint32 `protobuf:"varint,4,opt,name=min_days,json=minDays,proto3" json:"min_days,omitempty"`
+	// batch_size is the maximum number of community role candidates checked per epoch
+	BatchSize uint32 `protobuf:"varint,5,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+}
+
+func (m *CommunityRoleParams) Reset()         { *m = CommunityRoleParams{} }
+func (m *CommunityRoleParams) String() string { return proto.CompactTextString(m) }
+func (*CommunityRoleParams) ProtoMessage()    {}
+func (*CommunityRoleParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6c70f471d48698f, []int{3}
+}
+func (m *CommunityRoleParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityRoleParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityRoleParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.RoleCreationCooldown != that1.RoleCreationCooldown {
-		return false
+}
+func (m *CommunityRoleParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityRoleParams.Merge(m, src)
+}
+func (m *CommunityRoleParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityRoleParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityRoleParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityRoleParams proto.InternalMessageInfo
+
+func (m *CommunityRoleParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return true
+	return false
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *CommunityRoleParams) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CommunityRoleParams) GetMinDays() uint32 {
+	if m != nil {
+		return m.MinDays
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RoleCreationCooldown != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.RoleCreationCooldown))
-		i--
-		dAtA[i] = 0x10
+func (m *CommunityRoleParams) GetBatchSize() uint32 {
+	if m != nil {
+		return m.BatchSize
 	}
-	if m.MaxVoterRolesPerAddress != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.MaxVoterRolesPerAddress))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+// ParticipationParams defines how role holder participation is measured and enforced.
+type ParticipationParams struct {
+	// window is the number of most recent proposals the participation rate is measured over
+	Window uint32 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// min_rate is the share of the window a role holder must vote on to keep its multiplier
+	MinRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_rate,json=minRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_rate"`
+	// downgrade reduces the multiplier of role holders below min_rate to downgrade_multiplier
+	Downgrade bool `protobuf:"varint,3,opt,name=downgrade,proto3" json:"downgrade,omitempty"`
+	// downgrade_multiplier is the multiplier of downgraded role holders
+	DowngradeMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=downgrade_multiplier,json=downgradeMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"downgrade_multiplier"`
+}
+
+func (m *ParticipationParams) Reset()         { *m = ParticipationParams{} }
+func (m *ParticipationParams) String() string { return proto.CompactTextString(m) }
+func (*ParticipationParams) ProtoMessage()    {}
+func (*ParticipationParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6c70f471d48698f, []int{4}
+}
+func (m *ParticipationParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipationParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipationParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipationParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipationParams.Merge(m, src)
+}
+func (m *ParticipationParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipationParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipationParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipationParams proto.InternalMessageInfo
+
+func (m *ParticipationParams) GetWindow() uint32 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *ParticipationParams) GetDowngrade() bool {
+	if m != nil {
+		return m.Downgrade
+	}
+	return false
+}
+
+// NominationParams defines the deposit and the expiry of voter role nominations.
+type NominationParams struct {
+	// min_deposit is the deposit a nominator must lock, refunded unless the nomination is
+	// rejected as spam
+	MinDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_deposit"`
+	// expiry is the time (in seconds) a nomination stays pending before its deposit is refunded
+	Expiry uint32 `protobuf:"varint,2,opt,name=expiry,proto3" json:"expiry,omitempty"`
+}
+
+func (m *NominationParams) Reset()         { *m = NominationParams{} }
+func (m *NominationParams) String() string { return proto.CompactTextString(m) }
+func (*NominationParams) ProtoMessage()    {}
+func (*NominationParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6c70f471d48698f, []int{5}
+}
+func (m *NominationParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NominationParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NominationParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NominationParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NominationParams.Merge(m, src)
+}
+func (m *NominationParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *NominationParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_NominationParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NominationParams proto.InternalMessageInfo
+
+func (m *NominationParams) GetMinDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinDeposit
+	}
+	return nil
+}
+
+func (m *NominationParams) GetExpiry() uint32 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+// RoleBondParams defines the bonds required to hold voter roles.
+type RoleBondParams struct {
+	// stake_denom is the denom of the bonds
+	StakeDenom string `protobuf:"bytes,1,opt,name=stake_denom,json=stakeDenom,proto3" json:"stake_denom,omitempty"`
+	// slash_fraction is the share of the bond sent to the community pool when a role is
+	// revoked for cause
+	SlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=slash_fraction,json=slashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction"`
+	// bonds are the roles that require a bond
+	Bonds []RoleBond `protobuf:"bytes,3,rep,name=bonds,proto3" json:"bonds"`
+}
+
+func (m *RoleBondParams) Reset()         { *m = RoleBondParams{} }
+func (m *RoleBondParams) String() string { return proto.CompactTextString(m) }
+func (*RoleBondParams) ProtoMessage()    {}
+func (*RoleBondParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6c70f471d48698f, []int{6}
+}
+func (m *RoleBondParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleBondParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleBondParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleBondParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleBondParams.Merge(m, src)
+}
+func (m *RoleBondParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleBondParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleBondParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleBondParams proto.InternalMessageInfo
+
+func (m *RoleBondParams) GetStakeDenom() string {
+	if m != nil {
+		return m.StakeDenom
+	}
+	return ""
+}
+
+func (m *RoleBondParams) GetBonds() []RoleBond {
+	if m != nil {
+		return m.Bonds
+	}
+	return nil
+}
+
+// RoleBond defines the bond required to hold a role.
+type RoleBond struct {
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// amount is the bond in the stake denom
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *RoleBond) Reset()         { *m = RoleBond{} }
+func (m *RoleBond) String() string { return proto.CompactTextString(m) }
+func (*RoleBond) ProtoMessage()    {}
+func (*RoleBond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6c70f471d48698f, []int{7}
+}
+func (m *RoleBond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleBond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleBond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleBond.Merge(m, src)
+}
+func (m *RoleBond) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleBond) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleBond.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleBond proto.InternalMessageInfo
+
+func (m *RoleBond) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+// RoleAuthority defines an account allowed to change voter roles within a scope.
+type RoleAuthority struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// operations are the role messages the authority may sign: create, update, delete,
+	// suspend or reinstate
+	Operations []string `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+	// roles are the roles the authority may change, empty for every role
+	Roles []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	// min_multiplier is the lowest multiplier the authority may set
+	MinMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=min_multiplier,json=minMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_multiplier"`
+	// max_multiplier is the highest multiplier the authority may set
+	MaxMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=max_multiplier,json=maxMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_multiplier"`
+}
+
+func (m *RoleAuthority) Reset()         { *m = RoleAuthority{} }
+func (m *RoleAuthority) String() string { return proto.CompactTextString(m) }
+func (*RoleAuthority) ProtoMessage()    {}
+func (*RoleAuthority) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6c70f471d48698f, []int{8}
+}
+func (m *RoleAuthority) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleAuthority) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleAuthority.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleAuthority) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleAuthority.Merge(m, src)
+}
+func (m *RoleAuthority) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleAuthority) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleAuthority.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleAuthority proto.InternalMessageInfo
+
+func (m *RoleAuthority) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RoleAuthority) GetOperations() []string {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+func (m *RoleAuthority) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+// RoleQuorum defines the minimum participation of the holders of a role for a proposal to pass.
+type RoleQuorum struct {
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// min_participation is the share of the role that must vote
+	MinParticipation cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_participation,json=minParticipation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_participation"`
+	// basis measures participation by holders (head count) or power (role-weighted stake)
+	Basis string `protobuf:"bytes,3,opt,name=basis,proto3" json:"basis,omitempty"`
+}
+
+func (m *RoleQuorum) Reset()         { *m = RoleQuorum{} }
+func (m *RoleQuorum) String() string { return proto.CompactTextString(m) }
+func (*RoleQuorum) ProtoMessage()    {}
+func (*RoleQuorum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6c70f471d48698f, []int{9}
+}
+func (m *RoleQuorum) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleQuorum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleQuorum.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleQuorum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleQuorum.Merge(m, src)
+}
+func (m *RoleQuorum) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleQuorum) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleQuorum.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleQuorum proto.InternalMessageInfo
+
+func (m *RoleQuorum) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *RoleQuorum) GetBasis() string {
+	if m != nil {
+		return m.Basis
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmosweightedgovernancesdk.voting.v1.Params")
+	proto.RegisterType((*ChamberParams)(nil), "cosmosweightedgovernancesdk.voting.v1.ChamberParams")
+	proto.RegisterType((*RegistryTallyParams)(nil), "cosmosweightedgovernancesdk.voting.v1.RegistryTallyParams")
+	proto.RegisterType((*CommunityRoleParams)(nil), "cosmosweightedgovernancesdk.voting.v1.CommunityRoleParams")
+	proto.RegisterType((*ParticipationParams)(nil), "cosmosweightedgovernancesdk.voting.v1.ParticipationParams")
+	proto.RegisterType((*NominationParams)(nil), "cosmosweightedgovernancesdk.voting.v1.NominationParams")
+	proto.RegisterType((*RoleBondParams)(nil), "cosmosweightedgovernancesdk.voting.v1.RoleBondParams")
+	proto.RegisterType((*RoleBond)(nil), "cosmosweightedgovernancesdk.voting.v1.RoleBond")
+	proto.RegisterType((*RoleAuthority)(nil), "cosmosweightedgovernancesdk.voting.v1.RoleAuthority")
+	proto.RegisterType((*RoleQuorum)(nil), "cosmosweightedgovernancesdk.voting.v1.RoleQuorum")
+}
+
+func init() {
+	proto.RegisterFile("cosmosweightedgovernancesdk/voting/v1/params.proto", fileDescriptor_b6c70f471d48698f)
+}
+
+var fileDescriptor_b6c70f471d48698f = []byte{
+	// 1373 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xbd, 0x6f, 0x5c, 0xc5,
+	0x16, 0xf7, 0xf5, 0xe7, 0xee, 0x59, 0xaf, 0x3f, 0xc6, 0x7e, 0x79, 0xd7, 0xc9, 0x7b, 0x6b, 0xbf,
+	0x48, 0x4f, 0x98, 0x20, 0xef, 0x62, 0x63, 0x40, 0x44, 0x69, 0xb2, 0xb6, 0x42, 0x22, 0x48, 0x94,
+	0x5c, 0x5b, 0x41, 0x8a, 0x14, 0x2e, 0xb3, 0xf7, 0x4e, 0x76, 0x47, 0xbe, 0x77, 0xe6, 0x32, 0x33,
+	0xbb, 0xf6, 0xa6, 0xa4, 0xa4, 0x81, 0x9a, 0x2a, 0x34, 0x08, 0x51, 0xa5, 0x08, 0x15, 0xa2, 0x4f,
+	0x19, 0xa5, 0x42, 0x14, 0x01, 0xc5, 0x45, 0xe0, 0xbf, 0x40, 0xf3, 0xb1, 0x5f, 0x78, 0x15, 0xd9,
+	0x71, 0x1a, 0x1a, 0x7b, 0xe7, 0x9c, 0x39, 0xbf, 0x33, 0xe7, 0xfb, 0x5c, 0xd8, 0x88, 0xb8, 0x4c,
+	0xb9, 0xdc, 0x27, 0xb4, 0xde, 0x50, 0x24, 0xae, 0xf3, 0x16, 0x11, 0x0c, 0xb3, 0x88, 0xc8, 0x78,
+	0xaf, 0xd2, 0xe2, 0x8a, 0xb2, 0x7a, 0xa5, 0xb5, 0x5e, 0xc9, 0xb0, 0xc0, 0xa9, 0x2c, 0x67, 0x82,
+	0x2b, 0x8e, 0xfe, 0xff, 0x12, 0x99, 0xb2, 0x95, 0x29, 0xb7, 0xd6, 0xcf, 0xce, 0xe3, 0x94, 0x32,
+	0x5e, 0x31, 0x7f, 0xad, 0xe4, 0xd9, 0x92, 0x95, 0xac, 0xd4, 0xb0, 0x24, 0x95, 0xd6, 0x7a, 0x8d,
+	0x28, 0xbc, 0x5e, 0x89, 0x38, 0x65, 0x8e, 0xbf, 0x64, 0xf9, 0xa1, 0x39, 0x55, 0xec, 0xc1, 0xb1,
+	0x16, 0xeb, 0xbc, 0xce, 0x2d, 0x5d, 0xff, 0xb2, 0xd4, 0xf3, 0xdf, 0x4d, 0xc3, 0xe4, 0x4d, 0xf3,
+	0x36, 0x74, 0x09, 0xce, 0xa5, 0xf8, 0x20, 0x6c, 0x71, 0x45, 0x44, 0x28, 0x78, 0x42, 0x64, 0x98,
+	0x11, 0x11, 0xe2, 0x38, 0x16, 0x44, 0x4a, 0xdf, 0x5b, 0xf1, 0x56, 0x8b, 0xc1, 0xbf, 0x53, 0x7c,
+	0x70, 0x5b, 0xdf, 0x08, 0xf4, 0x85, 0x9b, 0x44, 0x5c, 0xb6, 0x6c, 0xb4, 0x09, 0x67, 0xb4, 0x4c,
+	0x18, 0x09, 0x82, 0x15, 0xe5, 0x2c, 0x8c, 0x38, 0x4f, 0x62, 0xbe, 0xcf, 0xfc, 0x51, 0x23, 0xb8,
+	0xa8, 0xb9, 0x5b, 0x8e, 0xb9, 0xe5, 0x78, 0xe8, 0x03, 0x58, 0xea, 0xe8, 0x0c, 0x63, 0x92, 0x90,
+	0xba, 0x95, 0x8d, 0x49, 0xa6, 0x1a, 0xfe, 0x98, 0x11, 0x3c, 0xe3, 0x34, 0x6e, 0x77, 0xd9, 0xdb,
+	0x9a, 0x8b, 0x2e, 0xc1, 0xd9, 0x16, 0x4e, 0x68, 0x8c, 0x15, 0x17, 0x61, 0xda, 0x4c, 0x14, 0xcd,
+	0x12, 0x4a, 0x44, 0x28, 0x23, 0x9e, 0x11, 0x7f, 0x7c, 0xc5, 0x5b, 0xcd, 0x07, 0x7e, 0xf7, 0xc6,
+	0xf5, 0xee, 0x85, 0x1d, 0xcd, 0x47, 0x6f, 0xc0, 0x6c, 0xc6, 0xf7, 0x89, 0x08, 0x95, 0xc0, 0x4c,
+	0xde, 0xe3, 0x22, 0xf5, 0x27, 0x8c, 0xc8, 0x8c, 0x21, 0xef, 0x76, 0xa8, 0xe8, 0x3a, 0xe4, 0xed,
+	0xc5, 0x08, 0x67, 0xfe, 0xa4, 0xbe, 0x52, 0x7d, 0xfb, 0xf1, 0xb3, 0xe5, 0x91, 0x5f, 0x9f, 0x2d,
+	0xff, 0xcb, 0xfa, 0x57, 0x07, 0x8d, 0xf2, 0x4a, 0x8a, 0x55, 0xa3, 0x7c, 0x8d, 0xa9, 0xa7, 0x8f,
+	0xd6, 0xc0, 0x39, 0xfe, 0x1a, 0x53, 0xdf, 0xbf, 0x78, 0x78, 0xc1, 0x0b, 0x72, 0x06, 0x62, 0x0b,
+	0x67, 0xe8, 0xbf, 0x00, 0x0a, 0x27, 0x49, 0x3b, 0x4c, 0x79, 0x4c, 0xfc, 0x29, 0xa3, 0x32, 0x6f,
+	0x28, 0xd7, 0x79, 0x4c, 0xd0, 0x67, 0x50, 0x50, 0x7c, 0x8f, 0xb0, 0xb0, 0xc1, 0x9b, 0x92, 0xf8,
+	0xb9, 0x15, 0x6f, 0xb5, 0xb0, 0xb1, 0x59, 0x3e, 0x56, 0xbe, 0x94, 0xb7, 0x1a, 0x38, 0xad, 0x11,
+	0x61, 0xc3, 0x59, 0xcd, 0xeb, 0x57, 0x5a, 0xf5, 0x60, 0x30, 0xaf, 0x6a, 0x48, 0xf4, 0x29, 0x80,
+	0x89, 0x93, 0x55, 0x90, 0x7f, 0x3d, 0x0a, 0xf2, 0x1a, 0xd2, 0xe2, 0xdf, 0x81, 0x69, 0x83, 0xff,
+	0x79, 0x93, 0x8b, 0x66, 0x2a, 0x7d, 0x58, 0x19, 0x5b, 0x2d, 0x6c, 0xac, 0x1f, 0x53, 0x83, 0xce,
+	0xaa, 0x5b, 0x46, 0xb2, 0x3a, 0xae, 0xe1, 0x83, 0x82, 0xe8, 0x52, 0x24, 0x5a, 0x86, 0x42, 0x8b,
+	0x28, 0x1e, 0xee, 0x53, 0x16, 0xf3, 0x7d, 0xbf, 0x60, 0xf2, 0x03, 0x34, 0xe9, 0x13, 0x43, 0x41,
+	0x77, 0x61, 0xc6, 0x5c, 0x50, 0x0d, 0x41, 0x64, 0x83, 0x27, 0xb1, 0x3f, 0x6d, 0x22, 0xf6, 0x9e,
+	0x8b, 0xd8, 0xb9, 0xa3, 0x11, 0xfb, 0x98, 0xd4, 0x71, 0xd4, 0xde, 0x26, 0x51, 0x5f, 0xdc, 0xb6,
+	0x49, 0x64, 0xed, 0x2a, 0x6a, 0xb4, 0xdd, 0x0e, 0x18, 0xfa, 0x1f, 0x4c, 0x0b, 0x12, 0x35, 0x25,
+	0x4e, 0x6c, 0xf8, 0x8a, 0x26, 0x7c, 0x05, 0x47, 0x33, 0x01, 0x4c, 0x60, 0x46, 0x90, 0x3a, 0x95,
+	0x4a, 0xb4, 0x43, 0x13, 0x56, 0x7f, 0xc6, 0xb8, 0xf8, 0xe2, 0x71, 0x1d, 0xe0, 0x84, 0x77, 0xb5,
+	0xec, 0x51, 0x47, 0x17, 0x45, 0x3f, 0x1f, 0x95, 0x61, 0x01, 0x37, 0x15, 0x0f, 0x7b, 0x85, 0xa0,
+	0xdd, 0xe5, 0xcf, 0xae, 0x78, 0xab, 0xb9, 0x60, 0x5e, 0xb3, 0x6e, 0x77, 0x38, 0xda, 0xb3, 0xfa,
+	0x75, 0x11, 0x4f, 0xd3, 0x26, 0xa3, 0xaa, 0x6d, 0xaf, 0xce, 0x9d, 0xe8, 0x75, 0x5b, 0x1d, 0x61,
+	0x8d, 0x36, 0xe4, 0x75, 0x51, 0x3f, 0x1f, 0xed, 0x41, 0x31, 0xc3, 0x42, 0xd1, 0x88, 0x66, 0xa6,
+	0x6e, 0xfd, 0xf9, 0x13, 0x29, 0xbb, 0xd9, 0x2f, 0x3b, 0x44, 0xd9, 0x00, 0x36, 0x22, 0x30, 0x67,
+	0xf2, 0x0e, 0x37, 0x55, 0x83, 0x0b, 0xaa, 0x28, 0x91, 0x3e, 0x32, 0xb9, 0xb7, 0x79, 0x82, 0xdc,
+	0xbb, 0xec, 0xa4, 0xdb, 0x2e, 0xfd, 0x66, 0x45, 0x1f, 0x91, 0x12, 0x89, 0x6a, 0x00, 0x8c, 0xa7,
+	0x94, 0x59, 0x83, 0x16, 0x8c, 0x41, 0xef, 0x1f, 0x53, 0xc1, 0x8d, 0xae, 0xe0, 0x90, 0x12, 0xed,
+	0xa1, 0xa2, 0xbb, 0x60, 0xea, 0x29, 0xac, 0x71, 0x16, 0xfb, 0x8b, 0x46, 0xc5, 0xbb, 0x27, 0xb0,
+	0xa1, 0xca, 0x59, 0x7c, 0x54, 0x41, 0x4e, 0x38, 0xd6, 0xc5, 0xcd, 0x3f, 0x1e, 0x2c, 0x7b, 0x5f,
+	0xbe, 0x78, 0x78, 0xe1, 0xad, 0x97, 0x8d, 0xae, 0x83, 0xce, 0xf0, 0xb2, 0x50, 0xe7, 0x7f, 0xf2,
+	0xa0, 0x38, 0x50, 0xff, 0xe8, 0x06, 0x4c, 0xda, 0x22, 0x37, 0xa3, 0xe1, 0xd5, 0x8b, 0xcc, 0xa1,
+	0xa0, 0x5d, 0xc8, 0xf7, 0xea, 0x76, 0xf4, 0x54, 0x90, 0x3d, 0xa0, 0x8b, 0xe3, 0xda, 0xda, 0xf3,
+	0x0f, 0x46, 0x61, 0x61, 0x48, 0x69, 0xfd, 0x33, 0x6c, 0x18, 0xd2, 0xd6, 0xc6, 0x5e, 0x63, 0x5b,
+	0x73, 0x2e, 0xfa, 0xd3, 0x83, 0x85, 0x21, 0xf5, 0x8d, 0x7c, 0x98, 0x22, 0x0c, 0xd7, 0x12, 0x12,
+	0x1b, 0x1f, 0xe5, 0x82, 0xce, 0x11, 0xbd, 0x09, 0x73, 0x24, 0xe3, 0x51, 0x23, 0xa4, 0x31, 0x61,
+	0x8a, 0xde, 0xa3, 0x44, 0x58, 0x9b, 0x83, 0x59, 0x43, 0xbf, 0xd6, 0x25, 0xeb, 0x29, 0x9a, 0x52,
+	0x16, 0x4a, 0x85, 0xf7, 0x88, 0x7b, 0xfc, 0x2b, 0x4c, 0xd1, 0x94, 0xb2, 0x1d, 0x8d, 0x80, 0x96,
+	0x40, 0xff, 0x0e, 0x63, 0xdc, 0x96, 0x66, 0xd2, 0x17, 0x83, 0xa9, 0x94, 0xb2, 0x6d, 0xdc, 0x96,
+	0x7a, 0xc0, 0xd6, 0xb0, 0x8a, 0x1a, 0xa1, 0xa4, 0xf7, 0x89, 0x99, 0xe9, 0xc5, 0x20, 0x6f, 0x28,
+	0x3b, 0xf4, 0x3e, 0x71, 0xb6, 0x7e, 0x35, 0x0a, 0x0b, 0x43, 0xda, 0x0b, 0x3a, 0x03, 0x93, 0x6e,
+	0xb6, 0xd8, 0x6d, 0xc7, 0x9d, 0xd0, 0x2d, 0xab, 0x4f, 0x60, 0x45, 0x4e, 0x19, 0x55, 0xfd, 0xce,
+	0x00, 0x2b, 0x82, 0xfe, 0x03, 0x79, 0xbd, 0x01, 0xd5, 0x05, 0x8e, 0xad, 0x47, 0x72, 0x41, 0x8f,
+	0x80, 0x28, 0x2c, 0x76, 0x0f, 0x7d, 0xcb, 0x8d, 0x5d, 0x6b, 0x5e, 0x59, 0xf9, 0x42, 0x17, 0xb3,
+	0xb7, 0x0e, 0x39, 0x8f, 0xfc, 0xe8, 0xc1, 0xdc, 0xdf, 0xfb, 0x13, 0xfa, 0xc2, 0x83, 0x82, 0xf1,
+	0x33, 0xc9, 0xb8, 0xa4, 0xca, 0xf7, 0x4c, 0x3f, 0x5d, 0x72, 0xbd, 0xa8, 0xac, 0x97, 0xd0, 0xb2,
+	0x5b, 0x42, 0xcb, 0x5b, 0x9c, 0xb2, 0xea, 0x15, 0xfd, 0xb0, 0x1f, 0x7e, 0x5b, 0x5e, 0xad, 0x53,
+	0xd5, 0x68, 0xd6, 0xca, 0x11, 0x4f, 0xdd, 0x12, 0xea, 0xfe, 0xad, 0xe9, 0xd6, 0xa2, 0xda, 0x19,
+	0x91, 0x46, 0x40, 0x7e, 0xf3, 0xe2, 0xe1, 0x85, 0xe9, 0xc4, 0xbc, 0x39, 0xd4, 0x6b, 0xac, 0x74,
+	0xdd, 0x50, 0x47, 0xd3, 0x2a, 0xd5, 0x31, 0x21, 0x07, 0x19, 0x15, 0x6d, 0xb7, 0x48, 0xba, 0x93,
+	0x7b, 0xf7, 0xa1, 0x07, 0x33, 0x83, 0x4d, 0x4f, 0x6f, 0x09, 0x26, 0xcf, 0xc2, 0x98, 0x30, 0xee,
+	0x0a, 0x3b, 0x00, 0x43, 0xda, 0xd6, 0x14, 0x5d, 0x4e, 0x32, 0xc1, 0xb2, 0x11, 0xde, 0x13, 0x38,
+	0x32, 0x7d, 0xfc, 0x74, 0x31, 0x2d, 0x1a, 0xb4, 0x2b, 0x0e, 0x0c, 0x7d, 0x04, 0x13, 0xba, 0x73,
+	0x4b, 0x7f, 0xcc, 0xb8, 0xab, 0x72, 0xc2, 0xd6, 0xed, 0x26, 0x8f, 0xc5, 0x70, 0x56, 0x32, 0xc8,
+	0x75, 0xd8, 0x08, 0xc1, 0xb8, 0x99, 0xdc, 0xd6, 0x2e, 0xf3, 0x1b, 0x5d, 0x85, 0x49, 0x9c, 0xf2,
+	0x26, 0x53, 0xce, 0x92, 0x93, 0xd7, 0x96, 0x93, 0x77, 0xfa, 0x7e, 0x1e, 0x85, 0xe2, 0xc0, 0x38,
+	0x44, 0x1b, 0x30, 0xd5, 0xff, 0x21, 0x90, 0xaf, 0xfa, 0x4f, 0x1f, 0xad, 0x2d, 0x3a, 0x14, 0xf7,
+	0x0d, 0xb0, 0xa3, 0x04, 0x65, 0xf5, 0xa0, 0x73, 0x11, 0x95, 0x00, 0x78, 0x46, 0x84, 0xc9, 0x28,
+	0xe9, 0x8f, 0xae, 0x8c, 0xe9, 0x38, 0xf4, 0x28, 0x68, 0x11, 0x26, 0xcc, 0x67, 0x86, 0x71, 0x54,
+	0x3e, 0xb0, 0x07, 0x1d, 0x1d, 0x9d, 0x73, 0xaf, 0x2d, 0xe9, 0x8b, 0x29, 0x65, 0xbd, 0x74, 0x37,
+	0xf0, 0xf8, 0xa0, 0x1f, 0x7e, 0xe2, 0x94, 0xf0, 0xf8, 0xe0, 0x48, 0x35, 0x7d, 0xeb, 0x01, 0xf4,
+	0x56, 0xd9, 0xa1, 0x21, 0x8b, 0x60, 0x5e, 0x9b, 0x39, 0xb8, 0x20, 0x9d, 0x2e, 0x0f, 0xe7, 0x52,
+	0xca, 0x06, 0xba, 0x9a, 0xf6, 0x70, 0x0d, 0x4b, 0x2a, 0x6d, 0xcb, 0x0d, 0xec, 0xc1, 0xbe, 0xb1,
+	0xfa, 0xe1, 0xe3, 0xe7, 0x25, 0xef, 0xc9, 0xf3, 0x92, 0xf7, 0xfb, 0xf3, 0x92, 0xf7, 0xf5, 0x61,
+	0x69, 0xe4, 0xc9, 0x61, 0x69, 0xe4, 0x97, 0xc3, 0xd2, 0xc8, 0x9d, 0x35, 0x57, 0xab, 0x9d, 0x8c,
+	0x5d, 0xeb, 0xa5, 0xec, 0xda, 0xc0, 0x6a, 0x60, 0x0a, 0xb9, 0x36, 0x69, 0xbe, 0x24, 0xdf, 0xf9,
+	0x2b, 0x00, 0x00, 0xff, 0xff, 0x44, 0xbd, 0x52, 0xb0, 0x0a, 0x0f, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxVoterRolesPerAddress != that1.MaxVoterRolesPerAddress {
+		return false
+	}
+	if this.RoleCreationCooldown != that1.RoleCreationCooldown {
+		return false
+	}
+	if this.MaxVoteDelegationDepth != that1.MaxVoteDelegationDepth {
+		return false
+	}
+	if this.ValidatorMultiplierScope != that1.ValidatorMultiplierScope {
+		return false
+	}
+	if this.PowerTransform != that1.PowerTransform {
+		return false
+	}
+	if !this.PowerCap.Equal(that1.PowerCap) {
+		return false
+	}
+	if this.TallyMode != that1.TallyMode {
+		return false
+	}
+	if !this.TokenHouse.Equal(&that1.TokenHouse) {
+		return false
+	}
+	if !this.RoleHouse.Equal(&that1.RoleHouse) {
+		return false
+	}
+	if len(this.RoleQuorums) != len(that1.RoleQuorums) {
+		return false
+	}
+	for i := range this.RoleQuorums {
+		if !this.RoleQuorums[i].Equal(&that1.RoleQuorums[i]) {
+			return false
+		}
+	}
+	if this.VetoWindow != that1.VetoWindow {
+		return false
+	}
+	if !this.VetoThreshold.Equal(that1.VetoThreshold) {
+		return false
+	}
+	if this.RecusalMode != that1.RecusalMode {
+		return false
+	}
+	if !this.RegistryTally.Equal(&that1.RegistryTally) {
+		return false
+	}
+	if this.AutoValidatorRole != that1.AutoValidatorRole {
+		return false
+	}
+	if !this.CommunityRole.Equal(&that1.CommunityRole) {
+		return false
+	}
+	if !this.Participation.Equal(&that1.Participation) {
+		return false
+	}
+	if len(this.RoleAuthorities) != len(that1.RoleAuthorities) {
+		return false
+	}
+	for i := range this.RoleAuthorities {
+		if !this.RoleAuthorities[i].Equal(&that1.RoleAuthorities[i]) {
+			return false
+		}
+	}
+	if !this.Nomination.Equal(&that1.Nomination) {
+		return false
+	}
+	if !this.RoleBond.Equal(&that1.RoleBond) {
+		return false
+	}
+	return true
+}
+func (this *ChamberParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ChamberParams)
+	if !ok {
+		that2, ok := that.(ChamberParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Quorum.Equal(that1.Quorum) {
+		return false
+	}
+	if !this.Threshold.Equal(that1.Threshold) {
+		return false
+	}
+	return true
+}
+func (this *RegistryTallyParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RegistryTallyParams)
+	if !ok {
+		that2, ok := that.(RegistryTallyParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Quorum.Equal(that1.Quorum) {
+		return false
+	}
+	if !this.Threshold.Equal(that1.Threshold) {
+		return false
+	}
+	if !this.VetoThreshold.Equal(that1.VetoThreshold) {
+		return false
+	}
+	return true
+}
+func (this *CommunityRoleParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommunityRoleParams)
+	if !ok {
+		that2, ok := that.(CommunityRoleParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.EpochIdentifier != that1.EpochIdentifier {
+		return false
+	}
+	if !this.MinStake.Equal(that1.MinStake) {
+		return false
+	}
+	if this.MinDays != that1.MinDays {
+		return false
+	}
+	if this.BatchSize != that1.BatchSize {
+		return false
+	}
+	return true
+}
+func (this *ParticipationParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ParticipationParams)
+	if !ok {
+		that2, ok := that.(ParticipationParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Window != that1.Window {
+		return false
+	}
+	if !this.MinRate.Equal(that1.MinRate) {
+		return false
+	}
+	if this.Downgrade != that1.Downgrade {
+		return false
+	}
+	if !this.DowngradeMultiplier.Equal(that1.DowngradeMultiplier) {
+		return false
+	}
+	return true
+}
+func (this *NominationParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NominationParams)
+	if !ok {
+		that2, ok := that.(NominationParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.MinDeposit) != len(that1.MinDeposit) {
+		return false
+	}
+	for i := range this.MinDeposit {
+		if !this.MinDeposit[i].Equal(&that1.MinDeposit[i]) {
+			return false
+		}
+	}
+	if this.Expiry != that1.Expiry {
+		return false
+	}
+	return true
+}
+func (this *RoleBondParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RoleBondParams)
+	if !ok {
+		that2, ok := that.(RoleBondParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StakeDenom != that1.StakeDenom {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if len(this.Bonds) != len(that1.Bonds) {
+		return false
+	}
+	for i := range this.Bonds {
+		if !this.Bonds[i].Equal(&that1.Bonds[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RoleBond) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RoleBond)
+	if !ok {
+		that2, ok := that.(RoleBond)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (this *RoleAuthority) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RoleAuthority)
+	if !ok {
+		that2, ok := that.(RoleAuthority)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if len(this.Operations) != len(that1.Operations) {
+		return false
+	}
+	for i := range this.Operations {
+		if this.Operations[i] != that1.Operations[i] {
+			return false
+		}
+	}
+	if len(this.Roles) != len(that1.Roles) {
+		return false
+	}
+	for i := range this.Roles {
+		if this.Roles[i] != that1.Roles[i] {
+			return false
+		}
+	}
+	if !this.MinMultiplier.Equal(that1.MinMultiplier) {
+		return false
+	}
+	if !this.MaxMultiplier.Equal(that1.MaxMultiplier) {
+		return false
+	}
+	return true
+}
+func (this *RoleQuorum) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RoleQuorum)
+	if !ok {
+		that2, ok := that.(RoleQuorum)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if !this.MinParticipation.Equal(that1.MinParticipation) {
+		return false
+	}
+	if this.Basis != that1.Basis {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RoleBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	{
+		size, err := m.Nomination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if len(m.RoleAuthorities) > 0 {
+		for iNdEx := len(m.RoleAuthorities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleAuthorities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	{
+		size, err := m.Participation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size, err := m.CommunityRole.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.AutoValidatorRole {
+		i--
+		if m.AutoValidatorRole {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size, err := m.RegistryTally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.RecusalMode) > 0 {
+		i -= len(m.RecusalMode)
+		copy(dAtA[i:], m.RecusalMode)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.RecusalMode)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	{
+		size := m.VetoThreshold.Size()
+		i -= size
+		if _, err := m.VetoThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.VetoWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VetoWindow))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.RoleQuorums) > 0 {
+		for iNdEx := len(m.RoleQuorums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleQuorums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.RoleHouse.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.TokenHouse.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.TallyMode) > 0 {
+		i -= len(m.TallyMode)
+		copy(dAtA[i:], m.TallyMode)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.TallyMode)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.PowerCap.Size()
+		i -= size
+		if _, err := m.PowerCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.PowerTransform) > 0 {
+		i -= len(m.PowerTransform)
+		copy(dAtA[i:], m.PowerTransform)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PowerTransform)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ValidatorMultiplierScope) > 0 {
+		i -= len(m.ValidatorMultiplierScope)
+		copy(dAtA[i:], m.ValidatorMultiplierScope)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ValidatorMultiplierScope)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxVoteDelegationDepth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxVoteDelegationDepth))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RoleCreationCooldown != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RoleCreationCooldown))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxVoterRolesPerAddress != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxVoterRolesPerAddress))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChamberParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChamberParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChamberParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Quorum.Size()
+		i -= size
+		if _, err := m.Quorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RegistryTallyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegistryTallyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegistryTallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VetoThreshold.Size()
+		i -= size
+		if _, err := m.VetoThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Quorum.Size()
+		i -= size
+		if _, err := m.Quorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityRoleParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityRoleParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityRoleParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BatchSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinDays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinDays))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MinStake.Size()
+		i -= size
+		if _, err := m.MinStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipationParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipationParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipationParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DowngradeMultiplier.Size()
+		i -= size
+		if _, err := m.DowngradeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Downgrade {
+		i--
+		if m.Downgrade {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MinRate.Size()
+		i -= size
+		if _, err := m.MinRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Window != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NominationParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NominationParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NominationParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiry != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleBondParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleBondParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleBondParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bonds) > 0 {
+		for iNdEx := len(m.Bonds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bonds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.StakeDenom) > 0 {
+		i -= len(m.StakeDenom)
+		copy(dAtA[i:], m.StakeDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.StakeDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleBond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleBond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleAuthority) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleAuthority) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleAuthority) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxMultiplier.Size()
+		i -= size
+		if _, err := m.MaxMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinMultiplier.Size()
+		i -= size
+		if _, err := m.MinMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Operations[iNdEx])
+			copy(dAtA[i:], m.Operations[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Operations[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleQuorum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleQuorum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleQuorum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Basis) > 0 {
+		i -= len(m.Basis)
+		copy(dAtA[i:], m.Basis)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Basis)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.MinParticipation.Size()
+		i -= size
+		if _, err := m.MinParticipation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxVoterRolesPerAddress != 0 {
+		n += 1 + sovParams(uint64(m.MaxVoterRolesPerAddress))
+	}
+	if m.RoleCreationCooldown != 0 {
+		n += 1 + sovParams(uint64(m.RoleCreationCooldown))
+	}
+	if m.MaxVoteDelegationDepth != 0 {
+		n += 1 + sovParams(uint64(m.MaxVoteDelegationDepth))
+	}
+	l = len(m.ValidatorMultiplierScope)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.PowerTransform)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.PowerCap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.TallyMode)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.TokenHouse.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.RoleHouse.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.RoleQuorums) > 0 {
+		for _, e := range m.RoleQuorums {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.VetoWindow != 0 {
+		n += 1 + sovParams(uint64(m.VetoWindow))
+	}
+	l = m.VetoThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.RecusalMode)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.RegistryTally.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.AutoValidatorRole {
+		n += 2
+	}
+	l = m.CommunityRole.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.Participation.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if len(m.RoleAuthorities) > 0 {
+		for _, e := range m.RoleAuthorities {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.Nomination.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.RoleBond.Size()
+	n += 2 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *ChamberParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Quorum.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Threshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *RegistryTallyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Quorum.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Threshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.VetoThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *CommunityRoleParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.MinStake.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MinDays != 0 {
+		n += 1 + sovParams(uint64(m.MinDays))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovParams(uint64(m.BatchSize))
+	}
+	return n
+}
+
+func (m *ParticipationParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovParams(uint64(m.Window))
+	}
+	l = m.MinRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.Downgrade {
+		n += 2
+	}
+	l = m.DowngradeMultiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *NominationParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovParams(uint64(m.Expiry))
+	}
+	return n
+}
+
+func (m *RoleBondParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakeDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.Bonds) > 0 {
+		for _, e := range m.Bonds {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RoleBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *RoleAuthority) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Operations) > 0 {
+		for _, s := range m.Operations {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.MinMultiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxMultiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *RoleQuorum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.MinParticipation.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.Basis)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVoterRolesPerAddress", wireType)
+			}
+			m.MaxVoterRolesPerAddress = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVoterRolesPerAddress |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleCreationCooldown", wireType)
+			}
+			m.RoleCreationCooldown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoleCreationCooldown |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVoteDelegationDepth", wireType)
+			}
+			m.MaxVoteDelegationDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVoteDelegationDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorMultiplierScope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorMultiplierScope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerTransform", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PowerTransform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PowerCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TallyMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenHouse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenHouse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleHouse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RoleHouse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleQuorums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleQuorums = append(m.RoleQuorums, RoleQuorum{})
+			if err := m.RoleQuorums[len(m.RoleQuorums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoWindow", wireType)
+			}
+			m.VetoWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VetoWindow |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VetoThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecusalMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecusalMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryTally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RegistryTally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoValidatorRole", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoValidatorRole = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityRole", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityRole.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Participation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleAuthorities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleAuthorities = append(m.RoleAuthorities, RoleAuthority{})
+			if err := m.RoleAuthorities[len(m.RoleAuthorities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nomination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Nomination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RoleBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChamberParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChamberParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChamberParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegistryTallyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegistryTallyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegistryTallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VetoThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityRoleParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityRoleParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityRoleParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDays", wireType)
+			}
+			m.MinDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDays |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParticipationParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipationParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipationParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downgrade", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Downgrade = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowngradeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowngradeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NominationParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NominationParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NominationParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleBondParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleBondParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleBondParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bonds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bonds = append(m.Bonds, RoleBond{})
+			if err := m.Bonds[len(m.Bonds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleBond) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleBond: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleBond: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MaxVoterRolesPerAddress != 0 {
-		n += 1 + sovParams(uint64(m.MaxVoterRolesPerAddress))
-	}
-	if m.RoleCreationCooldown != 0 {
-		n += 1 + sovParams(uint64(m.RoleCreationCooldown))
+func (m *RoleAuthority) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleAuthority: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operations = append(m.Operations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *RoleQuorum) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -224,17 +4021,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: RoleQuorum: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RoleQuorum: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxVoterRolesPerAddress", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			m.MaxVoterRolesPerAddress = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -244,16 +4041,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxVoterRolesPerAddress |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleCreationCooldown", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinParticipation", wireType)
 			}
-			m.RoleCreationCooldown = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -263,11 +4073,58 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RoleCreationCooldown |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinParticipation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Basis", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Basis = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,24 @@
+package types
+
+import "cosmossdk.io/math"
+
+// Rate returns the share of the last window proposals of Recent the address voted on. An
+// address without ended proposals has not missed any and has a rate of one.
+func (p Participation) Rate(window uint32) math.LegacyDec {
+	recent := p.Recent
+	if len(recent) > int(window) {
+		recent = recent[len(recent)-int(window):]
+	}
+	if len(recent) == 0 {
+		return math.LegacyOneDec()
+	}
+
+	var voted int64
+	for _, v := range recent {
+		if v {
+			voted++
+		}
+	}
+
+	return math.LegacyNewDec(voted).QuoInt64(int64(len(recent)))
+}
@@ -0,0 +1,823 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmosweightedgovernancesdk/voting/v1/participation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Participation tracks whether a role holder voted on the proposals that ended while it
+// held its role.
+type Participation struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// proposals_ended is the number of proposals that ended while the address held a role
+	ProposalsEnded uint64 `protobuf:"varint,2,opt,name=proposals_ended,json=proposalsEnded,proto3" json:"proposals_ended,omitempty"`
+	// proposals_voted is the number of these proposals the address voted on
+	ProposalsVoted uint64 `protobuf:"varint,3,opt,name=proposals_voted,json=proposalsVoted,proto3" json:"proposals_voted,omitempty"`
+	// recent holds whether the address voted on each of the last proposals of the
+	// participation window, oldest first
+	Recent []bool `protobuf:"varint,4,rep,packed,name=recent,proto3" json:"recent,omitempty"`
+	// downgraded_from is the multiplier of a role reduced for inactivity, empty otherwise
+	DowngradedFrom string `protobuf:"bytes,5,opt,name=downgraded_from,json=downgradedFrom,proto3" json:"downgraded_from,omitempty"`
+	// downgraded_overrides are the message type overrides of a downgraded role before they
+	// were capped at the downgrade multiplier
+	DowngradedOverrides []MsgTypeMultiplier `protobuf:"bytes,6,rep,name=downgraded_overrides,json=downgradedOverrides,proto3" json:"downgraded_overrides"`
+}
+
+func (m *Participation) Reset()         { *m = Participation{} }
+func (m *Participation) String() string { return proto.CompactTextString(m) }
+func (*Participation) ProtoMessage()    {}
+func (*Participation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfc469f9eba537ee, []int{0}
+}
+func (m *Participation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Participation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Participation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Participation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Participation.Merge(m, src)
+}
+func (m *Participation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Participation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Participation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Participation proto.InternalMessageInfo
+
+func (m *Participation) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Participation) GetProposalsEnded() uint64 {
+	if m != nil {
+		return m.ProposalsEnded
+	}
+	return 0
+}
+
+func (m *Participation) GetProposalsVoted() uint64 {
+	if m != nil {
+		return m.ProposalsVoted
+	}
+	return 0
+}
+
+func (m *Participation) GetRecent() []bool {
+	if m != nil {
+		return m.Recent
+	}
+	return nil
+}
+
+func (m *Participation) GetDowngradedFrom() string {
+	if m != nil {
+		return m.DowngradedFrom
+	}
+	return ""
+}
+
+func (m *Participation) GetDowngradedOverrides() []MsgTypeMultiplier {
+	if m != nil {
+		return m.DowngradedOverrides
+	}
+	return nil
+}
+
+// ProposalVoter records that a role holder voted on a proposal in voting period.
+type ProposalVoter struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Voter      string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+}
+
+func (m *ProposalVoter) Reset()         { *m = ProposalVoter{} }
+func (m *ProposalVoter) String() string { return proto.CompactTextString(m) }
+func (*ProposalVoter) ProtoMessage()    {}
+func (*ProposalVoter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfc469f9eba537ee, []int{1}
+}
+func (m *ProposalVoter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalVoter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalVoter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalVoter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalVoter.Merge(m, src)
+}
+func (m *ProposalVoter) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalVoter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalVoter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalVoter proto.InternalMessageInfo
+
+func (m *ProposalVoter) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ProposalVoter) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Participation)(nil), "cosmosweightedgovernancesdk.voting.v1.Participation")
+	proto.RegisterType((*ProposalVoter)(nil), "cosmosweightedgovernancesdk.voting.v1.ProposalVoter")
+}
+
+func init() {
+	proto.RegisterFile("cosmosweightedgovernancesdk/voting/v1/participation.proto", fileDescriptor_dfc469f9eba537ee)
+}
+
+var fileDescriptor_dfc469f9eba537ee = []byte{
+	// 382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xcd, 0xca, 0xd3, 0x40,
+	0x14, 0x4d, 0xbe, 0xe6, 0xab, 0x76, 0x4a, 0x2b, 0xc4, 0x22, 0xa1, 0x8b, 0x34, 0x14, 0xc4, 0x6c,
+	0x92, 0x50, 0x05, 0xd1, 0x6d, 0xc1, 0x8a, 0x8b, 0x62, 0x09, 0xe2, 0xc2, 0x4d, 0x88, 0x99, 0x6b,
+	0x1c, 0x4c, 0x73, 0xc7, 0x99, 0x31, 0xb5, 0x6f, 0xe1, 0x9b, 0xf8, 0x1a, 0x5d, 0x76, 0xe9, 0x4a,
+	0xa4, 0x7d, 0x11, 0x99, 0xa4, 0xb1, 0xd5, 0x85, 0x74, 0x37, 0xf7, 0x70, 0xef, 0x99, 0xf3, 0x43,
+	0x9e, 0x67, 0x28, 0xd7, 0x28, 0x37, 0xc0, 0xf2, 0x8f, 0x0a, 0x68, 0x8e, 0x15, 0x88, 0x32, 0x2d,
+	0x33, 0x90, 0xf4, 0x53, 0x54, 0xa1, 0x62, 0x65, 0x1e, 0x55, 0xb3, 0x88, 0xa7, 0x42, 0xb1, 0x8c,
+	0xf1, 0x54, 0x31, 0x2c, 0x43, 0x2e, 0x50, 0xa1, 0xfd, 0xf0, 0x3f, 0xa7, 0x61, 0x73, 0x1a, 0x56,
+	0xb3, 0xf1, 0xd3, 0xeb, 0x7e, 0xa8, 0x50, 0x81, 0x48, 0x04, 0x16, 0xd0, 0xd0, 0x8f, 0x47, 0x39,
+	0xe6, 0x58, 0x3f, 0x23, 0xfd, 0x6a, 0xd0, 0xe9, 0xf7, 0x1b, 0x32, 0x58, 0x5d, 0x8a, 0xb1, 0x1d,
+	0x72, 0x27, 0xa5, 0x54, 0x80, 0x94, 0x8e, 0xe9, 0x99, 0x7e, 0x2f, 0x6e, 0x47, 0xfb, 0x11, 0xb9,
+	0xc7, 0x05, 0x72, 0x94, 0x69, 0x21, 0x13, 0x28, 0x29, 0x50, 0xe7, 0xc6, 0x33, 0x7d, 0x2b, 0x1e,
+	0xfe, 0x81, 0x5f, 0x68, 0xf4, 0xef, 0x45, 0x2d, 0x84, 0x3a, 0x9d, 0x7f, 0x16, 0xdf, 0x6a, 0xd4,
+	0x7e, 0x40, 0xba, 0x02, 0x32, 0x28, 0x95, 0x63, 0x79, 0x1d, 0xff, 0x6e, 0x7c, 0x9a, 0x34, 0x01,
+	0xc5, 0x4d, 0x99, 0x8b, 0x94, 0x02, 0x4d, 0x3e, 0x08, 0x5c, 0x3b, 0xb7, 0xb5, 0x96, 0xe1, 0x19,
+	0x5e, 0x08, 0x5c, 0xdb, 0x9f, 0xc9, 0xe8, 0x62, 0x51, 0x47, 0x21, 0x18, 0x05, 0xe9, 0x74, 0xbd,
+	0x8e, 0xdf, 0x7f, 0xfc, 0x2c, 0xbc, 0x2a, 0xd2, 0x70, 0x29, 0xf3, 0x37, 0x5b, 0x0e, 0xcb, 0x2f,
+	0x85, 0x62, 0xbc, 0x60, 0x20, 0xe6, 0xd6, 0xee, 0xe7, 0xc4, 0x88, 0xef, 0x9f, 0xb9, 0x5f, 0xb7,
+	0xd4, 0xd3, 0x05, 0x19, 0xac, 0x4e, 0x2e, 0xb4, 0x09, 0x61, 0x4f, 0x48, 0xbf, 0xb5, 0x95, 0x30,
+	0x5a, 0x87, 0x66, 0xc5, 0xa4, 0x85, 0x5e, 0x51, 0x7b, 0x44, 0x6e, 0xeb, 0x36, 0xea, 0xb4, 0x7a,
+	0x71, 0x33, 0xcc, 0x5f, 0xee, 0x0e, 0xae, 0xb9, 0x3f, 0xb8, 0xe6, 0xaf, 0x83, 0x6b, 0x7e, 0x3b,
+	0xba, 0xc6, 0xfe, 0xe8, 0x1a, 0x3f, 0x8e, 0xae, 0xf1, 0x2e, 0x68, 0x54, 0x07, 0xad, 0xec, 0xe0,
+	0xac, 0x3b, 0xd0, 0x25, 0x7f, 0x6d, 0x6b, 0x56, 0x5b, 0x0e, 0xf2, 0x7d, 0xb7, 0x6e, 0xf2, 0xc9,
+	0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x18, 0x61, 0x5c, 0x7b, 0x02, 0x00, 0x00,
+}
+
+func (m *Participation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Participation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Participation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DowngradedOverrides) > 0 {
+		for iNdEx := len(m.DowngradedOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DowngradedOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParticipation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DowngradedFrom) > 0 {
+		i -= len(m.DowngradedFrom)
+		copy(dAtA[i:], m.DowngradedFrom)
+		i = encodeVarintParticipation(dAtA, i, uint64(len(m.DowngradedFrom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Recent) > 0 {
+		for iNdEx := len(m.Recent) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.Recent[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintParticipation(dAtA, i, uint64(len(m.Recent)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ProposalsVoted != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.ProposalsVoted))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProposalsEnded != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.ProposalsEnded))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParticipation(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalVoter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalVoter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalVoter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintParticipation(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParticipation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParticipation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Participation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParticipation(uint64(l))
+	}
+	if m.ProposalsEnded != 0 {
+		n += 1 + sovParticipation(uint64(m.ProposalsEnded))
+	}
+	if m.ProposalsVoted != 0 {
+		n += 1 + sovParticipation(uint64(m.ProposalsVoted))
+	}
+	if len(m.Recent) > 0 {
+		n += 1 + sovParticipation(uint64(len(m.Recent))) + len(m.Recent)*1
+	}
+	l = len(m.DowngradedFrom)
+	if l > 0 {
+		n += 1 + l + sovParticipation(uint64(l))
+	}
+	if len(m.DowngradedOverrides) > 0 {
+		for _, e := range m.DowngradedOverrides {
+			l = e.Size()
+			n += 1 + l + sovParticipation(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProposalVoter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovParticipation(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovParticipation(uint64(l))
+	}
+	return n
+}
+
+func sovParticipation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParticipation(x uint64) (n int) {
+	return sovParticipation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Participation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Participation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Participation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalsEnded", wireType)
+			}
+			m.ProposalsEnded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalsEnded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalsVoted", wireType)
+			}
+			m.ProposalsVoted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalsVoted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParticipation
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Recent = append(m.Recent, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParticipation
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParticipation
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParticipation
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.Recent) == 0 {
+					m.Recent = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParticipation
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Recent = append(m.Recent, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recent", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowngradedFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DowngradedFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowngradedOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DowngradedOverrides = append(m.DowngradedOverrides, MsgTypeMultiplier{})
+			if err := m.DowngradedOverrides[len(m.DowngradedOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalVoter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalVoter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalVoter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParticipation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParticipation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParticipation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParticipation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParticipation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParticipation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParticipation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParticipation = fmt.Errorf("proto: unexpected end of group")
+)
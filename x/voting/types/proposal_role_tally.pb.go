@@ -0,0 +1,749 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmosweightedgovernancesdk/voting/v1/proposal_role_tally.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProposalRoleTally holds how the holders of each role voted on a finished proposal.
+type ProposalRoleTally struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// role_tallies are sorted by role, the empty role groups voters without a role
+	RoleTallies []RoleVoteTally `protobuf:"bytes,2,rep,name=role_tallies,json=roleTallies,proto3" json:"role_tallies"`
+}
+
+func (m *ProposalRoleTally) Reset()         { *m = ProposalRoleTally{} }
+func (m *ProposalRoleTally) String() string { return proto.CompactTextString(m) }
+func (*ProposalRoleTally) ProtoMessage()    {}
+func (*ProposalRoleTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fbb02e370ac4f51, []int{0}
+}
+func (m *ProposalRoleTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalRoleTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalRoleTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalRoleTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalRoleTally.Merge(m, src)
+}
+func (m *ProposalRoleTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalRoleTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalRoleTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalRoleTally proto.InternalMessageInfo
+
+func (m *ProposalRoleTally) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ProposalRoleTally) GetRoleTallies() []RoleVoteTally {
+	if m != nil {
+		return m.RoleTallies
+	}
+	return nil
+}
+
+// RoleVoteTally is the vote of the holders of one role on a proposal.
+type RoleVoteTally struct {
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// voters is the number of holders that voted, directly or through a vote delegation
+	Voters uint64 `protobuf:"varint,2,opt,name=voters,proto3" json:"voters,omitempty"`
+	// multiplier is the average multiplier the voters counted with
+	Multiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=multiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplier"`
+	// raw_power is the staked power of the role by option, including the power validators
+	// of the role inherited from delegators that did not vote
+	RawPower v1.TallyResult `protobuf:"bytes,4,opt,name=raw_power,json=rawPower,proto3" json:"raw_power"`
+	// weighted_power is the power the role counted with in the weighted tally by option
+	WeightedPower v1.TallyResult `protobuf:"bytes,5,opt,name=weighted_power,json=weightedPower,proto3" json:"weighted_power"`
+}
+
+func (m *RoleVoteTally) Reset()         { *m = RoleVoteTally{} }
+func (m *RoleVoteTally) String() string { return proto.CompactTextString(m) }
+func (*RoleVoteTally) ProtoMessage()    {}
+func (*RoleVoteTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fbb02e370ac4f51, []int{1}
+}
+func (m *RoleVoteTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleVoteTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleVoteTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleVoteTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleVoteTally.Merge(m, src)
+}
+func (m *RoleVoteTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleVoteTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleVoteTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleVoteTally proto.InternalMessageInfo
+
+func (m *RoleVoteTally) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *RoleVoteTally) GetVoters() uint64 {
+	if m != nil {
+		return m.Voters
+	}
+	return 0
+}
+
+func (m *RoleVoteTally) GetRawPower() v1.TallyResult {
+	if m != nil {
+		return m.RawPower
+	}
+	return v1.TallyResult{}
+}
+
+func (m *RoleVoteTally) GetWeightedPower() v1.TallyResult {
+	if m != nil {
+		return m.WeightedPower
+	}
+	return v1.TallyResult{}
+}
+
+func init() {
+	proto.RegisterType((*ProposalRoleTally)(nil), "cosmosweightedgovernancesdk.voting.v1.ProposalRoleTally")
+	proto.RegisterType((*RoleVoteTally)(nil), "cosmosweightedgovernancesdk.voting.v1.RoleVoteTally")
+}
+
+func init() {
+	proto.RegisterFile("cosmosweightedgovernancesdk/voting/v1/proposal_role_tally.proto", fileDescriptor_3fbb02e370ac4f51)
+}
+
+var fileDescriptor_3fbb02e370ac4f51 = []byte{
+	// 419 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0x14, 0x31,
+	0x14, 0xc7, 0x77, 0xda, 0xb5, 0xd8, 0xac, 0x15, 0x0c, 0xa2, 0xeb, 0x0a, 0xb3, 0x4b, 0x41, 0xd8,
+	0xcb, 0x64, 0x98, 0xea, 0x55, 0x84, 0xa5, 0x50, 0x04, 0x0f, 0x75, 0x10, 0x0f, 0x82, 0x0c, 0xe3,
+	0xcc, 0x23, 0x0d, 0x66, 0xf7, 0x0d, 0x49, 0x9a, 0x71, 0xbf, 0x85, 0x20, 0xf8, 0x49, 0xfc, 0x10,
+	0x3d, 0x16, 0x4f, 0xe2, 0xa1, 0xc8, 0xee, 0x17, 0x91, 0x4c, 0x32, 0x55, 0x2f, 0xd2, 0x5b, 0xde,
+	0xcb, 0x7b, 0xff, 0xff, 0x2f, 0x2f, 0x8f, 0xbc, 0xa8, 0x50, 0x2f, 0x51, 0xb7, 0x20, 0xf8, 0x99,
+	0x81, 0x9a, 0xa3, 0x05, 0xb5, 0x2a, 0x57, 0x15, 0xe8, 0xfa, 0x63, 0x6a, 0xd1, 0x88, 0x15, 0x4f,
+	0x6d, 0x96, 0x36, 0x0a, 0x1b, 0xd4, 0xa5, 0x2c, 0x14, 0x4a, 0x28, 0x4c, 0x29, 0xe5, 0x9a, 0x35,
+	0x0a, 0x0d, 0xd2, 0x27, 0xff, 0x11, 0x60, 0x5e, 0x80, 0xd9, 0x6c, 0xf2, 0xd0, 0x97, 0xa5, 0x1c,
+	0xad, 0xd3, 0xe3, 0x68, 0x7d, 0xff, 0xe4, 0x91, 0xbf, 0x28, 0xba, 0x28, 0xf5, 0x41, 0xb8, 0xba,
+	0xcf, 0x91, 0xa3, 0xcf, 0xbb, 0x93, 0xcf, 0x1e, 0x7e, 0x89, 0xc8, 0xbd, 0xd3, 0x80, 0x93, 0xa3,
+	0x84, 0x37, 0x0e, 0x86, 0x4e, 0xc9, 0xe8, 0x9a, 0x51, 0xd4, 0xe3, 0x68, 0x16, 0xcd, 0x87, 0x39,
+	0xe9, 0x53, 0x2f, 0x6b, 0xfa, 0x9e, 0xdc, 0xb9, 0x66, 0x17, 0xa0, 0xc7, 0x3b, 0xb3, 0xdd, 0xf9,
+	0xe8, 0xe8, 0x19, 0xbb, 0x11, 0x3e, 0x73, 0x46, 0x6f, 0xd1, 0x78, 0xb3, 0xc5, 0xf0, 0xe2, 0x6a,
+	0x3a, 0xc8, 0x47, 0x2a, 0xb8, 0x0b, 0xd0, 0x87, 0x5f, 0x77, 0xc8, 0xc1, 0x3f, 0x45, 0x94, 0x92,
+	0xa1, 0x2b, 0xe8, 0x50, 0xf6, 0xf3, 0xee, 0x4c, 0x1f, 0x90, 0x3d, 0x8b, 0x06, 0x94, 0xb3, 0x77,
+	0x80, 0x21, 0xa2, 0xaf, 0x09, 0x59, 0x9e, 0x4b, 0x23, 0x1a, 0x29, 0x40, 0x8d, 0x77, 0x5d, 0xc7,
+	0x22, 0x73, 0x26, 0x3f, 0xaf, 0xa6, 0x8f, 0x3d, 0xa1, 0xe3, 0x11, 0x98, 0x2e, 0x4b, 0x73, 0xc6,
+	0x5e, 0x01, 0x2f, 0xab, 0xf5, 0x31, 0x54, 0xdf, 0xbf, 0x25, 0x24, 0x8c, 0xec, 0x18, 0xaa, 0xfc,
+	0x2f, 0x11, 0xfa, 0x9c, 0xec, 0xab, 0xb2, 0x2d, 0x1a, 0x6c, 0x41, 0x8d, 0x87, 0xb3, 0x68, 0x3e,
+	0x3a, 0x9a, 0x84, 0xc7, 0x32, 0x37, 0x7d, 0x9b, 0xb1, 0x8e, 0x33, 0x07, 0x7d, 0x2e, 0x4d, 0x78,
+	0xd2, 0x6d, 0x55, 0xb6, 0xa7, 0xae, 0x83, 0x9e, 0x90, 0xbb, 0xfd, 0x4c, 0x82, 0xc6, 0xad, 0x1b,
+	0x6a, 0x1c, 0xf4, 0x7d, 0x9d, 0xd0, 0xe2, 0xe4, 0x62, 0x13, 0x47, 0x97, 0x9b, 0x38, 0xfa, 0xb5,
+	0x89, 0xa3, 0xcf, 0xdb, 0x78, 0x70, 0xb9, 0x8d, 0x07, 0x3f, 0xb6, 0xf1, 0xe0, 0x5d, 0xe2, 0x95,
+	0x92, 0xbe, 0x3e, 0xf9, 0x33, 0xfc, 0xc4, 0x6d, 0xdf, 0xa7, 0x7e, 0xff, 0xcc, 0xba, 0x01, 0xfd,
+	0x61, 0xaf, 0xfb, 0xfe, 0xa7, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x34, 0x35, 0xaf, 0xf6, 0xb2,
+	0x02, 0x00, 0x00,
+}
+
+func (m *ProposalRoleTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalRoleTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalRoleTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RoleTallies) > 0 {
+		for iNdEx := len(m.RoleTallies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleTallies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposalRoleTally(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintProposalRoleTally(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleVoteTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleVoteTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleVoteTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.WeightedPower.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposalRoleTally(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.RawPower.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposalRoleTally(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposalRoleTally(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Voters != 0 {
+		i = encodeVarintProposalRoleTally(dAtA, i, uint64(m.Voters))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintProposalRoleTally(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposalRoleTally(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposalRoleTally(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProposalRoleTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovProposalRoleTally(uint64(m.ProposalId))
+	}
+	if len(m.RoleTallies) > 0 {
+		for _, e := range m.RoleTallies {
+			l = e.Size()
+			n += 1 + l + sovProposalRoleTally(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RoleVoteTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovProposalRoleTally(uint64(l))
+	}
+	if m.Voters != 0 {
+		n += 1 + sovProposalRoleTally(uint64(m.Voters))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovProposalRoleTally(uint64(l))
+	l = m.RawPower.Size()
+	n += 1 + l + sovProposalRoleTally(uint64(l))
+	l = m.WeightedPower.Size()
+	n += 1 + l + sovProposalRoleTally(uint64(l))
+	return n
+}
+
+func sovProposalRoleTally(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposalRoleTally(x uint64) (n int) {
+	return sovProposalRoleTally(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProposalRoleTally) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposalRoleTally
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalRoleTally: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalRoleTally: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposalRoleTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleTallies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposalRoleTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposalRoleTally
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposalRoleTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleTallies = append(m.RoleTallies, RoleVoteTally{})
+			if err := m.RoleTallies[len(m.RoleTallies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposalRoleTally(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposalRoleTally
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleVoteTally) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposalRoleTally
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleVoteTally: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleVoteTally: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposalRoleTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposalRoleTally
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposalRoleTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+			}
+			m.Voters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposalRoleTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Voters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposalRoleTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposalRoleTally
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposalRoleTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawPower", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposalRoleTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposalRoleTally
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposalRoleTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RawPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedPower", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposalRoleTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposalRoleTally
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposalRoleTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightedPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposalRoleTally(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposalRoleTally
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposalRoleTally(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposalRoleTally
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposalRoleTally
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposalRoleTally
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposalRoleTally
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposalRoleTally
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposalRoleTally
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposalRoleTally        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposalRoleTally          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposalRoleTally = fmt.Errorf("proto: unexpected end of group")
+)
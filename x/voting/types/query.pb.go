@@ -5,7 +5,9 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -302,511 +304,7661 @@ func (m *QueryAllVoterRoleResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetVoterRoleRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryGetVoterRoleRequest")
-	proto.RegisterType((*QueryGetVoterRoleResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryGetVoterRoleResponse")
-	proto.RegisterType((*QueryAllVoterRoleRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryAllVoterRoleRequest")
-	proto.RegisterType((*QueryAllVoterRoleResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryAllVoterRoleResponse")
+// QueryVoteDelegationFromRequest defines the QueryVoteDelegationFromRequest message.
+type QueryVoteDelegationFromRequest struct {
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("cosmosweightedgovernancesdk/voting/v1/query.proto", fileDescriptor_e2ee4582cc4035b6)
+func (m *QueryVoteDelegationFromRequest) Reset()         { *m = QueryVoteDelegationFromRequest{} }
+func (m *QueryVoteDelegationFromRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationFromRequest) ProtoMessage()    {}
+func (*QueryVoteDelegationFromRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{6}
 }
-
-var fileDescriptor_e2ee4582cc4035b6 = []byte{
-	// 549 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4d, 0x8b, 0x13, 0x31,
-	0x1c, 0xc6, 0x9b, 0xba, 0x16, 0x36, 0xbe, 0x80, 0x71, 0x0f, 0x6b, 0x91, 0x51, 0x06, 0x7c, 0xa1,
-	0x30, 0x13, 0xa7, 0x8b, 0x8b, 0xeb, 0xc1, 0x97, 0x1e, 0xec, 0xc5, 0x43, 0x1d, 0xd0, 0x83, 0x17,
-	0x49, 0x77, 0x42, 0x36, 0xb4, 0x4d, 0x66, 0x27, 0xd9, 0xd1, 0x45, 0xbc, 0xf8, 0x09, 0x04, 0xbf,
-	0x84, 0x47, 0x4f, 0x5e, 0xfc, 0x02, 0x7b, 0x11, 0x56, 0xbc, 0x78, 0x12, 0x69, 0x17, 0xfc, 0x1a,
-	0x32, 0x49, 0x6a, 0x5b, 0xb7, 0xea, 0xb4, 0x78, 0x29, 0x43, 0xc8, 0xf3, 0xfc, 0x7f, 0x4f, 0xf2,
-	0xa4, 0x30, 0xda, 0x96, 0x6a, 0x20, 0xd5, 0x73, 0xca, 0xd9, 0x8e, 0xa6, 0x09, 0x93, 0x39, 0xcd,
-	0x04, 0x11, 0xdb, 0x54, 0x25, 0x3d, 0x9c, 0x4b, 0xcd, 0x05, 0xc3, 0x79, 0x84, 0x77, 0xf7, 0x68,
-	0xb6, 0x1f, 0xa6, 0x99, 0xd4, 0x12, 0x5d, 0xf9, 0x8b, 0x24, 0xb4, 0x92, 0x30, 0x8f, 0xea, 0xe7,
-	0xc8, 0x80, 0x0b, 0x89, 0xcd, 0xaf, 0x55, 0xd6, 0x1b, 0x56, 0x89, 0xbb, 0x44, 0x51, 0x6b, 0x89,
-	0xf3, 0xa8, 0x4b, 0x35, 0x89, 0x70, 0x4a, 0x18, 0x17, 0x44, 0x73, 0x29, 0xdc, 0xde, 0x66, 0x39,
-	0xb0, 0x94, 0x64, 0x64, 0xa0, 0x9c, 0x66, 0xb3, 0x9c, 0x26, 0x97, 0x9a, 0x66, 0xcf, 0x32, 0xd9,
-	0xa7, 0x4e, 0xb7, 0xc6, 0x24, 0x93, 0xe6, 0x13, 0x17, 0x5f, 0x6e, 0xf5, 0x22, 0x93, 0x92, 0xf5,
-	0x29, 0x26, 0x29, 0xc7, 0x44, 0x08, 0xa9, 0x0d, 0x9e, 0x9b, 0xe5, 0xaf, 0x41, 0xf4, 0xa8, 0x48,
-	0xd0, 0x31, 0x00, 0x31, 0xdd, 0xdd, 0xa3, 0x4a, 0xfb, 0x0c, 0x9e, 0x9f, 0x59, 0x55, 0xa9, 0x14,
-	0x8a, 0xa2, 0x0e, 0xac, 0x59, 0xd0, 0x75, 0x70, 0x19, 0x5c, 0x3f, 0xd5, 0x0c, 0xc2, 0x52, 0x67,
-	0x18, 0x5a, 0x9b, 0xd6, 0xea, 0xc1, 0xb7, 0x4b, 0x95, 0x77, 0x3f, 0xde, 0x37, 0x40, 0xec, 0x7c,
-	0xfc, 0x06, 0x5c, 0x37, 0x83, 0xda, 0x54, 0x3f, 0x29, 0xe2, 0xc4, 0xb2, 0x4f, 0x1d, 0x04, 0x3a,
-	0x0b, 0xab, 0x3c, 0x31, 0x93, 0x56, 0xe2, 0x2a, 0x4f, 0xfc, 0x0c, 0x5e, 0x98, 0xb3, 0xd7, 0xa1,
-	0x3d, 0x86, 0x70, 0x72, 0x1e, 0x0e, 0xef, 0x46, 0x49, 0xbc, 0x5f, 0x6e, 0xad, 0x95, 0x82, 0x30,
-	0x5e, 0xcd, 0xc7, 0x0b, 0x7e, 0xd7, 0xf1, 0xdd, 0xef, 0xf7, 0x8f, 0xf1, 0x3d, 0x80, 0x70, 0x72,
-	0xdd, 0x6e, 0xe4, 0x55, 0x37, 0x32, 0x2c, 0xba, 0x11, 0xda, 0xba, 0xb9, 0x6e, 0x84, 0x1d, 0xc2,
-	0xc6, 0xda, 0x78, 0x4a, 0xe9, 0x7f, 0x04, 0x2e, 0xd8, 0xec, 0x90, 0x3f, 0x04, 0x3b, 0xf1, 0x5f,
-	0x82, 0xa1, 0xf6, 0x0c, 0x7c, 0xd5, 0xc0, 0x5f, 0xfb, 0x27, 0xbc, 0x65, 0x9a, 0xa6, 0x6f, 0x1e,
-	0xad, 0xc0, 0x93, 0x86, 0x1e, 0x7d, 0x00, 0xb0, 0x66, 0x6f, 0x1a, 0x6d, 0x95, 0x04, 0x3c, 0x5e,
-	0xbd, 0xfa, 0xed, 0x65, 0xa4, 0x96, 0xcb, 0xbf, 0xf9, 0xfa, 0xcb, 0xd1, 0xdb, 0x2a, 0x46, 0x01,
-	0xa6, 0x62, 0xa7, 0x90, 0x24, 0xc1, 0x44, 0x1e, 0x28, 0x4d, 0x7a, 0xe6, 0xe9, 0xfc, 0xf6, 0xea,
-	0xd0, 0x67, 0x00, 0x4f, 0x4f, 0x97, 0x0a, 0xdd, 0x5d, 0x84, 0x61, 0x4e, 0x75, 0xeb, 0xf7, 0x96,
-	0x37, 0x70, 0x51, 0xee, 0x98, 0x28, 0xb7, 0xd0, 0x66, 0xc9, 0x28, 0x93, 0x8e, 0xe0, 0x97, 0x3c,
-	0x79, 0x85, 0x3e, 0x01, 0x78, 0xe6, 0x21, 0x57, 0xcb, 0x86, 0x9a, 0xd3, 0xf7, 0xc5, 0x42, 0xcd,
-	0xeb, 0xb2, 0xbf, 0x65, 0x42, 0x6d, 0xa0, 0x68, 0xe1, 0x50, 0xad, 0xf6, 0xc1, 0xd0, 0x03, 0x87,
-	0x43, 0x0f, 0x7c, 0x1f, 0x7a, 0xe0, 0xcd, 0xc8, 0xab, 0x1c, 0x8e, 0xbc, 0xca, 0xd7, 0x91, 0x57,
-	0x79, 0x1a, 0x58, 0xaa, 0x60, 0x8c, 0x35, 0x63, 0x99, 0xf4, 0xf0, 0x8b, 0xb1, 0xa1, 0xde, 0x4f,
-	0xa9, 0xea, 0xd6, 0xcc, 0xff, 0xde, 0xc6, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xab, 0x76, 0xf3,
-	0x4a, 0x32, 0x06, 0x00, 0x00,
+func (m *QueryVoteDelegationFromRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteDelegationFromRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteDelegationFromRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteDelegationFromRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationFromRequest.Merge(m, src)
+}
+func (m *QueryVoteDelegationFromRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteDelegationFromRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationFromRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryVoteDelegationFromRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ListVoterRole Queries a list of VoterRole items.
-	GetVoterRole(ctx context.Context, in *QueryGetVoterRoleRequest, opts ...grpc.CallOption) (*QueryGetVoterRoleResponse, error)
-	// ListVoterRole defines the ListVoterRole RPC.
-	ListVoterRole(ctx context.Context, in *QueryAllVoterRoleRequest, opts ...grpc.CallOption) (*QueryAllVoterRoleResponse, error)
+func (m *QueryVoteDelegationFromRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryVoteDelegationFromResponse defines the QueryVoteDelegationFromResponse message.
+type QueryVoteDelegationFromResponse struct {
+	VoteDelegation VoteDelegation `protobuf:"bytes,1,opt,name=vote_delegation,json=voteDelegation,proto3" json:"vote_delegation"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryVoteDelegationFromResponse) Reset()         { *m = QueryVoteDelegationFromResponse{} }
+func (m *QueryVoteDelegationFromResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationFromResponse) ProtoMessage()    {}
+func (*QueryVoteDelegationFromResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{7}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryVoteDelegationFromResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GetVoterRole(ctx context.Context, in *QueryGetVoterRoleRequest, opts ...grpc.CallOption) (*QueryGetVoterRoleResponse, error) {
-	out := new(QueryGetVoterRoleResponse)
-	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/GetVoterRole", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVoteDelegationFromResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteDelegationFromResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryVoteDelegationFromResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationFromResponse.Merge(m, src)
+}
+func (m *QueryVoteDelegationFromResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteDelegationFromResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationFromResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListVoterRole(ctx context.Context, in *QueryAllVoterRoleRequest, opts ...grpc.CallOption) (*QueryAllVoterRoleResponse, error) {
-	out := new(QueryAllVoterRoleResponse)
-	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/ListVoterRole", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVoteDelegationFromResponse proto.InternalMessageInfo
+
+func (m *QueryVoteDelegationFromResponse) GetVoteDelegation() VoteDelegation {
+	if m != nil {
+		return m.VoteDelegation
 	}
-	return out, nil
+	return VoteDelegation{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ListVoterRole Queries a list of VoterRole items.
-	GetVoterRole(context.Context, *QueryGetVoterRoleRequest) (*QueryGetVoterRoleResponse, error)
-	// ListVoterRole defines the ListVoterRole RPC.
-	ListVoterRole(context.Context, *QueryAllVoterRoleRequest) (*QueryAllVoterRoleResponse, error)
+// QueryVoteDelegationsToRequest defines the QueryVoteDelegationsToRequest message.
+type QueryVoteDelegationsToRequest struct {
+	Representative string             `protobuf:"bytes,1,opt,name=representative,proto3" json:"representative,omitempty"`
+	Pagination     *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryVoteDelegationsToRequest) Reset()         { *m = QueryVoteDelegationsToRequest{} }
+func (m *QueryVoteDelegationsToRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationsToRequest) ProtoMessage()    {}
+func (*QueryVoteDelegationsToRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{8}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryVoteDelegationsToRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetVoterRole(ctx context.Context, req *QueryGetVoterRoleRequest) (*QueryGetVoterRoleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVoterRole not implemented")
+func (m *QueryVoteDelegationsToRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteDelegationsToRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListVoterRole(ctx context.Context, req *QueryAllVoterRoleRequest) (*QueryAllVoterRoleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListVoterRole not implemented")
+func (m *QueryVoteDelegationsToRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationsToRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryVoteDelegationsToRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryVoteDelegationsToRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationsToRequest.DiscardUnknown(m)
 }
 
-func _Query_GetVoterRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetVoterRoleRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetVoterRole(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/GetVoterRole",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetVoterRole(ctx, req.(*QueryGetVoterRoleRequest))
+var xxx_messageInfo_QueryVoteDelegationsToRequest proto.InternalMessageInfo
+
+func (m *QueryVoteDelegationsToRequest) GetRepresentative() string {
+	if m != nil {
+		return m.Representative
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ListVoterRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllVoterRoleRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListVoterRole(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/ListVoterRole",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListVoterRole(ctx, req.(*QueryAllVoterRoleRequest))
+func (m *QueryVoteDelegationsToRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmosweightedgovernancesdk.voting.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetVoterRole",
-			Handler:    _Query_GetVoterRole_Handler,
-		},
-		{
-			MethodName: "ListVoterRole",
-			Handler:    _Query_ListVoterRole_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmosweightedgovernancesdk/voting/v1/query.proto",
+// QueryVoteDelegationsToResponse defines the QueryVoteDelegationsToResponse message.
+type QueryVoteDelegationsToResponse struct {
+	VoteDelegations []VoteDelegation    `protobuf:"bytes,1,rep,name=vote_delegations,json=voteDelegations,proto3" json:"vote_delegations"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVoteDelegationsToResponse) Reset()         { *m = QueryVoteDelegationsToResponse{} }
+func (m *QueryVoteDelegationsToResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationsToResponse) ProtoMessage()    {}
+func (*QueryVoteDelegationsToResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{9}
+}
+func (m *QueryVoteDelegationsToResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteDelegationsToResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteDelegationsToResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVoteDelegationsToResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationsToResponse.Merge(m, src)
+}
+func (m *QueryVoteDelegationsToResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteDelegationsToResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationsToResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryVoteDelegationsToResponse proto.InternalMessageInfo
+
+func (m *QueryVoteDelegationsToResponse) GetVoteDelegations() []VoteDelegation {
+	if m != nil {
+		return m.VoteDelegations
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVoteDelegationsToResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVotingPowerRequest defines the QueryVotingPowerRequest message.
+type QueryVotingPowerRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryVotingPowerRequest) Reset()         { *m = QueryVotingPowerRequest{} }
+func (m *QueryVotingPowerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingPowerRequest) ProtoMessage()    {}
+func (*QueryVotingPowerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{10}
+}
+func (m *QueryVotingPowerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingPowerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingPowerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryVotingPowerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingPowerRequest.Merge(m, src)
+}
+func (m *QueryVotingPowerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingPowerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingPowerRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetVoterRoleRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVotingPowerRequest proto.InternalMessageInfo
+
+func (m *QueryVotingPowerRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetVoterRoleRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVotingPowerResponse defines the QueryVotingPowerResponse message.
+type QueryVotingPowerResponse struct {
+	// raw_power is the address's stake delegated to bonded validators.
+	RawPower cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=raw_power,json=rawPower,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"raw_power"`
+	// transformed_power is raw_power after the power_transform param.
+	TransformedPower cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=transformed_power,json=transformedPower,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"transformed_power"`
+	// multiplier is the address's role multiplier.
+	Multiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=multiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplier"`
+	// weighted_power is transformed_power times multiplier.
+	WeightedPower  cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=weighted_power,json=weightedPower,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weighted_power"`
+	PowerTransform string                      `protobuf:"bytes,5,opt,name=power_transform,json=powerTransform,proto3" json:"power_transform,omitempty"`
 }
 
-func (m *QueryGetVoterRoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryVotingPowerResponse) Reset()         { *m = QueryVotingPowerResponse{} }
+func (m *QueryVotingPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingPowerResponse) ProtoMessage()    {}
+func (*QueryVotingPowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{11}
+}
+func (m *QueryVotingPowerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingPowerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVotingPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingPowerResponse.Merge(m, src)
+}
+func (m *QueryVotingPowerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingPowerResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetVoterRoleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVotingPowerResponse proto.InternalMessageInfo
+
+func (m *QueryVotingPowerResponse) GetPowerTransform() string {
+	if m != nil {
+		return m.PowerTransform
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetVoterRoleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEffectiveMultiplierRequest defines the QueryEffectiveMultiplierRequest message.
+type QueryEffectiveMultiplierRequest struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (m *QueryGetVoterRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.VoterRole.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryEffectiveMultiplierRequest) Reset()         { *m = QueryEffectiveMultiplierRequest{} }
+func (m *QueryEffectiveMultiplierRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveMultiplierRequest) ProtoMessage()    {}
+func (*QueryEffectiveMultiplierRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{12}
+}
+func (m *QueryEffectiveMultiplierRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveMultiplierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveMultiplierRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryEffectiveMultiplierRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveMultiplierRequest.Merge(m, src)
+}
+func (m *QueryEffectiveMultiplierRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveMultiplierRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveMultiplierRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllVoterRoleRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEffectiveMultiplierRequest proto.InternalMessageInfo
+
+func (m *QueryEffectiveMultiplierRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllVoterRoleRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEffectiveMultiplierRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
 }
 
-func (m *QueryAllVoterRoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryEffectiveMultiplierResponse defines the QueryEffectiveMultiplierResponse message.
+type QueryEffectiveMultiplierResponse struct {
+	// multiplier is the multiplier applied by the tally.
+	Multiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=multiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplier"`
+	// base_multiplier is the role's multiplier without overrides.
+	BaseMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_multiplier,json=baseMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_multiplier"`
+	// msg_type_url is the proposal message whose override decided the multiplier, empty when the base multiplier applies.
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *QueryEffectiveMultiplierResponse) Reset()         { *m = QueryEffectiveMultiplierResponse{} }
+func (m *QueryEffectiveMultiplierResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveMultiplierResponse) ProtoMessage()    {}
+func (*QueryEffectiveMultiplierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{13}
+}
+func (m *QueryEffectiveMultiplierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveMultiplierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveMultiplierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryEffectiveMultiplierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveMultiplierResponse.Merge(m, src)
+}
+func (m *QueryEffectiveMultiplierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveMultiplierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveMultiplierResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllVoterRoleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEffectiveMultiplierResponse proto.InternalMessageInfo
+
+func (m *QueryEffectiveMultiplierResponse) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllVoterRoleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryChamberTallyRequest defines the QueryChamberTallyRequest message.
+type QueryChamberTallyRequest struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (m *QueryAllVoterRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.VoterRole) > 0 {
-		for iNdEx := len(m.VoterRole) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VoterRole[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryChamberTallyRequest) Reset()         { *m = QueryChamberTallyRequest{} }
+func (m *QueryChamberTallyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChamberTallyRequest) ProtoMessage()    {}
+func (*QueryChamberTallyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{14}
+}
+func (m *QueryChamberTallyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChamberTallyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChamberTallyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryChamberTallyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChamberTallyRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryChamberTallyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChamberTallyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChamberTallyRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryChamberTallyRequest proto.InternalMessageInfo
+
+func (m *QueryChamberTallyRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryGetVoterRoleRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
-	}
-	return n
+// QueryChamberTallyResponse defines the QueryChamberTallyResponse message.
+type QueryChamberTallyResponse struct {
+	ChamberTally ChamberTally `protobuf:"bytes,1,opt,name=chamber_tally,json=chamberTally,proto3" json:"chamber_tally"`
 }
 
-func (m *QueryGetVoterRoleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryChamberTallyResponse) Reset()         { *m = QueryChamberTallyResponse{} }
+func (m *QueryChamberTallyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChamberTallyResponse) ProtoMessage()    {}
+func (*QueryChamberTallyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{15}
+}
+func (m *QueryChamberTallyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChamberTallyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChamberTallyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.VoterRole.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryChamberTallyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChamberTallyResponse.Merge(m, src)
+}
+func (m *QueryChamberTallyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChamberTallyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChamberTallyResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllVoterRoleRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryChamberTallyResponse proto.InternalMessageInfo
+
+func (m *QueryChamberTallyResponse) GetChamberTally() ChamberTally {
+	if m != nil {
+		return m.ChamberTally
 	}
-	return n
+	return ChamberTally{}
+}
+
+// QueryTallyBreakdownRequest defines the QueryTallyBreakdownRequest message.
+type QueryTallyBreakdownRequest struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryTallyBreakdownRequest) Reset()         { *m = QueryTallyBreakdownRequest{} }
+func (m *QueryTallyBreakdownRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyBreakdownRequest) ProtoMessage()    {}
+func (*QueryTallyBreakdownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{16}
+}
+func (m *QueryTallyBreakdownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTallyBreakdownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTallyBreakdownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTallyBreakdownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyBreakdownRequest.Merge(m, src)
+}
+func (m *QueryTallyBreakdownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallyBreakdownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyBreakdownRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTallyBreakdownRequest proto.InternalMessageInfo
+
+func (m *QueryTallyBreakdownRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryTallyBreakdownResponse defines the QueryTallyBreakdownResponse message.
+type QueryTallyBreakdownResponse struct {
+	RoleTallies []RoleTally `protobuf:"bytes,1,rep,name=role_tallies,json=roleTallies,proto3" json:"role_tallies"`
+	// role_quorums_met is false when any role quorum is unmet
+	RoleQuorumsMet bool `protobuf:"varint,2,opt,name=role_quorums_met,json=roleQuorumsMet,proto3" json:"role_quorums_met,omitempty"`
+	// recused lists the role holders whose role the proposal changes, sorted by address
+	Recused []string `protobuf:"bytes,3,rep,name=recused,proto3" json:"recused,omitempty"`
+}
+
+func (m *QueryTallyBreakdownResponse) Reset()         { *m = QueryTallyBreakdownResponse{} }
+func (m *QueryTallyBreakdownResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyBreakdownResponse) ProtoMessage()    {}
+func (*QueryTallyBreakdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{17}
+}
+func (m *QueryTallyBreakdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTallyBreakdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTallyBreakdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTallyBreakdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyBreakdownResponse.Merge(m, src)
+}
+func (m *QueryTallyBreakdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallyBreakdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyBreakdownResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTallyBreakdownResponse proto.InternalMessageInfo
+
+func (m *QueryTallyBreakdownResponse) GetRoleTallies() []RoleTally {
+	if m != nil {
+		return m.RoleTallies
+	}
+	return nil
+}
+
+func (m *QueryTallyBreakdownResponse) GetRoleQuorumsMet() bool {
+	if m != nil {
+		return m.RoleQuorumsMet
+	}
+	return false
+}
+
+func (m *QueryTallyBreakdownResponse) GetRecused() []string {
+	if m != nil {
+		return m.Recused
+	}
+	return nil
+}
+
+// QueryGetTimelockRequest defines the QueryGetTimelockRequest message.
+type QueryGetTimelockRequest struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryGetTimelockRequest) Reset()         { *m = QueryGetTimelockRequest{} }
+func (m *QueryGetTimelockRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTimelockRequest) ProtoMessage()    {}
+func (*QueryGetTimelockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{18}
+}
+func (m *QueryGetTimelockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTimelockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTimelockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTimelockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTimelockRequest.Merge(m, src)
+}
+func (m *QueryGetTimelockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTimelockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTimelockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetTimelockRequest proto.InternalMessageInfo
+
+func (m *QueryGetTimelockRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryGetTimelockResponse defines the QueryGetTimelockResponse message.
+type QueryGetTimelockResponse struct {
+	Timelock Timelock `protobuf:"bytes,1,opt,name=timelock,proto3" json:"timelock"`
+}
+
+func (m *QueryGetTimelockResponse) Reset()         { *m = QueryGetTimelockResponse{} }
+func (m *QueryGetTimelockResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTimelockResponse) ProtoMessage()    {}
+func (*QueryGetTimelockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{19}
+}
+func (m *QueryGetTimelockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTimelockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTimelockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTimelockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTimelockResponse.Merge(m, src)
+}
+func (m *QueryGetTimelockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTimelockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTimelockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetTimelockResponse proto.InternalMessageInfo
+
+func (m *QueryGetTimelockResponse) GetTimelock() Timelock {
+	if m != nil {
+		return m.Timelock
+	}
+	return Timelock{}
+}
+
+// QueryAllTimelockRequest defines the QueryAllTimelockRequest message.
+type QueryAllTimelockRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllTimelockRequest) Reset()         { *m = QueryAllTimelockRequest{} }
+func (m *QueryAllTimelockRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTimelockRequest) ProtoMessage()    {}
+func (*QueryAllTimelockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{20}
+}
+func (m *QueryAllTimelockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTimelockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTimelockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllTimelockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTimelockRequest.Merge(m, src)
+}
+func (m *QueryAllTimelockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTimelockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTimelockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllTimelockRequest proto.InternalMessageInfo
+
+func (m *QueryAllTimelockRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllTimelockResponse defines the QueryAllTimelockResponse message.
+type QueryAllTimelockResponse struct {
+	Timelock   []Timelock          `protobuf:"bytes,1,rep,name=timelock,proto3" json:"timelock"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllTimelockResponse) Reset()         { *m = QueryAllTimelockResponse{} }
+func (m *QueryAllTimelockResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTimelockResponse) ProtoMessage()    {}
+func (*QueryAllTimelockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{21}
+}
+func (m *QueryAllTimelockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTimelockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTimelockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllTimelockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTimelockResponse.Merge(m, src)
+}
+func (m *QueryAllTimelockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTimelockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTimelockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllTimelockResponse proto.InternalMessageInfo
+
+func (m *QueryAllTimelockResponse) GetTimelock() []Timelock {
+	if m != nil {
+		return m.Timelock
+	}
+	return nil
+}
+
+func (m *QueryAllTimelockResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProposalRoleTallyRequest defines the QueryProposalRoleTallyRequest message.
+type QueryProposalRoleTallyRequest struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryProposalRoleTallyRequest) Reset()         { *m = QueryProposalRoleTallyRequest{} }
+func (m *QueryProposalRoleTallyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalRoleTallyRequest) ProtoMessage()    {}
+func (*QueryProposalRoleTallyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{22}
+}
+func (m *QueryProposalRoleTallyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalRoleTallyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalRoleTallyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalRoleTallyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalRoleTallyRequest.Merge(m, src)
+}
+func (m *QueryProposalRoleTallyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalRoleTallyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalRoleTallyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalRoleTallyRequest proto.InternalMessageInfo
+
+func (m *QueryProposalRoleTallyRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryProposalRoleTallyResponse defines the QueryProposalRoleTallyResponse message.
+type QueryProposalRoleTallyResponse struct {
+	ProposalRoleTally ProposalRoleTally `protobuf:"bytes,1,opt,name=proposal_role_tally,json=proposalRoleTally,proto3" json:"proposal_role_tally"`
+}
+
+func (m *QueryProposalRoleTallyResponse) Reset()         { *m = QueryProposalRoleTallyResponse{} }
+func (m *QueryProposalRoleTallyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalRoleTallyResponse) ProtoMessage()    {}
+func (*QueryProposalRoleTallyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{23}
+}
+func (m *QueryProposalRoleTallyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalRoleTallyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalRoleTallyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalRoleTallyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalRoleTallyResponse.Merge(m, src)
+}
+func (m *QueryProposalRoleTallyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalRoleTallyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalRoleTallyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalRoleTallyResponse proto.InternalMessageInfo
+
+func (m *QueryProposalRoleTallyResponse) GetProposalRoleTally() ProposalRoleTally {
+	if m != nil {
+		return m.ProposalRoleTally
+	}
+	return ProposalRoleTally{}
+}
+
+// QueryTallyPreviewRequest defines the QueryTallyPreviewRequest message.
+type QueryTallyPreviewRequest struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryTallyPreviewRequest) Reset()         { *m = QueryTallyPreviewRequest{} }
+func (m *QueryTallyPreviewRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyPreviewRequest) ProtoMessage()    {}
+func (*QueryTallyPreviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{24}
+}
+func (m *QueryTallyPreviewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTallyPreviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTallyPreviewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTallyPreviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyPreviewRequest.Merge(m, src)
+}
+func (m *QueryTallyPreviewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallyPreviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyPreviewRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTallyPreviewRequest proto.InternalMessageInfo
+
+func (m *QueryTallyPreviewRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryTallyPreviewResponse defines the QueryTallyPreviewResponse message.
+type QueryTallyPreviewResponse struct {
+	// stake_tally counts staked power only, like the default x/gov tally
+	StakeTally TallyPreview `protobuf:"bytes,1,opt,name=stake_tally,json=stakeTally,proto3" json:"stake_tally"`
+	// weighted_tally is the tally x/gov would receive from the voting module
+	WeightedTally TallyPreview `protobuf:"bytes,2,opt,name=weighted_tally,json=weightedTally,proto3" json:"weighted_tally"`
+}
+
+func (m *QueryTallyPreviewResponse) Reset()         { *m = QueryTallyPreviewResponse{} }
+func (m *QueryTallyPreviewResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyPreviewResponse) ProtoMessage()    {}
+func (*QueryTallyPreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{25}
+}
+func (m *QueryTallyPreviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTallyPreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTallyPreviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTallyPreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyPreviewResponse.Merge(m, src)
+}
+func (m *QueryTallyPreviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallyPreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyPreviewResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTallyPreviewResponse proto.InternalMessageInfo
+
+func (m *QueryTallyPreviewResponse) GetStakeTally() TallyPreview {
+	if m != nil {
+		return m.StakeTally
+	}
+	return TallyPreview{}
+}
+
+func (m *QueryTallyPreviewResponse) GetWeightedTally() TallyPreview {
+	if m != nil {
+		return m.WeightedTally
+	}
+	return TallyPreview{}
+}
+
+// QueryRoleChangeImpactRequest defines the QueryRoleChangeImpactRequest message.
+type QueryRoleChangeImpactRequest struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryRoleChangeImpactRequest) Reset()         { *m = QueryRoleChangeImpactRequest{} }
+func (m *QueryRoleChangeImpactRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoleChangeImpactRequest) ProtoMessage()    {}
+func (*QueryRoleChangeImpactRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{26}
+}
+func (m *QueryRoleChangeImpactRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoleChangeImpactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoleChangeImpactRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoleChangeImpactRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoleChangeImpactRequest.Merge(m, src)
+}
+func (m *QueryRoleChangeImpactRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoleChangeImpactRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoleChangeImpactRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoleChangeImpactRequest proto.InternalMessageInfo
+
+func (m *QueryRoleChangeImpactRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryRoleChangeImpactResponse defines the QueryRoleChangeImpactResponse message.
+type QueryRoleChangeImpactResponse struct {
+	Impacts []ProposalImpact `protobuf:"bytes,1,rep,name=impacts,proto3" json:"impacts"`
+}
+
+func (m *QueryRoleChangeImpactResponse) Reset()         { *m = QueryRoleChangeImpactResponse{} }
+func (m *QueryRoleChangeImpactResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoleChangeImpactResponse) ProtoMessage()    {}
+func (*QueryRoleChangeImpactResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{27}
+}
+func (m *QueryRoleChangeImpactResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoleChangeImpactResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoleChangeImpactResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoleChangeImpactResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoleChangeImpactResponse.Merge(m, src)
+}
+func (m *QueryRoleChangeImpactResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoleChangeImpactResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoleChangeImpactResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoleChangeImpactResponse proto.InternalMessageInfo
+
+func (m *QueryRoleChangeImpactResponse) GetImpacts() []ProposalImpact {
+	if m != nil {
+		return m.Impacts
+	}
+	return nil
+}
+
+// QueryParticipationRequest defines the QueryParticipationRequest message.
+type QueryParticipationRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryParticipationRequest) Reset()         { *m = QueryParticipationRequest{} }
+func (m *QueryParticipationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipationRequest) ProtoMessage()    {}
+func (*QueryParticipationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{28}
+}
+func (m *QueryParticipationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParticipationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipationRequest.Merge(m, src)
+}
+func (m *QueryParticipationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParticipationRequest proto.InternalMessageInfo
+
+func (m *QueryParticipationRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryParticipationResponse defines the QueryParticipationResponse message.
+type QueryParticipationResponse struct {
+	Participation Participation `protobuf:"bytes,1,opt,name=participation,proto3" json:"participation"`
+	// rate is the share of the participation window the address voted on
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *QueryParticipationResponse) Reset()         { *m = QueryParticipationResponse{} }
+func (m *QueryParticipationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipationResponse) ProtoMessage()    {}
+func (*QueryParticipationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{29}
+}
+func (m *QueryParticipationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParticipationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipationResponse.Merge(m, src)
+}
+func (m *QueryParticipationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParticipationResponse proto.InternalMessageInfo
+
+func (m *QueryParticipationResponse) GetParticipation() Participation {
+	if m != nil {
+		return m.Participation
+	}
+	return Participation{}
+}
+
+// QueryGetNominationRequest defines the QueryGetNominationRequest message.
+type QueryGetNominationRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetNominationRequest) Reset()         { *m = QueryGetNominationRequest{} }
+func (m *QueryGetNominationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetNominationRequest) ProtoMessage()    {}
+func (*QueryGetNominationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{30}
+}
+func (m *QueryGetNominationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetNominationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetNominationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetNominationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetNominationRequest.Merge(m, src)
+}
+func (m *QueryGetNominationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetNominationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetNominationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetNominationRequest proto.InternalMessageInfo
+
+func (m *QueryGetNominationRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryGetNominationResponse defines the QueryGetNominationResponse message.
+type QueryGetNominationResponse struct {
+	Nomination Nomination `protobuf:"bytes,1,opt,name=nomination,proto3" json:"nomination"`
+}
+
+func (m *QueryGetNominationResponse) Reset()         { *m = QueryGetNominationResponse{} }
+func (m *QueryGetNominationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetNominationResponse) ProtoMessage()    {}
+func (*QueryGetNominationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{31}
+}
+func (m *QueryGetNominationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetNominationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetNominationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetNominationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetNominationResponse.Merge(m, src)
+}
+func (m *QueryGetNominationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetNominationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetNominationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetNominationResponse proto.InternalMessageInfo
+
+func (m *QueryGetNominationResponse) GetNomination() Nomination {
+	if m != nil {
+		return m.Nomination
+	}
+	return Nomination{}
+}
+
+// QueryAllNominationRequest defines the QueryAllNominationRequest message.
+type QueryAllNominationRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllNominationRequest) Reset()         { *m = QueryAllNominationRequest{} }
+func (m *QueryAllNominationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllNominationRequest) ProtoMessage()    {}
+func (*QueryAllNominationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{32}
+}
+func (m *QueryAllNominationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllNominationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllNominationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllNominationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllNominationRequest.Merge(m, src)
+}
+func (m *QueryAllNominationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllNominationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllNominationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllNominationRequest proto.InternalMessageInfo
+
+func (m *QueryAllNominationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllNominationResponse defines the QueryAllNominationResponse message.
+type QueryAllNominationResponse struct {
+	Nomination []Nomination        `protobuf:"bytes,1,rep,name=nomination,proto3" json:"nomination"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllNominationResponse) Reset()         { *m = QueryAllNominationResponse{} }
+func (m *QueryAllNominationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllNominationResponse) ProtoMessage()    {}
+func (*QueryAllNominationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{33}
+}
+func (m *QueryAllNominationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllNominationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllNominationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllNominationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllNominationResponse.Merge(m, src)
+}
+func (m *QueryAllNominationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllNominationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllNominationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllNominationResponse proto.InternalMessageInfo
+
+func (m *QueryAllNominationResponse) GetNomination() []Nomination {
+	if m != nil {
+		return m.Nomination
+	}
+	return nil
+}
+
+func (m *QueryAllNominationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetRoleDistributionRequest defines the QueryGetRoleDistributionRequest message.
+type QueryGetRoleDistributionRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetRoleDistributionRequest) Reset()         { *m = QueryGetRoleDistributionRequest{} }
+func (m *QueryGetRoleDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRoleDistributionRequest) ProtoMessage()    {}
+func (*QueryGetRoleDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{34}
+}
+func (m *QueryGetRoleDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRoleDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRoleDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRoleDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRoleDistributionRequest.Merge(m, src)
+}
+func (m *QueryGetRoleDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRoleDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRoleDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRoleDistributionRequest proto.InternalMessageInfo
+
+func (m *QueryGetRoleDistributionRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryGetRoleDistributionResponse defines the QueryGetRoleDistributionResponse message.
+type QueryGetRoleDistributionResponse struct {
+	RoleDistribution RoleDistribution `protobuf:"bytes,1,opt,name=role_distribution,json=roleDistribution,proto3" json:"role_distribution"`
+}
+
+func (m *QueryGetRoleDistributionResponse) Reset()         { *m = QueryGetRoleDistributionResponse{} }
+func (m *QueryGetRoleDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRoleDistributionResponse) ProtoMessage()    {}
+func (*QueryGetRoleDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{35}
+}
+func (m *QueryGetRoleDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRoleDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRoleDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRoleDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRoleDistributionResponse.Merge(m, src)
+}
+func (m *QueryGetRoleDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRoleDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRoleDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRoleDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryGetRoleDistributionResponse) GetRoleDistribution() RoleDistribution {
+	if m != nil {
+		return m.RoleDistribution
+	}
+	return RoleDistribution{}
+}
+
+// QueryAllRoleDistributionRequest defines the QueryAllRoleDistributionRequest message.
+type QueryAllRoleDistributionRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRoleDistributionRequest) Reset()         { *m = QueryAllRoleDistributionRequest{} }
+func (m *QueryAllRoleDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRoleDistributionRequest) ProtoMessage()    {}
+func (*QueryAllRoleDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{36}
+}
+func (m *QueryAllRoleDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRoleDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRoleDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRoleDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRoleDistributionRequest.Merge(m, src)
+}
+func (m *QueryAllRoleDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRoleDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRoleDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRoleDistributionRequest proto.InternalMessageInfo
+
+func (m *QueryAllRoleDistributionRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllRoleDistributionResponse defines the QueryAllRoleDistributionResponse message.
+type QueryAllRoleDistributionResponse struct {
+	RoleDistribution []RoleDistribution  `protobuf:"bytes,1,rep,name=role_distribution,json=roleDistribution,proto3" json:"role_distribution"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRoleDistributionResponse) Reset()         { *m = QueryAllRoleDistributionResponse{} }
+func (m *QueryAllRoleDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRoleDistributionResponse) ProtoMessage()    {}
+func (*QueryAllRoleDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{37}
+}
+func (m *QueryAllRoleDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRoleDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRoleDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRoleDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRoleDistributionResponse.Merge(m, src)
+}
+func (m *QueryAllRoleDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRoleDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRoleDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRoleDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryAllRoleDistributionResponse) GetRoleDistribution() []RoleDistribution {
+	if m != nil {
+		return m.RoleDistribution
+	}
+	return nil
+}
+
+func (m *QueryAllRoleDistributionResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRoleClaimedRequest defines the QueryRoleClaimedRequest message.
+type QueryRoleClaimedRequest struct {
+	DistributionId uint64 `protobuf:"varint,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+	Address        string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryRoleClaimedRequest) Reset()         { *m = QueryRoleClaimedRequest{} }
+func (m *QueryRoleClaimedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoleClaimedRequest) ProtoMessage()    {}
+func (*QueryRoleClaimedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{38}
+}
+func (m *QueryRoleClaimedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoleClaimedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoleClaimedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoleClaimedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoleClaimedRequest.Merge(m, src)
+}
+func (m *QueryRoleClaimedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoleClaimedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoleClaimedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoleClaimedRequest proto.InternalMessageInfo
+
+func (m *QueryRoleClaimedRequest) GetDistributionId() uint64 {
+	if m != nil {
+		return m.DistributionId
+	}
+	return 0
+}
+
+func (m *QueryRoleClaimedRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryRoleClaimedResponse defines the QueryRoleClaimedResponse message.
+type QueryRoleClaimedResponse struct {
+	Claimed bool `protobuf:"varint,1,opt,name=claimed,proto3" json:"claimed,omitempty"`
+}
+
+func (m *QueryRoleClaimedResponse) Reset()         { *m = QueryRoleClaimedResponse{} }
+func (m *QueryRoleClaimedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoleClaimedResponse) ProtoMessage()    {}
+func (*QueryRoleClaimedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ee4582cc4035b6, []int{39}
+}
+func (m *QueryRoleClaimedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoleClaimedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoleClaimedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoleClaimedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoleClaimedResponse.Merge(m, src)
+}
+func (m *QueryRoleClaimedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoleClaimedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoleClaimedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoleClaimedResponse proto.InternalMessageInfo
+
+func (m *QueryRoleClaimedResponse) GetClaimed() bool {
+	if m != nil {
+		return m.Claimed
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetVoterRoleRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryGetVoterRoleRequest")
+	proto.RegisterType((*QueryGetVoterRoleResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryGetVoterRoleResponse")
+	proto.RegisterType((*QueryAllVoterRoleRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryAllVoterRoleRequest")
+	proto.RegisterType((*QueryAllVoterRoleResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryAllVoterRoleResponse")
+	proto.RegisterType((*QueryVoteDelegationFromRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryVoteDelegationFromRequest")
+	proto.RegisterType((*QueryVoteDelegationFromResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryVoteDelegationFromResponse")
+	proto.RegisterType((*QueryVoteDelegationsToRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryVoteDelegationsToRequest")
+	proto.RegisterType((*QueryVoteDelegationsToResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryVoteDelegationsToResponse")
+	proto.RegisterType((*QueryVotingPowerRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryVotingPowerRequest")
+	proto.RegisterType((*QueryVotingPowerResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryVotingPowerResponse")
+	proto.RegisterType((*QueryEffectiveMultiplierRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryEffectiveMultiplierRequest")
+	proto.RegisterType((*QueryEffectiveMultiplierResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryEffectiveMultiplierResponse")
+	proto.RegisterType((*QueryChamberTallyRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryChamberTallyRequest")
+	proto.RegisterType((*QueryChamberTallyResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryChamberTallyResponse")
+	proto.RegisterType((*QueryTallyBreakdownRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryTallyBreakdownRequest")
+	proto.RegisterType((*QueryTallyBreakdownResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryTallyBreakdownResponse")
+	proto.RegisterType((*QueryGetTimelockRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryGetTimelockRequest")
+	proto.RegisterType((*QueryGetTimelockResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryGetTimelockResponse")
+	proto.RegisterType((*QueryAllTimelockRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryAllTimelockRequest")
+	proto.RegisterType((*QueryAllTimelockResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryAllTimelockResponse")
+	proto.RegisterType((*QueryProposalRoleTallyRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryProposalRoleTallyRequest")
+	proto.RegisterType((*QueryProposalRoleTallyResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryProposalRoleTallyResponse")
+	proto.RegisterType((*QueryTallyPreviewRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryTallyPreviewRequest")
+	proto.RegisterType((*QueryTallyPreviewResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryTallyPreviewResponse")
+	proto.RegisterType((*QueryRoleChangeImpactRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryRoleChangeImpactRequest")
+	proto.RegisterType((*QueryRoleChangeImpactResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryRoleChangeImpactResponse")
+	proto.RegisterType((*QueryParticipationRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryParticipationRequest")
+	proto.RegisterType((*QueryParticipationResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryParticipationResponse")
+	proto.RegisterType((*QueryGetNominationRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryGetNominationRequest")
+	proto.RegisterType((*QueryGetNominationResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryGetNominationResponse")
+	proto.RegisterType((*QueryAllNominationRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryAllNominationRequest")
+	proto.RegisterType((*QueryAllNominationResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryAllNominationResponse")
+	proto.RegisterType((*QueryGetRoleDistributionRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryGetRoleDistributionRequest")
+	proto.RegisterType((*QueryGetRoleDistributionResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryGetRoleDistributionResponse")
+	proto.RegisterType((*QueryAllRoleDistributionRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryAllRoleDistributionRequest")
+	proto.RegisterType((*QueryAllRoleDistributionResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryAllRoleDistributionResponse")
+	proto.RegisterType((*QueryRoleClaimedRequest)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryRoleClaimedRequest")
+	proto.RegisterType((*QueryRoleClaimedResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.QueryRoleClaimedResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmosweightedgovernancesdk/voting/v1/query.proto", fileDescriptor_e2ee4582cc4035b6)
+}
+
+var fileDescriptor_e2ee4582cc4035b6 = []byte{
+	// 2097 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x4b, 0x6f, 0x1c, 0x59,
+	0x15, 0x4e, 0xb5, 0x3d, 0x93, 0xf8, 0xd8, 0x6e, 0xc7, 0x37, 0x91, 0xf0, 0xf4, 0x04, 0x27, 0x2a,
+	0xc4, 0x64, 0x14, 0xe4, 0x2e, 0x3a, 0xaf, 0x49, 0x32, 0x4a, 0xfc, 0x88, 0x1f, 0x24, 0xca, 0x0c,
+	0x4e, 0xe3, 0xf0, 0x08, 0x51, 0x7a, 0xca, 0x5d, 0xd7, 0xe5, 0xc2, 0xf5, 0x4a, 0x55, 0x75, 0x1b,
+	0xcb, 0xf2, 0x06, 0x16, 0xec, 0x60, 0x24, 0xfe, 0x00, 0x4b, 0x96, 0x2c, 0xd0, 0x6c, 0x78, 0xec,
+	0x90, 0xb2, 0x41, 0xca, 0xf0, 0x10, 0x0f, 0xc1, 0x08, 0x25, 0x20, 0xc4, 0x02, 0x16, 0xc0, 0x0f,
+	0x40, 0x75, 0xef, 0xa9, 0xae, 0x47, 0x57, 0x27, 0x75, 0xab, 0x9b, 0x4d, 0x94, 0x3a, 0xdd, 0xe7,
+	0xbb, 0xe7, 0x3b, 0xf7, 0xd4, 0xb9, 0xe7, 0x7e, 0x6d, 0x68, 0xb4, 0x1d, 0xdf, 0x72, 0xfc, 0x7d,
+	0x6a, 0xe8, 0xbb, 0x01, 0xd5, 0x74, 0xa7, 0x4b, 0x3d, 0x5b, 0xb5, 0xdb, 0xd4, 0xd7, 0xf6, 0x94,
+	0xae, 0x13, 0x18, 0xb6, 0xae, 0x74, 0x1b, 0xca, 0x93, 0x0e, 0xf5, 0x0e, 0xea, 0xae, 0xe7, 0x04,
+	0x0e, 0xf9, 0xec, 0x4b, 0x5c, 0xea, 0xdc, 0xa5, 0xde, 0x6d, 0xd4, 0x66, 0x55, 0xcb, 0xb0, 0x1d,
+	0x85, 0xfd, 0xcb, 0x3d, 0x6b, 0x6f, 0x70, 0xcf, 0x16, 0x7b, 0x52, 0xf8, 0x03, 0x7e, 0x74, 0x81,
+	0x3f, 0x29, 0xdb, 0xaa, 0x4f, 0xf9, 0x6a, 0x4a, 0xb7, 0xb1, 0x4d, 0x03, 0xb5, 0xa1, 0xb8, 0xaa,
+	0x6e, 0xd8, 0x6a, 0x60, 0x38, 0x36, 0x7e, 0xf7, 0x7a, 0xb1, 0x98, 0xdb, 0xbb, 0xaa, 0xb5, 0x4d,
+	0xbd, 0x56, 0xa0, 0x9a, 0x26, 0xc6, 0x5e, 0xbb, 0x5a, 0xcc, 0xd5, 0x76, 0xac, 0xf4, 0x92, 0x17,
+	0x8b, 0xf9, 0xb9, 0xaa, 0xa7, 0x5a, 0xbe, 0x58, 0x98, 0xae, 0xea, 0x05, 0x46, 0xdb, 0x70, 0x93,
+	0xcb, 0x2d, 0x16, 0x74, 0xf5, 0x1c, 0xd7, 0xf1, 0x55, 0xb3, 0xe5, 0x39, 0x26, 0x4d, 0xf1, 0xbc,
+	0x59, 0x0c, 0x80, 0xf9, 0x69, 0x86, 0x1f, 0x78, 0xc6, 0x76, 0x27, 0xb1, 0xfe, 0x55, 0x01, 0xf7,
+	0xe4, 0xb2, 0x05, 0x29, 0x33, 0x97, 0x96, 0xeb, 0xd1, 0xae, 0x41, 0xf7, 0xd1, 0xf5, 0x72, 0x41,
+	0x57, 0xc3, 0xa2, 0xa6, 0xd3, 0xde, 0x43, 0xaf, 0x77, 0x8b, 0x79, 0x75, 0x9d, 0x80, 0xb6, 0x34,
+	0x6a, 0x52, 0x5d, 0x15, 0x67, 0x19, 0x3a, 0x7b, 0x2c, 0xc5, 0xe8, 0x77, 0x5a, 0x77, 0x74, 0x87,
+	0xd7, 0x70, 0xf8, 0x3f, 0xb4, 0x9e, 0xd1, 0x1d, 0x47, 0x37, 0xa9, 0xa2, 0xba, 0x86, 0xa2, 0xda,
+	0xb6, 0x13, 0xb0, 0xa5, 0xb0, 0x18, 0xe4, 0xd3, 0x40, 0xee, 0x87, 0x55, 0xbd, 0xc9, 0x2a, 0xa4,
+	0x49, 0x9f, 0x74, 0xa8, 0x1f, 0xc8, 0x3a, 0x9c, 0x4a, 0x59, 0x7d, 0xd7, 0xb1, 0x7d, 0x4a, 0x36,
+	0xe1, 0x75, 0x5e, 0x49, 0x73, 0xd2, 0x39, 0xe9, 0xed, 0xc9, 0x8b, 0x0b, 0xf5, 0x42, 0xaf, 0x5c,
+	0x9d, 0xc3, 0xac, 0x4c, 0x3c, 0xfd, 0xe4, 0xec, 0xb1, 0x1f, 0xfe, 0xfd, 0x47, 0x17, 0xa4, 0x26,
+	0xe2, 0xc8, 0x17, 0x60, 0x8e, 0x2d, 0xb4, 0x41, 0x83, 0x2f, 0x87, 0x74, 0x9a, 0x8e, 0x49, 0x31,
+	0x08, 0x52, 0x85, 0x8a, 0xa1, 0xb1, 0x95, 0xc6, 0x9b, 0x15, 0x43, 0x93, 0x3d, 0x78, 0x23, 0xe7,
+	0xbb, 0x18, 0xda, 0x03, 0x80, 0x38, 0x1f, 0x18, 0xde, 0xe7, 0x0b, 0x86, 0xd7, 0x43, 0x5b, 0x19,
+	0x0f, 0x23, 0x6c, 0x4e, 0x74, 0x23, 0x83, 0xbc, 0x8d, 0xf1, 0x2d, 0x9b, 0x66, 0x5f, 0x7c, 0xeb,
+	0x00, 0x71, 0x0b, 0xc0, 0x25, 0xdf, 0xc2, 0x25, 0xeb, 0x61, 0xbf, 0xa8, 0xf3, 0xee, 0x84, 0xfd,
+	0xa2, 0xbe, 0xa9, 0xea, 0x91, 0x6f, 0x33, 0xe1, 0x29, 0xff, 0x44, 0x42, 0x62, 0xe9, 0x45, 0x06,
+	0x10, 0x1b, 0x1b, 0x09, 0x31, 0xb2, 0x91, 0x0a, 0xbe, 0xc2, 0x82, 0x3f, 0xff, 0xca, 0xe0, 0x79,
+	0x4c, 0xa9, 0xe8, 0x6f, 0xc1, 0x3c, 0x0b, 0x3e, 0x5c, 0x6b, 0xb5, 0x57, 0xc9, 0xeb, 0x9e, 0x63,
+	0x45, 0x79, 0x3a, 0x03, 0x13, 0x58, 0xe2, 0x8e, 0xc7, 0xd2, 0x34, 0xd1, 0x8c, 0x0d, 0xf2, 0x77,
+	0x24, 0x38, 0x3b, 0x10, 0x00, 0x73, 0xa0, 0xc1, 0x4c, 0xe6, 0x4d, 0xc1, 0x74, 0x5f, 0x11, 0x48,
+	0x44, 0x8c, 0x8d, 0xd9, 0xa8, 0x76, 0x53, 0x56, 0xf9, 0x7b, 0x12, 0x7c, 0x3a, 0x27, 0x12, 0x7f,
+	0xcb, 0x89, 0x98, 0xbc, 0x05, 0x55, 0x8f, 0xba, 0x1e, 0xf5, 0xa9, 0x1d, 0xbe, 0x45, 0x5d, 0x8a,
+	0x74, 0x32, 0xd6, 0x4c, 0x65, 0x54, 0x4a, 0x57, 0xc6, 0xc7, 0x52, 0x6e, 0x72, 0x59, 0x44, 0x98,
+	0x9a, 0x1d, 0x38, 0x99, 0x49, 0x8d, 0x8f, 0x45, 0x32, 0x54, 0x6e, 0x66, 0xd2, 0xb9, 0xf1, 0x47,
+	0x57, 0x2f, 0x97, 0xe0, 0x53, 0x11, 0x25, 0xc3, 0xd6, 0x37, 0x9d, 0x7d, 0xea, 0x45, 0xe9, 0x9d,
+	0x83, 0xe3, 0xaa, 0xa6, 0x79, 0xd4, 0xf7, 0x31, 0xaf, 0xd1, 0xa3, 0xfc, 0x83, 0x31, 0x7c, 0x0f,
+	0x53, 0x5e, 0x98, 0x82, 0xf7, 0x61, 0xc2, 0x53, 0xf7, 0x5b, 0x6e, 0x68, 0xe4, 0x8e, 0x2b, 0x8d,
+	0x90, 0xc4, 0x1f, 0x3f, 0x39, 0xfb, 0x26, 0x0f, 0x30, 0x24, 0x6c, 0x38, 0x8a, 0xa5, 0x06, 0xbb,
+	0xf5, 0x7b, 0x54, 0x57, 0xdb, 0x07, 0xab, 0xb4, 0xfd, 0xab, 0x1f, 0x2f, 0x00, 0xc6, 0xbf, 0x4a,
+	0xdb, 0xcd, 0x13, 0x9e, 0xba, 0xcf, 0x70, 0xc9, 0x63, 0x98, 0x0d, 0x3c, 0xd5, 0xf6, 0x77, 0x1c,
+	0xcf, 0xa2, 0x1a, 0xe2, 0x56, 0xca, 0xe2, 0x9e, 0x4c, 0x60, 0x71, 0xfc, 0xfb, 0x00, 0x56, 0xc7,
+	0x0c, 0x0c, 0xd7, 0x34, 0xa8, 0x37, 0x37, 0x56, 0x16, 0x38, 0x01, 0x42, 0xbe, 0x0a, 0xd5, 0x68,
+	0x9b, 0x31, 0xde, 0xf1, 0xb2, 0xb0, 0xd3, 0x11, 0x10, 0x0f, 0xf6, 0x3c, 0xcc, 0x30, 0xc0, 0x56,
+	0x8f, 0xc6, 0xdc, 0x6b, 0xbc, 0xe6, 0x99, 0x79, 0x2b, 0xb2, 0xca, 0x8f, 0xf0, 0x35, 0x5e, 0xdb,
+	0xd9, 0xa1, 0xed, 0xf0, 0x2d, 0x78, 0xaf, 0x17, 0xde, 0x2b, 0xf7, 0x97, 0x9c, 0x85, 0xc9, 0xde,
+	0xcc, 0x60, 0x68, 0x2c, 0xd9, 0xe3, 0x4d, 0x88, 0x4c, 0x77, 0x34, 0xf9, 0x3f, 0x12, 0x9c, 0x1b,
+	0x0c, 0x8f, 0x85, 0x90, 0x4e, 0xac, 0x34, 0x8a, 0xc4, 0x3e, 0x84, 0x99, 0xb0, 0xb8, 0x5b, 0x09,
+	0xdc, 0xd2, 0x95, 0x50, 0x0d, 0x91, 0xe2, 0xb0, 0xc9, 0x39, 0x98, 0xb2, 0x7c, 0xbd, 0x15, 0x1c,
+	0xb8, 0xb4, 0xd5, 0xf1, 0x4c, 0x5e, 0x09, 0x4d, 0xb0, 0x7c, 0x7d, 0xeb, 0xc0, 0xa5, 0x0f, 0x3c,
+	0x53, 0x7e, 0x17, 0xab, 0xfe, 0x36, 0x9f, 0x18, 0xb7, 0xc2, 0xf1, 0x24, 0x4a, 0x66, 0x26, 0x65,
+	0x52, 0x5f, 0xca, 0x0e, 0xf1, 0x54, 0x49, 0x3b, 0x63, 0xaa, 0x1e, 0xc3, 0x74, 0x6a, 0x0c, 0xc5,
+	0x7e, 0x7a, 0xa9, 0x60, 0xcf, 0x48, 0x62, 0x62, 0xc7, 0x98, 0x6a, 0x27, 0x6c, 0xf2, 0x4d, 0xa8,
+	0xb1, 0xc5, 0xf9, 0x37, 0x3c, 0xaa, 0xee, 0x69, 0xce, 0xbe, 0x5d, 0x38, 0xf6, 0x9f, 0x4a, 0xf0,
+	0x66, 0xae, 0x3f, 0x86, 0xff, 0x35, 0x98, 0xea, 0xcd, 0x78, 0x06, 0xf5, 0x05, 0x8f, 0xc5, 0xf0,
+	0x00, 0x4c, 0x86, 0x3e, 0xe9, 0xa1, 0xc1, 0xa0, 0x3e, 0x79, 0x1b, 0x4e, 0x32, 0xe8, 0x27, 0x1d,
+	0xc7, 0xeb, 0x58, 0x7e, 0xcb, 0xa2, 0x01, 0xdb, 0xf2, 0x13, 0xcd, 0x6a, 0x68, 0xbf, 0xcf, 0xcd,
+	0xef, 0x51, 0x56, 0xce, 0x1e, 0x6d, 0x77, 0x7c, 0xaa, 0xcd, 0x8d, 0x9d, 0x1b, 0x0b, 0xcb, 0x19,
+	0x1f, 0xe5, 0x1b, 0xd8, 0xe3, 0x36, 0x68, 0xb0, 0x85, 0x73, 0x61, 0x61, 0xea, 0x56, 0x3c, 0x11,
+	0xc5, 0xbe, 0xbd, 0x02, 0x3f, 0x11, 0xcd, 0x99, 0xb8, 0x61, 0x4a, 0x41, 0xca, 0x11, 0x14, 0x32,
+	0xee, 0xc1, 0xc8, 0x2a, 0x86, 0xba, 0x6c, 0x9a, 0xd9, 0x50, 0x47, 0x35, 0xdf, 0x7c, 0x24, 0xc5,
+	0x43, 0xd4, 0x2b, 0x28, 0x8d, 0x8d, 0x80, 0xd2, 0xe8, 0x8e, 0xaa, 0x25, 0x9c, 0x07, 0x36, 0x71,
+	0x77, 0x7a, 0x75, 0x53, 0x78, 0x33, 0x3f, 0x8c, 0x0e, 0xf0, 0x1c, 0x08, 0x4c, 0x80, 0x0d, 0xa7,
+	0x72, 0xae, 0x4b, 0x98, 0xee, 0x6b, 0x45, 0x07, 0xec, 0x2c, 0x3c, 0x26, 0x65, 0xd6, 0xcd, 0x7e,
+	0xd0, 0xeb, 0x29, 0xec, 0x69, 0x93, 0x5f, 0x75, 0x0a, 0xf3, 0xf9, 0x43, 0x34, 0xaa, 0xa6, 0xbd,
+	0x91, 0xca, 0x43, 0x98, 0xf4, 0x03, 0x75, 0x8f, 0x96, 0x6a, 0x29, 0x49, 0x44, 0x8c, 0x1e, 0x18,
+	0x1a, 0xfb, 0x80, 0x7c, 0x90, 0x38, 0xe1, 0x38, 0x7c, 0x65, 0x58, 0xf8, 0xde, 0x49, 0xc7, 0x13,
+	0xb3, 0x08, 0x67, 0x18, 0xb5, 0x30, 0x55, 0xb7, 0x77, 0x55, 0x5b, 0xa7, 0x77, 0x2c, 0x57, 0x6d,
+	0x07, 0x85, 0x93, 0xd3, 0xc5, 0x72, 0xe9, 0x07, 0xe8, 0x8d, 0xf2, 0xc7, 0x0d, 0x66, 0x11, 0x1d,
+	0xd1, 0xa2, 0xed, 0xe5, 0x78, 0x18, 0x7e, 0x84, 0x25, 0x5f, 0xc1, 0x3d, 0xd9, 0x4c, 0x5e, 0xd8,
+	0x5f, 0x3d, 0x53, 0xfd, 0x42, 0xc2, 0x1e, 0x9d, 0xf1, 0xc3, 0x60, 0x3f, 0x80, 0xe9, 0x94, 0x02,
+	0x80, 0xdb, 0x79, 0xb9, 0xf8, 0x95, 0x2f, 0xf6, 0x8d, 0x12, 0x9e, 0x02, 0x24, 0x6b, 0x30, 0xee,
+	0xa9, 0x01, 0x2d, 0x7f, 0xa0, 0x32, 0x77, 0xf9, 0x73, 0xf1, 0xb5, 0xf0, 0xfd, 0x9e, 0x3c, 0x32,
+	0xe8, 0x0e, 0xd9, 0x41, 0xce, 0x99, 0x2f, 0x23, 0xe7, 0xaf, 0x00, 0xc4, 0x0a, 0x0b, 0x12, 0x6e,
+	0x14, 0x24, 0x1c, 0xc3, 0x45, 0xd5, 0x1b, 0x43, 0xc9, 0xed, 0xf8, 0x86, 0xd7, 0x1f, 0xe3, 0xa8,
+	0xfa, 0xec, 0xcf, 0xa3, 0x0d, 0xcd, 0xac, 0x32, 0x80, 0xdc, 0xd8, 0x88, 0xc8, 0x8d, 0xae, 0xdf,
+	0x36, 0x70, 0x84, 0xdc, 0xa0, 0x41, 0xf8, 0x0e, 0xad, 0x26, 0xf4, 0x9f, 0x41, 0xfb, 0xf9, 0xdd,
+	0x68, 0x2e, 0xcc, 0xf5, 0x41, 0xe6, 0xdf, 0x80, 0xd9, 0x3e, 0x41, 0x09, 0xf3, 0xfc, 0x8e, 0xc0,
+	0xc8, 0x90, 0xc4, 0xc6, 0x34, 0xb0, 0x51, 0x21, 0x69, 0x97, 0x0d, 0xe4, 0xb0, 0x6c, 0x9a, 0x83,
+	0x38, 0x8c, 0x6a, 0xbf, 0x7f, 0x17, 0x71, 0xcf, 0x5d, 0xeb, 0xe5, 0xdc, 0xc7, 0xfe, 0x0f, 0xdc,
+	0x47, 0x57, 0x08, 0x8f, 0x70, 0x28, 0x61, 0x9d, 0xd4, 0x54, 0x0d, 0x8b, 0x6a, 0x51, 0xf2, 0xce,
+	0xc3, 0x4c, 0x92, 0x4a, 0xdc, 0x89, 0xab, 0x49, 0xf3, 0x1d, 0x2d, 0xd9, 0xf8, 0x2a, 0xe9, 0xc6,
+	0x77, 0x19, 0x4f, 0xc0, 0x14, 0x3a, 0xa6, 0x6b, 0x0e, 0x8e, 0xb7, 0xb9, 0x89, 0xc1, 0x9e, 0x68,
+	0x46, 0x8f, 0x17, 0x7f, 0xf6, 0x19, 0x78, 0x8d, 0xb9, 0x91, 0x8f, 0x24, 0x78, 0x9d, 0x2b, 0x5a,
+	0xe4, 0x7a, 0xc1, 0x14, 0xf6, 0x4b, 0x6c, 0xb5, 0x1b, 0x65, 0x5c, 0x79, 0x94, 0xf2, 0x95, 0x6f,
+	0xfd, 0xfa, 0xaf, 0xdf, 0xaf, 0x28, 0x64, 0x41, 0xa1, 0xf6, 0x6e, 0xe8, 0xa2, 0x2d, 0xc4, 0xee,
+	0x0b, 0xe1, 0xa9, 0xc9, 0x24, 0xc2, 0x8c, 0xfc, 0x4b, 0x3e, 0x96, 0x60, 0x2a, 0x29, 0x9e, 0x91,
+	0x45, 0x91, 0x18, 0x72, 0x24, 0xba, 0xda, 0x52, 0x79, 0x00, 0xa4, 0x72, 0x8b, 0x51, 0xb9, 0x46,
+	0xae, 0x16, 0xa4, 0x12, 0x6b, 0x61, 0xca, 0xa1, 0xa1, 0x1d, 0x91, 0x5f, 0x4a, 0x30, 0x7d, 0xcf,
+	0xf0, 0xcb, 0x92, 0xca, 0xd1, 0xf5, 0xc4, 0x48, 0xe5, 0x69, 0x76, 0xf2, 0x75, 0x46, 0xea, 0x12,
+	0x69, 0x08, 0x93, 0x22, 0xff, 0x95, 0x80, 0xf4, 0x2b, 0x61, 0x64, 0x4d, 0x24, 0xa6, 0x81, 0x52,
+	0x5c, 0x6d, 0x7d, 0x58, 0x18, 0x24, 0xf8, 0x45, 0x46, 0xf0, 0x0e, 0xd9, 0x10, 0x20, 0x98, 0x90,
+	0xa8, 0x94, 0x1d, 0xcf, 0xb1, 0x94, 0xc3, 0x9e, 0x08, 0x78, 0x14, 0xd2, 0x9e, 0xed, 0x13, 0xb9,
+	0xc8, 0x6a, 0xf9, 0x70, 0x63, 0xd5, 0xae, 0xb6, 0x36, 0x24, 0x0a, 0x72, 0x6e, 0x32, 0xce, 0xf7,
+	0xc8, 0xdd, 0x92, 0x9c, 0x03, 0x47, 0x39, 0x4c, 0xeb, 0x84, 0x47, 0xe4, 0xb7, 0x12, 0x4c, 0x26,
+	0x24, 0x2d, 0x72, 0x4b, 0x30, 0xd4, 0x8c, 0x82, 0x56, 0x5b, 0x2c, 0xed, 0x8f, 0x24, 0xd7, 0x18,
+	0xc9, 0x45, 0x72, 0xb3, 0x38, 0x49, 0xc3, 0xd6, 0xb9, 0xe6, 0xa4, 0x1c, 0x62, 0x87, 0x3d, 0x22,
+	0xdf, 0xae, 0xc0, 0xa9, 0x1c, 0xa5, 0x86, 0x08, 0xd5, 0xdf, 0x60, 0x25, 0xa9, 0xb6, 0x31, 0x34,
+	0x0e, 0xf2, 0xfd, 0x3a, 0xe3, 0xfb, 0x80, 0x7c, 0xa9, 0x20, 0x5f, 0x1a, 0x61, 0x25, 0x14, 0xa1,
+	0x98, 0xb7, 0x72, 0x98, 0xb8, 0x21, 0x1c, 0x91, 0x3f, 0x4b, 0x30, 0x95, 0x54, 0x4a, 0xc4, 0x5a,
+	0x53, 0x8e, 0xe8, 0x23, 0xd6, 0x9a, 0xf2, 0x84, 0x1f, 0xf9, 0x2e, 0x23, 0xbc, 0x4a, 0x56, 0x0a,
+	0x12, 0x4e, 0xa9, 0x44, 0x19, 0x7e, 0x7f, 0x93, 0xa0, 0x9a, 0x16, 0x68, 0xc8, 0xb2, 0x48, 0x80,
+	0xb9, 0xe2, 0x50, 0x6d, 0x65, 0x18, 0x08, 0x64, 0x79, 0x8f, 0xb1, 0x5c, 0x27, 0xab, 0x05, 0x59,
+	0xf2, 0x1f, 0xfe, 0xb6, 0x23, 0x9c, 0x0c, 0xcf, 0xf0, 0x2d, 0x4d, 0xc8, 0x31, 0x62, 0x6f, 0x69,
+	0xbf, 0x06, 0x54, 0x5b, 0x2c, 0xed, 0x5f, 0xf2, 0x2d, 0x8d, 0xa4, 0x91, 0x0c, 0xaf, 0xa7, 0x12,
+	0x4c, 0x85, 0x67, 0x67, 0x39, 0x62, 0xfd, 0x8a, 0x51, 0x6d, 0xb1, 0xb4, 0x3f, 0x12, 0x7b, 0x87,
+	0x11, 0x6b, 0x10, 0x45, 0x90, 0x18, 0xf9, 0xb7, 0x04, 0xb3, 0x7d, 0x22, 0x88, 0xd8, 0xf9, 0x31,
+	0x48, 0xe5, 0x11, 0x3b, 0x3f, 0x06, 0x0a, 0x3d, 0xf2, 0x26, 0xe3, 0x76, 0x97, 0x7c, 0xa1, 0xe8,
+	0xd0, 0xd6, 0xaf, 0x0a, 0xe5, 0xf4, 0x97, 0xa4, 0xae, 0x21, 0xd6, 0x5f, 0x72, 0x04, 0x20, 0xb1,
+	0xfe, 0x92, 0xa7, 0x01, 0x09, 0xf7, 0x97, 0xd4, 0x4f, 0xee, 0x19, 0x7e, 0xff, 0x92, 0xe0, 0x64,
+	0x56, 0x4c, 0x21, 0xb7, 0x45, 0x42, 0x1c, 0xa0, 0xe5, 0xd4, 0x56, 0x87, 0x03, 0x29, 0x39, 0x05,
+	0xb1, 0x8d, 0x6c, 0x33, 0xa4, 0x16, 0x17, 0x6f, 0x32, 0x84, 0xff, 0x24, 0xc1, 0x74, 0x4a, 0x38,
+	0x21, 0x4b, 0x82, 0xb7, 0x84, 0x3e, 0x01, 0xa8, 0xb6, 0x3c, 0x04, 0x02, 0xf2, 0x5c, 0x67, 0x3c,
+	0x97, 0xc8, 0xad, 0xe2, 0xd7, 0x8d, 0x18, 0x25, 0x31, 0x15, 0xfc, 0x46, 0x82, 0xe9, 0x94, 0xf0,
+	0x42, 0x44, 0xef, 0x0f, 0x7d, 0xe2, 0x89, 0x18, 0xbd, 0x5c, 0xd5, 0x47, 0xf8, 0x0a, 0x12, 0x4b,
+	0x1f, 0xfc, 0x0a, 0xf2, 0x4c, 0x82, 0x6a, 0xd8, 0x46, 0xcb, 0xf2, 0xca, 0x13, 0x85, 0xc4, 0x78,
+	0xe5, 0x0a, 0x3e, 0xc2, 0xb7, 0x90, 0x84, 0xa4, 0xf3, 0x4f, 0x09, 0x4e, 0xe5, 0x28, 0x2a, 0x62,
+	0xf3, 0xdb, 0x60, 0x19, 0x47, 0x6c, 0x7e, 0x7b, 0x89, 0xb4, 0x23, 0x7c, 0x12, 0xf6, 0x69, 0x21,
+	0x7c, 0x0b, 0xff, 0x21, 0xc1, 0xe9, 0x70, 0x0b, 0x87, 0x23, 0x3c, 0x58, 0xf3, 0x11, 0x23, 0xfc,
+	0x12, 0x3d, 0x47, 0x5e, 0x62, 0x84, 0x6f, 0x90, 0x6b, 0x65, 0x09, 0x87, 0x57, 0xad, 0xc9, 0x84,
+	0xf4, 0x21, 0x76, 0xe8, 0xf7, 0x2b, 0x32, 0x62, 0x87, 0x7e, 0x8e, 0xe6, 0x22, 0x6b, 0x8c, 0xd2,
+	0x63, 0xf2, 0xa8, 0xfc, 0x1e, 0x66, 0x24, 0xa1, 0x23, 0x05, 0x55, 0x9b, 0xb8, 0xf9, 0xac, 0x6c,
+	0x3c, 0x7d, 0x3e, 0x2f, 0x3d, 0x7b, 0x3e, 0x2f, 0xfd, 0xe5, 0xf9, 0xbc, 0xf4, 0xe1, 0x8b, 0xf9,
+	0x63, 0xcf, 0x5e, 0xcc, 0x1f, 0xfb, 0xfd, 0x8b, 0xf9, 0x63, 0x0f, 0x17, 0x78, 0xfc, 0x0b, 0x11,
+	0x81, 0xd4, 0xea, 0xda, 0x9e, 0xf2, 0xcd, 0x68, 0xed, 0xe0, 0xc0, 0xa5, 0xfe, 0xf6, 0xeb, 0xec,
+	0x0f, 0xa7, 0x2e, 0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xfc, 0xdf, 0x8e, 0xcd, 0xa2, 0x28, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ListVoterRole Queries a list of VoterRole items.
+	GetVoterRole(ctx context.Context, in *QueryGetVoterRoleRequest, opts ...grpc.CallOption) (*QueryGetVoterRoleResponse, error)
+	// ListVoterRole defines the ListVoterRole RPC.
+	ListVoterRole(ctx context.Context, in *QueryAllVoterRoleRequest, opts ...grpc.CallOption) (*QueryAllVoterRoleResponse, error)
+	// VoteDelegationFrom returns the vote delegation made by an address.
+	VoteDelegationFrom(ctx context.Context, in *QueryVoteDelegationFromRequest, opts ...grpc.CallOption) (*QueryVoteDelegationFromResponse, error)
+	// VoteDelegationsTo lists the vote delegations made to a representative.
+	VoteDelegationsTo(ctx context.Context, in *QueryVoteDelegationsToRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsToResponse, error)
+	// VotingPower shows how the weighted tally derives an address's voting power.
+	VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error)
+	// EffectiveMultiplier resolves the multiplier the tally applies to an address on a proposal.
+	EffectiveMultiplier(ctx context.Context, in *QueryEffectiveMultiplierRequest, opts ...grpc.CallOption) (*QueryEffectiveMultiplierResponse, error)
+	// ChamberTally returns the per-chamber results of a proposal tallied in bicameral mode.
+	ChamberTally(ctx context.Context, in *QueryChamberTallyRequest, opts ...grpc.CallOption) (*QueryChamberTallyResponse, error)
+	// TallyBreakdown shows the participation of each role in an active proposal and which role quorums are unmet.
+	TallyBreakdown(ctx context.Context, in *QueryTallyBreakdownRequest, opts ...grpc.CallOption) (*QueryTallyBreakdownResponse, error)
+	// GetTimelock returns the held messages of a passed proposal during its veto window.
+	GetTimelock(ctx context.Context, in *QueryGetTimelockRequest, opts ...grpc.CallOption) (*QueryGetTimelockResponse, error)
+	// ListTimelock returns all proposals waiting for their veto window to end.
+	ListTimelock(ctx context.Context, in *QueryAllTimelockRequest, opts ...grpc.CallOption) (*QueryAllTimelockResponse, error)
+	// ProposalRoleTally returns how the holders of each role voted on a finished proposal.
+	ProposalRoleTally(ctx context.Context, in *QueryProposalRoleTallyRequest, opts ...grpc.CallOption) (*QueryProposalRoleTallyResponse, error)
+	// TallyPreview returns the stake-only and the role-weighted outcome of an active proposal
+	// if its voting period ended now.
+	TallyPreview(ctx context.Context, in *QueryTallyPreviewRequest, opts ...grpc.CallOption) (*QueryTallyPreviewResponse, error)
+	// RoleChangeImpact applies the messages of an active registry proposal to a cached state
+	// and returns how the weighted tallies of the other active proposals would change.
+	RoleChangeImpact(ctx context.Context, in *QueryRoleChangeImpactRequest, opts ...grpc.CallOption) (*QueryRoleChangeImpactResponse, error)
+	// Participation returns whether a role holder voted on the proposals that ended while it
+	// held its role, and its participation rate over the participation window.
+	Participation(ctx context.Context, in *QueryParticipationRequest, opts ...grpc.CallOption) (*QueryParticipationResponse, error)
+	// GetNomination returns a pending voter role nomination.
+	GetNomination(ctx context.Context, in *QueryGetNominationRequest, opts ...grpc.CallOption) (*QueryGetNominationResponse, error)
+	// ListNomination returns the pending voter role nominations.
+	ListNomination(ctx context.Context, in *QueryAllNominationRequest, opts ...grpc.CallOption) (*QueryAllNominationResponse, error)
+	// GetRoleDistribution returns a role distribution.
+	GetRoleDistribution(ctx context.Context, in *QueryGetRoleDistributionRequest, opts ...grpc.CallOption) (*QueryGetRoleDistributionResponse, error)
+	// ListRoleDistribution returns the role distributions.
+	ListRoleDistribution(ctx context.Context, in *QueryAllRoleDistributionRequest, opts ...grpc.CallOption) (*QueryAllRoleDistributionResponse, error)
+	// RoleClaimed returns whether an address claimed its role from a role distribution.
+	RoleClaimed(ctx context.Context, in *QueryRoleClaimedRequest, opts ...grpc.CallOption) (*QueryRoleClaimedResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetVoterRole(ctx context.Context, in *QueryGetVoterRoleRequest, opts ...grpc.CallOption) (*QueryGetVoterRoleResponse, error) {
+	out := new(QueryGetVoterRoleResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/GetVoterRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListVoterRole(ctx context.Context, in *QueryAllVoterRoleRequest, opts ...grpc.CallOption) (*QueryAllVoterRoleResponse, error) {
+	out := new(QueryAllVoterRoleResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/ListVoterRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VoteDelegationFrom(ctx context.Context, in *QueryVoteDelegationFromRequest, opts ...grpc.CallOption) (*QueryVoteDelegationFromResponse, error) {
+	out := new(QueryVoteDelegationFromResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/VoteDelegationFrom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VoteDelegationsTo(ctx context.Context, in *QueryVoteDelegationsToRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsToResponse, error) {
+	out := new(QueryVoteDelegationsToResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/VoteDelegationsTo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error) {
+	out := new(QueryVotingPowerResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/VotingPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveMultiplier(ctx context.Context, in *QueryEffectiveMultiplierRequest, opts ...grpc.CallOption) (*QueryEffectiveMultiplierResponse, error) {
+	out := new(QueryEffectiveMultiplierResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/EffectiveMultiplier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChamberTally(ctx context.Context, in *QueryChamberTallyRequest, opts ...grpc.CallOption) (*QueryChamberTallyResponse, error) {
+	out := new(QueryChamberTallyResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/ChamberTally", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TallyBreakdown(ctx context.Context, in *QueryTallyBreakdownRequest, opts ...grpc.CallOption) (*QueryTallyBreakdownResponse, error) {
+	out := new(QueryTallyBreakdownResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/TallyBreakdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTimelock(ctx context.Context, in *QueryGetTimelockRequest, opts ...grpc.CallOption) (*QueryGetTimelockResponse, error) {
+	out := new(QueryGetTimelockResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/GetTimelock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListTimelock(ctx context.Context, in *QueryAllTimelockRequest, opts ...grpc.CallOption) (*QueryAllTimelockResponse, error) {
+	out := new(QueryAllTimelockResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/ListTimelock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalRoleTally(ctx context.Context, in *QueryProposalRoleTallyRequest, opts ...grpc.CallOption) (*QueryProposalRoleTallyResponse, error) {
+	out := new(QueryProposalRoleTallyResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/ProposalRoleTally", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TallyPreview(ctx context.Context, in *QueryTallyPreviewRequest, opts ...grpc.CallOption) (*QueryTallyPreviewResponse, error) {
+	out := new(QueryTallyPreviewResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/TallyPreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RoleChangeImpact(ctx context.Context, in *QueryRoleChangeImpactRequest, opts ...grpc.CallOption) (*QueryRoleChangeImpactResponse, error) {
+	out := new(QueryRoleChangeImpactResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/RoleChangeImpact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Participation(ctx context.Context, in *QueryParticipationRequest, opts ...grpc.CallOption) (*QueryParticipationResponse, error) {
+	out := new(QueryParticipationResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/Participation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetNomination(ctx context.Context, in *QueryGetNominationRequest, opts ...grpc.CallOption) (*QueryGetNominationResponse, error) {
+	out := new(QueryGetNominationResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/GetNomination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListNomination(ctx context.Context, in *QueryAllNominationRequest, opts ...grpc.CallOption) (*QueryAllNominationResponse, error) {
+	out := new(QueryAllNominationResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/ListNomination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRoleDistribution(ctx context.Context, in *QueryGetRoleDistributionRequest, opts ...grpc.CallOption) (*QueryGetRoleDistributionResponse, error) {
+	out := new(QueryGetRoleDistributionResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/GetRoleDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListRoleDistribution(ctx context.Context, in *QueryAllRoleDistributionRequest, opts ...grpc.CallOption) (*QueryAllRoleDistributionResponse, error) {
+	out := new(QueryAllRoleDistributionResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/ListRoleDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RoleClaimed(ctx context.Context, in *QueryRoleClaimedRequest, opts ...grpc.CallOption) (*QueryRoleClaimedResponse, error) {
+	out := new(QueryRoleClaimedResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Query/RoleClaimed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ListVoterRole Queries a list of VoterRole items.
+	GetVoterRole(context.Context, *QueryGetVoterRoleRequest) (*QueryGetVoterRoleResponse, error)
+	// ListVoterRole defines the ListVoterRole RPC.
+	ListVoterRole(context.Context, *QueryAllVoterRoleRequest) (*QueryAllVoterRoleResponse, error)
+	// VoteDelegationFrom returns the vote delegation made by an address.
+	VoteDelegationFrom(context.Context, *QueryVoteDelegationFromRequest) (*QueryVoteDelegationFromResponse, error)
+	// VoteDelegationsTo lists the vote delegations made to a representative.
+	VoteDelegationsTo(context.Context, *QueryVoteDelegationsToRequest) (*QueryVoteDelegationsToResponse, error)
+	// VotingPower shows how the weighted tally derives an address's voting power.
+	VotingPower(context.Context, *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error)
+	// EffectiveMultiplier resolves the multiplier the tally applies to an address on a proposal.
+	EffectiveMultiplier(context.Context, *QueryEffectiveMultiplierRequest) (*QueryEffectiveMultiplierResponse, error)
+	// ChamberTally returns the per-chamber results of a proposal tallied in bicameral mode.
+	ChamberTally(context.Context, *QueryChamberTallyRequest) (*QueryChamberTallyResponse, error)
+	// TallyBreakdown shows the participation of each role in an active proposal and which role quorums are unmet.
+	TallyBreakdown(context.Context, *QueryTallyBreakdownRequest) (*QueryTallyBreakdownResponse, error)
+	// GetTimelock returns the held messages of a passed proposal during its veto window.
+	GetTimelock(context.Context, *QueryGetTimelockRequest) (*QueryGetTimelockResponse, error)
+	// ListTimelock returns all proposals waiting for their veto window to end.
+	ListTimelock(context.Context, *QueryAllTimelockRequest) (*QueryAllTimelockResponse, error)
+	// ProposalRoleTally returns how the holders of each role voted on a finished proposal.
+	ProposalRoleTally(context.Context, *QueryProposalRoleTallyRequest) (*QueryProposalRoleTallyResponse, error)
+	// TallyPreview returns the stake-only and the role-weighted outcome of an active proposal
+	// if its voting period ended now.
+	TallyPreview(context.Context, *QueryTallyPreviewRequest) (*QueryTallyPreviewResponse, error)
+	// RoleChangeImpact applies the messages of an active registry proposal to a cached state
+	// and returns how the weighted tallies of the other active proposals would change.
+	RoleChangeImpact(context.Context, *QueryRoleChangeImpactRequest) (*QueryRoleChangeImpactResponse, error)
+	// Participation returns whether a role holder voted on the proposals that ended while it
+	// held its role, and its participation rate over the participation window.
+	Participation(context.Context, *QueryParticipationRequest) (*QueryParticipationResponse, error)
+	// GetNomination returns a pending voter role nomination.
+	GetNomination(context.Context, *QueryGetNominationRequest) (*QueryGetNominationResponse, error)
+	// ListNomination returns the pending voter role nominations.
+	ListNomination(context.Context, *QueryAllNominationRequest) (*QueryAllNominationResponse, error)
+	// GetRoleDistribution returns a role distribution.
+	GetRoleDistribution(context.Context, *QueryGetRoleDistributionRequest) (*QueryGetRoleDistributionResponse, error)
+	// ListRoleDistribution returns the role distributions.
+	ListRoleDistribution(context.Context, *QueryAllRoleDistributionRequest) (*QueryAllRoleDistributionResponse, error)
+	// RoleClaimed returns whether an address claimed its role from a role distribution.
+	RoleClaimed(context.Context, *QueryRoleClaimedRequest) (*QueryRoleClaimedResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetVoterRole(ctx context.Context, req *QueryGetVoterRoleRequest) (*QueryGetVoterRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVoterRole not implemented")
+}
+func (*UnimplementedQueryServer) ListVoterRole(ctx context.Context, req *QueryAllVoterRoleRequest) (*QueryAllVoterRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVoterRole not implemented")
+}
+func (*UnimplementedQueryServer) VoteDelegationFrom(ctx context.Context, req *QueryVoteDelegationFromRequest) (*QueryVoteDelegationFromResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDelegationFrom not implemented")
+}
+func (*UnimplementedQueryServer) VoteDelegationsTo(ctx context.Context, req *QueryVoteDelegationsToRequest) (*QueryVoteDelegationsToResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDelegationsTo not implemented")
+}
+func (*UnimplementedQueryServer) VotingPower(ctx context.Context, req *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingPower not implemented")
+}
+func (*UnimplementedQueryServer) EffectiveMultiplier(ctx context.Context, req *QueryEffectiveMultiplierRequest) (*QueryEffectiveMultiplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveMultiplier not implemented")
+}
+func (*UnimplementedQueryServer) ChamberTally(ctx context.Context, req *QueryChamberTallyRequest) (*QueryChamberTallyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChamberTally not implemented")
+}
+func (*UnimplementedQueryServer) TallyBreakdown(ctx context.Context, req *QueryTallyBreakdownRequest) (*QueryTallyBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TallyBreakdown not implemented")
+}
+func (*UnimplementedQueryServer) GetTimelock(ctx context.Context, req *QueryGetTimelockRequest) (*QueryGetTimelockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimelock not implemented")
+}
+func (*UnimplementedQueryServer) ListTimelock(ctx context.Context, req *QueryAllTimelockRequest) (*QueryAllTimelockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTimelock not implemented")
+}
+func (*UnimplementedQueryServer) ProposalRoleTally(ctx context.Context, req *QueryProposalRoleTallyRequest) (*QueryProposalRoleTallyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalRoleTally not implemented")
+}
+func (*UnimplementedQueryServer) TallyPreview(ctx context.Context, req *QueryTallyPreviewRequest) (*QueryTallyPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TallyPreview not implemented")
+}
+func (*UnimplementedQueryServer) RoleChangeImpact(ctx context.Context, req *QueryRoleChangeImpactRequest) (*QueryRoleChangeImpactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleChangeImpact not implemented")
+}
+func (*UnimplementedQueryServer) Participation(ctx context.Context, req *QueryParticipationRequest) (*QueryParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Participation not implemented")
+}
+func (*UnimplementedQueryServer) GetNomination(ctx context.Context, req *QueryGetNominationRequest) (*QueryGetNominationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNomination not implemented")
+}
+func (*UnimplementedQueryServer) ListNomination(ctx context.Context, req *QueryAllNominationRequest) (*QueryAllNominationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNomination not implemented")
+}
+func (*UnimplementedQueryServer) GetRoleDistribution(ctx context.Context, req *QueryGetRoleDistributionRequest) (*QueryGetRoleDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleDistribution not implemented")
+}
+func (*UnimplementedQueryServer) ListRoleDistribution(ctx context.Context, req *QueryAllRoleDistributionRequest) (*QueryAllRoleDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleDistribution not implemented")
+}
+func (*UnimplementedQueryServer) RoleClaimed(ctx context.Context, req *QueryRoleClaimedRequest) (*QueryRoleClaimedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleClaimed not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetVoterRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetVoterRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetVoterRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/GetVoterRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetVoterRole(ctx, req.(*QueryGetVoterRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListVoterRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllVoterRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListVoterRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/ListVoterRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListVoterRole(ctx, req.(*QueryAllVoterRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VoteDelegationFrom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteDelegationFromRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteDelegationFrom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/VoteDelegationFrom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteDelegationFrom(ctx, req.(*QueryVoteDelegationFromRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VoteDelegationsTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteDelegationsToRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteDelegationsTo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/VoteDelegationsTo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteDelegationsTo(ctx, req.(*QueryVoteDelegationsToRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/VotingPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingPower(ctx, req.(*QueryVotingPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveMultiplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveMultiplierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveMultiplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/EffectiveMultiplier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveMultiplier(ctx, req.(*QueryEffectiveMultiplierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChamberTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChamberTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChamberTally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/ChamberTally",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChamberTally(ctx, req.(*QueryChamberTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TallyBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallyBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TallyBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/TallyBreakdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TallyBreakdown(ctx, req.(*QueryTallyBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTimelock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTimelockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTimelock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/GetTimelock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTimelock(ctx, req.(*QueryGetTimelockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListTimelock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTimelockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListTimelock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/ListTimelock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListTimelock(ctx, req.(*QueryAllTimelockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalRoleTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalRoleTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalRoleTally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/ProposalRoleTally",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalRoleTally(ctx, req.(*QueryProposalRoleTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TallyPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallyPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TallyPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/TallyPreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TallyPreview(ctx, req.(*QueryTallyPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RoleChangeImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoleChangeImpactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoleChangeImpact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/RoleChangeImpact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoleChangeImpact(ctx, req.(*QueryRoleChangeImpactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Participation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Participation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/Participation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Participation(ctx, req.(*QueryParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetNomination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetNominationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetNomination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/GetNomination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNomination(ctx, req.(*QueryGetNominationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListNomination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllNominationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListNomination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/ListNomination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListNomination(ctx, req.(*QueryAllNominationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRoleDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRoleDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRoleDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/GetRoleDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRoleDistribution(ctx, req.(*QueryGetRoleDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListRoleDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRoleDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListRoleDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/ListRoleDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListRoleDistribution(ctx, req.(*QueryAllRoleDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RoleClaimed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoleClaimedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoleClaimed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Query/RoleClaimed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoleClaimed(ctx, req.(*QueryRoleClaimedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmosweightedgovernancesdk.voting.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetVoterRole",
+			Handler:    _Query_GetVoterRole_Handler,
+		},
+		{
+			MethodName: "ListVoterRole",
+			Handler:    _Query_ListVoterRole_Handler,
+		},
+		{
+			MethodName: "VoteDelegationFrom",
+			Handler:    _Query_VoteDelegationFrom_Handler,
+		},
+		{
+			MethodName: "VoteDelegationsTo",
+			Handler:    _Query_VoteDelegationsTo_Handler,
+		},
+		{
+			MethodName: "VotingPower",
+			Handler:    _Query_VotingPower_Handler,
+		},
+		{
+			MethodName: "EffectiveMultiplier",
+			Handler:    _Query_EffectiveMultiplier_Handler,
+		},
+		{
+			MethodName: "ChamberTally",
+			Handler:    _Query_ChamberTally_Handler,
+		},
+		{
+			MethodName: "TallyBreakdown",
+			Handler:    _Query_TallyBreakdown_Handler,
+		},
+		{
+			MethodName: "GetTimelock",
+			Handler:    _Query_GetTimelock_Handler,
+		},
+		{
+			MethodName: "ListTimelock",
+			Handler:    _Query_ListTimelock_Handler,
+		},
+		{
+			MethodName: "ProposalRoleTally",
+			Handler:    _Query_ProposalRoleTally_Handler,
+		},
+		{
+			MethodName: "TallyPreview",
+			Handler:    _Query_TallyPreview_Handler,
+		},
+		{
+			MethodName: "RoleChangeImpact",
+			Handler:    _Query_RoleChangeImpact_Handler,
+		},
+		{
+			MethodName: "Participation",
+			Handler:    _Query_Participation_Handler,
+		},
+		{
+			MethodName: "GetNomination",
+			Handler:    _Query_GetNomination_Handler,
+		},
+		{
+			MethodName: "ListNomination",
+			Handler:    _Query_ListNomination_Handler,
+		},
+		{
+			MethodName: "GetRoleDistribution",
+			Handler:    _Query_GetRoleDistribution_Handler,
+		},
+		{
+			MethodName: "ListRoleDistribution",
+			Handler:    _Query_ListRoleDistribution_Handler,
+		},
+		{
+			MethodName: "RoleClaimed",
+			Handler:    _Query_RoleClaimed_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmosweightedgovernancesdk/voting/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVoterRoleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVoterRoleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVoterRoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVoterRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVoterRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVoterRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VoterRole.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllVoterRoleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllVoterRoleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllVoterRoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllVoterRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllVoterRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllVoterRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VoterRole) > 0 {
+		for iNdEx := len(m.VoterRole) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoterRole[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteDelegationFromRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteDelegationFromRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteDelegationFromRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteDelegationFromResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteDelegationFromResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteDelegationFromResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VoteDelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteDelegationsToRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteDelegationsToRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteDelegationsToRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Representative) > 0 {
+		i -= len(m.Representative)
+		copy(dAtA[i:], m.Representative)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Representative)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteDelegationsToResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteDelegationsToResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteDelegationsToResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VoteDelegations) > 0 {
+		for iNdEx := len(m.VoteDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PowerTransform) > 0 {
+		i -= len(m.PowerTransform)
+		copy(dAtA[i:], m.PowerTransform)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PowerTransform)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.WeightedPower.Size()
+		i -= size
+		if _, err := m.WeightedPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TransformedPower.Size()
+		i -= size
+		if _, err := m.TransformedPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.RawPower.Size()
+		i -= size
+		if _, err := m.RawPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveMultiplierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveMultiplierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveMultiplierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveMultiplierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveMultiplierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveMultiplierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.BaseMultiplier.Size()
+		i -= size
+		if _, err := m.BaseMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChamberTallyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChamberTallyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChamberTallyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChamberTallyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChamberTallyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChamberTallyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ChamberTally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyBreakdownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyBreakdownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyBreakdownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyBreakdownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyBreakdownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyBreakdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recused) > 0 {
+		for iNdEx := len(m.Recused) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Recused[iNdEx])
+			copy(dAtA[i:], m.Recused[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Recused[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.RoleQuorumsMet {
+		i--
+		if m.RoleQuorumsMet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RoleTallies) > 0 {
+		for iNdEx := len(m.RoleTallies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleTallies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTimelockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTimelockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTimelockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTimelockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTimelockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTimelockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Timelock.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTimelockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTimelockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTimelockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTimelockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTimelockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTimelockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Timelock) > 0 {
+		for iNdEx := len(m.Timelock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Timelock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalRoleTallyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalRoleTallyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalRoleTallyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalRoleTallyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalRoleTallyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalRoleTallyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProposalRoleTally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyPreviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyPreviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyPreviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyPreviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyPreviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyPreviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.WeightedTally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.StakeTally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoleChangeImpactRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoleChangeImpactRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoleChangeImpactRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoleChangeImpactResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoleChangeImpactResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoleChangeImpactResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Impacts) > 0 {
+		for iNdEx := len(m.Impacts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Impacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Participation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetNominationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNominationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNominationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetNominationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNominationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNominationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Nomination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllNominationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllNominationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllNominationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllNominationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllNominationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllNominationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nomination) > 0 {
+		for iNdEx := len(m.Nomination) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nomination[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRoleDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRoleDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRoleDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRoleDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRoleDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRoleDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RoleDistribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRoleDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRoleDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRoleDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRoleDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRoleDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRoleDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RoleDistribution) > 0 {
+		for iNdEx := len(m.RoleDistribution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleDistribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoleClaimedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoleClaimedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoleClaimedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DistributionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DistributionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoleClaimedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoleClaimedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoleClaimedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Claimed {
+		i--
+		if m.Claimed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetVoterRoleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetVoterRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VoterRole.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllVoterRoleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryAllVoterRoleResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	if len(m.VoterRole) > 0 {
-		for _, e := range m.VoterRole {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	var l int
+	_ = l
+	if len(m.VoterRole) > 0 {
+		for _, e := range m.VoterRole {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteDelegationFromRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteDelegationFromResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VoteDelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVoteDelegationsToRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Representative)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteDelegationsToResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VoteDelegations) > 0 {
+		for _, e := range m.VoteDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotingPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotingPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RawPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TransformedPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Multiplier.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.WeightedPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.PowerTransform)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEffectiveMultiplierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryEffectiveMultiplierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Multiplier.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BaseMultiplier.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChamberTallyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryChamberTallyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ChamberTally.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTallyBreakdownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryTallyBreakdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RoleTallies) > 0 {
+		for _, e := range m.RoleTallies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.RoleQuorumsMet {
+		n += 2
+	}
+	if len(m.Recused) > 0 {
+		for _, s := range m.Recused {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetTimelockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryGetTimelockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Timelock.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllTimelockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTimelockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Timelock) > 0 {
+		for _, e := range m.Timelock {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalRoleTallyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalRoleTallyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProposalRoleTally.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTallyPreviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryTallyPreviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StakeTally.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.WeightedTally.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRoleChangeImpactRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryRoleChangeImpactResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Impacts) > 0 {
+		for _, e := range m.Impacts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParticipationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParticipationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Participation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetNominationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetNominationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Nomination.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllNominationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllNominationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nomination) > 0 {
+		for _, e := range m.Nomination {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRoleDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetRoleDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RoleDistribution.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllRoleDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRoleDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RoleDistribution) > 0 {
+		for _, e := range m.RoleDistribution {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRoleClaimedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		n += 1 + sovQuery(uint64(m.DistributionId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRoleClaimedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Claimed {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVoterRoleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVoterRoleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVoterRoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVoterRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVoterRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVoterRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterRole", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoterRole.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllVoterRoleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllVoterRoleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllVoterRoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllVoterRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllVoterRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllVoterRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterRole", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterRole = append(m.VoterRole, VoterRole{})
+			if err := m.VoterRole[len(m.VoterRole)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteDelegationFromRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteDelegationFromRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteDelegationFromRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteDelegationFromResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteDelegationFromResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteDelegationFromResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteDelegationsToRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteDelegationsToRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteDelegationsToRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Representative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Representative = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteDelegationsToResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteDelegationsToResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteDelegationsToResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteDelegations = append(m.VoteDelegations, VoteDelegation{})
+			if err := m.VoteDelegations[len(m.VoteDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RawPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransformedPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransformedPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightedPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerTransform", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PowerTransform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveMultiplierRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveMultiplierRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveMultiplierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveMultiplierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveMultiplierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveMultiplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChamberTallyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChamberTallyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChamberTallyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChamberTallyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChamberTallyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChamberTallyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChamberTally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChamberTally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTallyBreakdownRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTallyBreakdownRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTallyBreakdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTallyBreakdownResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTallyBreakdownResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTallyBreakdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleTallies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleTallies = append(m.RoleTallies, RoleTally{})
+			if err := m.RoleTallies[len(m.RoleTallies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleQuorumsMet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RoleQuorumsMet = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recused", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recused = append(m.Recused, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTimelockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTimelockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTimelockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTimelockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTimelockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTimelockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timelock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timelock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTimelockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTimelockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTimelockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTimelockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTimelockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTimelockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timelock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timelock = append(m.Timelock, Timelock{})
+			if err := m.Timelock[len(m.Timelock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalRoleTallyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalRoleTallyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalRoleTallyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalRoleTallyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalRoleTallyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalRoleTallyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalRoleTally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposalRoleTally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTallyPreviewRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTallyPreviewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTallyPreviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTallyPreviewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTallyPreviewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTallyPreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeTally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakeTally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedTally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightedTally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRoleChangeImpactRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoleChangeImpactRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoleChangeImpactRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRoleChangeImpactResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoleChangeImpactResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoleChangeImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Impacts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Impacts = append(m.Impacts, ProposalImpact{})
+			if err := m.Impacts[len(m.Impacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParticipationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParticipationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParticipationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParticipationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParticipationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParticipationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Participation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryGetNominationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetNominationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetNominationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryGetNominationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetNominationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetNominationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nomination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Nomination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllNominationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -829,12 +7981,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllNominationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllNominationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -856,7 +8044,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllNominationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -879,15 +8067,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllNominationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllNominationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nomination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -914,7 +8102,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Nomination = append(m.Nomination, Nomination{})
+			if err := m.Nomination[len(m.Nomination)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -939,7 +8164,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetVoterRoleRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRoleDistributionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -962,10 +8187,10 @@ func (m *QueryGetVoterRoleRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVoterRoleRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRoleDistributionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVoterRoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRoleDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1008,7 +8233,7 @@ func (m *QueryGetVoterRoleRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetVoterRoleResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRoleDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1031,15 +8256,15 @@ func (m *QueryGetVoterRoleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVoterRoleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRoleDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVoterRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRoleDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoterRole", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleDistribution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1066,7 +8291,7 @@ func (m *QueryGetVoterRoleResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VoterRole.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RoleDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1091,7 +8316,7 @@ func (m *QueryGetVoterRoleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllVoterRoleRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRoleDistributionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1114,10 +8339,10 @@ func (m *QueryAllVoterRoleRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllVoterRoleRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRoleDistributionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllVoterRoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRoleDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1177,7 +8402,7 @@ func (m *QueryAllVoterRoleRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllVoterRoleResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRoleDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1200,15 +8425,15 @@ func (m *QueryAllVoterRoleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllVoterRoleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRoleDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllVoterRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRoleDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoterRole", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleDistribution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1235,8 +8460,8 @@ func (m *QueryAllVoterRoleResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoterRole = append(m.VoterRole, VoterRole{})
-			if err := m.VoterRole[len(m.VoterRole)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RoleDistribution = append(m.RoleDistribution, RoleDistribution{})
+			if err := m.RoleDistribution[len(m.RoleDistribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1297,6 +8522,177 @@ func (m *QueryAllVoterRoleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRoleClaimedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoleClaimedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoleClaimedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
+			}
+			m.DistributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRoleClaimedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoleClaimedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoleClaimedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Claimed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,733 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmosweightedgovernancesdk/voting/v1/role_distribution.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RoleDistribution is a Merkle root over (address, role, multiplier, expires_at) leaves
+// published by governance. Each listed address claims its role with a proof during the
+// claim window.
+type RoleDistribution struct {
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Authority  string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	MerkleRoot []byte `protobuf:"bytes,3,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// claim_start and claim_end bound the unix times roles can be claimed at, end excluded
+	ClaimStart int64 `protobuf:"varint,4,opt,name=claim_start,json=claimStart,proto3" json:"claim_start,omitempty"`
+	ClaimEnd   int64 `protobuf:"varint,5,opt,name=claim_end,json=claimEnd,proto3" json:"claim_end,omitempty"`
+	// claimed is the number of roles claimed so far
+	Claimed uint64 `protobuf:"varint,6,opt,name=claimed,proto3" json:"claimed,omitempty"`
+}
+
+func (m *RoleDistribution) Reset()         { *m = RoleDistribution{} }
+func (m *RoleDistribution) String() string { return proto.CompactTextString(m) }
+func (*RoleDistribution) ProtoMessage()    {}
+func (*RoleDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b5ba4e07ab3e247, []int{0}
+}
+func (m *RoleDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleDistribution.Merge(m, src)
+}
+func (m *RoleDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleDistribution proto.InternalMessageInfo
+
+func (m *RoleDistribution) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RoleDistribution) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *RoleDistribution) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+func (m *RoleDistribution) GetClaimStart() int64 {
+	if m != nil {
+		return m.ClaimStart
+	}
+	return 0
+}
+
+func (m *RoleDistribution) GetClaimEnd() int64 {
+	if m != nil {
+		return m.ClaimEnd
+	}
+	return 0
+}
+
+func (m *RoleDistribution) GetClaimed() uint64 {
+	if m != nil {
+		return m.Claimed
+	}
+	return 0
+}
+
+// RoleClaim records that an address claimed its role from a distribution.
+type RoleClaim struct {
+	DistributionId uint64 `protobuf:"varint,1,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+	Address        string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *RoleClaim) Reset()         { *m = RoleClaim{} }
+func (m *RoleClaim) String() string { return proto.CompactTextString(m) }
+func (*RoleClaim) ProtoMessage()    {}
+func (*RoleClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b5ba4e07ab3e247, []int{1}
+}
+func (m *RoleClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleClaim.Merge(m, src)
+}
+func (m *RoleClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleClaim proto.InternalMessageInfo
+
+func (m *RoleClaim) GetDistributionId() uint64 {
+	if m != nil {
+		return m.DistributionId
+	}
+	return 0
+}
+
+func (m *RoleClaim) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*RoleDistribution)(nil), "cosmosweightedgovernancesdk.voting.v1.RoleDistribution")
+	proto.RegisterType((*RoleClaim)(nil), "cosmosweightedgovernancesdk.voting.v1.RoleClaim")
+}
+
+func init() {
+	proto.RegisterFile("cosmosweightedgovernancesdk/voting/v1/role_distribution.proto", fileDescriptor_4b5ba4e07ab3e247)
+}
+
+var fileDescriptor_4b5ba4e07ab3e247 = []byte{
+	// 346 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xb1, 0x4e, 0xf3, 0x30,
+	0x18, 0xac, 0xdb, 0xfe, 0xfd, 0xff, 0xf8, 0x47, 0x05, 0x45, 0x0c, 0x06, 0xa4, 0x10, 0x55, 0x42,
+	0x64, 0x49, 0xa3, 0x0a, 0x89, 0x8d, 0x81, 0x02, 0x42, 0x2c, 0x0c, 0xe9, 0xc6, 0x12, 0xa5, 0xb5,
+	0x95, 0x5a, 0x4d, 0xfd, 0x55, 0xb6, 0x1b, 0xe8, 0x5b, 0xf0, 0x30, 0x3c, 0x04, 0x63, 0x05, 0x0b,
+	0x23, 0x6a, 0x5f, 0x04, 0xc5, 0x26, 0xb4, 0x13, 0x5b, 0xee, 0x72, 0xdf, 0xc9, 0x77, 0x87, 0x2f,
+	0x46, 0xa0, 0xa6, 0xa0, 0x1e, 0x19, 0xcf, 0xc6, 0x9a, 0xd1, 0x0c, 0x0a, 0x26, 0x45, 0x2a, 0x46,
+	0x4c, 0xd1, 0x49, 0x54, 0x80, 0xe6, 0x22, 0x8b, 0x8a, 0x5e, 0x24, 0x21, 0x67, 0x09, 0xe5, 0x4a,
+	0x4b, 0x3e, 0x9c, 0x6b, 0x0e, 0xa2, 0x3b, 0x93, 0xa0, 0xc1, 0x3d, 0xf9, 0xe5, 0xbc, 0x6b, 0xcf,
+	0xbb, 0x45, 0xef, 0xf0, 0xc0, 0xca, 0x12, 0x73, 0x14, 0x59, 0x60, 0x1d, 0x3a, 0xef, 0x08, 0xef,
+	0xc5, 0x90, 0xb3, 0xeb, 0x2d, 0x73, 0xb7, 0x8d, 0xeb, 0x9c, 0x12, 0xe4, 0xa3, 0xa0, 0x19, 0xd7,
+	0x39, 0x75, 0xcf, 0xb1, 0x93, 0xce, 0xf5, 0x18, 0x24, 0xd7, 0x0b, 0x52, 0xf7, 0x51, 0xe0, 0xf4,
+	0xc9, 0xdb, 0x4b, 0xb8, 0xff, 0xed, 0x74, 0x49, 0xa9, 0x64, 0x4a, 0x0d, 0xb4, 0xe4, 0x22, 0x8b,
+	0x37, 0x52, 0xf7, 0x18, 0xff, 0x9f, 0x32, 0x39, 0xc9, 0x59, 0x22, 0x01, 0x34, 0x69, 0xf8, 0x28,
+	0xd8, 0x89, 0xb1, 0xa5, 0x62, 0x00, 0x5d, 0x0a, 0x46, 0x79, 0xca, 0xa7, 0x89, 0xd2, 0xa9, 0xd4,
+	0xa4, 0xe9, 0xa3, 0xa0, 0x11, 0x63, 0x43, 0x0d, 0x4a, 0xc6, 0x3d, 0xc2, 0x8e, 0x15, 0x30, 0x41,
+	0xc9, 0x1f, 0xf3, 0xfb, 0x9f, 0x21, 0x6e, 0x04, 0x75, 0x09, 0xfe, 0x6b, 0xbe, 0x19, 0x25, 0x2d,
+	0xf3, 0xd6, 0x0a, 0x76, 0xee, 0xb1, 0x53, 0x86, 0xba, 0x2a, 0xa1, 0x7b, 0x8a, 0x77, 0xb7, 0xab,
+	0x4b, 0x7e, 0xa2, 0xb5, 0xb7, 0xe9, 0x3b, 0xe3, 0x97, 0xda, 0x28, 0x36, 0x64, 0x5c, 0xc1, 0xfe,
+	0xed, 0xeb, 0xca, 0x43, 0xcb, 0x95, 0x87, 0x3e, 0x57, 0x1e, 0x7a, 0x5e, 0x7b, 0xb5, 0xe5, 0xda,
+	0xab, 0x7d, 0xac, 0xbd, 0xda, 0x43, 0x68, 0x3b, 0x08, 0xab, 0x09, 0xc2, 0xcd, 0x06, 0x61, 0xb9,
+	0xe1, 0x53, 0xb5, 0xa2, 0x5e, 0xcc, 0x98, 0x1a, 0xb6, 0x4c, 0xeb, 0x67, 0x5f, 0x01, 0x00, 0x00,
+	0xff, 0xff, 0xbc, 0x20, 0x9d, 0xc0, 0xf8, 0x01, 0x00, 0x00,
+}
+
+func (m *RoleDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Claimed != 0 {
+		i = encodeVarintRoleDistribution(dAtA, i, uint64(m.Claimed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ClaimEnd != 0 {
+		i = encodeVarintRoleDistribution(dAtA, i, uint64(m.ClaimEnd))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ClaimStart != 0 {
+		i = encodeVarintRoleDistribution(dAtA, i, uint64(m.ClaimStart))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintRoleDistribution(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintRoleDistribution(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRoleDistribution(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRoleDistribution(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DistributionId != 0 {
+		i = encodeVarintRoleDistribution(dAtA, i, uint64(m.DistributionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRoleDistribution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRoleDistribution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RoleDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRoleDistribution(uint64(m.Id))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovRoleDistribution(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovRoleDistribution(uint64(l))
+	}
+	if m.ClaimStart != 0 {
+		n += 1 + sovRoleDistribution(uint64(m.ClaimStart))
+	}
+	if m.ClaimEnd != 0 {
+		n += 1 + sovRoleDistribution(uint64(m.ClaimEnd))
+	}
+	if m.Claimed != 0 {
+		n += 1 + sovRoleDistribution(uint64(m.Claimed))
+	}
+	return n
+}
+
+func (m *RoleClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		n += 1 + sovRoleDistribution(uint64(m.DistributionId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRoleDistribution(uint64(l))
+	}
+	return n
+}
+
+func sovRoleDistribution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRoleDistribution(x uint64) (n int) {
+	return sovRoleDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RoleDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoleDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoleDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoleDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRoleDistribution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoleDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimStart", wireType)
+			}
+			m.ClaimStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimEnd", wireType)
+			}
+			m.ClaimEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimEnd |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			m.Claimed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Claimed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoleDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoleDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoleDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
+			}
+			m.DistributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoleDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoleDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoleDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoleDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRoleDistribution(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRoleDistribution
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRoleDistribution
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRoleDistribution
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRoleDistribution
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRoleDistribution
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRoleDistribution
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRoleDistribution        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRoleDistribution          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRoleDistribution = fmt.Errorf("proto: unexpected end of group")
+)
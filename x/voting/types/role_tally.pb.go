@@ -0,0 +1,728 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmosweightedgovernancesdk/voting/v1/role_tally.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RoleTally is the participation of the holders of one role in the tally of a proposal.
+type RoleTally struct {
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// holders is the number of addresses holding the role
+	Holders uint64 `protobuf:"varint,2,opt,name=holders,proto3" json:"holders,omitempty"`
+	// voters is the number of holders that voted, directly or through a vote delegation
+	Voters uint64 `protobuf:"varint,3,opt,name=voters,proto3" json:"voters,omitempty"`
+	// power is the role-weighted power of all holders
+	Power cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=power,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"power"`
+	// voted_power is the role-weighted power of the holders that voted
+	VotedPower cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=voted_power,json=votedPower,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"voted_power"`
+	// participation is the share of the role that voted, measured on the quorum basis
+	Participation cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=participation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"participation"`
+	// min_participation is the role quorum, zero when the role has none
+	MinParticipation cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=min_participation,json=minParticipation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_participation"`
+	// basis is the role quorum basis, holders when the role has no quorum
+	Basis     string `protobuf:"bytes,8,opt,name=basis,proto3" json:"basis,omitempty"`
+	QuorumMet bool   `protobuf:"varint,9,opt,name=quorum_met,json=quorumMet,proto3" json:"quorum_met,omitempty"`
+	// recused is the number of holders recused from the proposal because it changes their role
+	Recused uint64 `protobuf:"varint,10,opt,name=recused,proto3" json:"recused,omitempty"`
+}
+
+func (m *RoleTally) Reset()         { *m = RoleTally{} }
+func (m *RoleTally) String() string { return proto.CompactTextString(m) }
+func (*RoleTally) ProtoMessage()    {}
+func (*RoleTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc8d61f314f5f196, []int{0}
+}
+func (m *RoleTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleTally.Merge(m, src)
+}
+func (m *RoleTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleTally proto.InternalMessageInfo
+
+func (m *RoleTally) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *RoleTally) GetHolders() uint64 {
+	if m != nil {
+		return m.Holders
+	}
+	return 0
+}
+
+func (m *RoleTally) GetVoters() uint64 {
+	if m != nil {
+		return m.Voters
+	}
+	return 0
+}
+
+func (m *RoleTally) GetBasis() string {
+	if m != nil {
+		return m.Basis
+	}
+	return ""
+}
+
+func (m *RoleTally) GetQuorumMet() bool {
+	if m != nil {
+		return m.QuorumMet
+	}
+	return false
+}
+
+func (m *RoleTally) GetRecused() uint64 {
+	if m != nil {
+		return m.Recused
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RoleTally)(nil), "cosmosweightedgovernancesdk.voting.v1.RoleTally")
+}
+
+func init() {
+	proto.RegisterFile("cosmosweightedgovernancesdk/voting/v1/role_tally.proto", fileDescriptor_dc8d61f314f5f196)
+}
+
+var fileDescriptor_dc8d61f314f5f196 = []byte{
+	// 392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcd, 0x0a, 0xd3, 0x40,
+	0x14, 0x85, 0x13, 0xfb, 0x9b, 0x11, 0x41, 0x87, 0x22, 0x63, 0xc5, 0xb4, 0x08, 0x42, 0x37, 0x49,
+	0x28, 0x82, 0x0f, 0x50, 0x0a, 0xdd, 0x28, 0x94, 0x20, 0x08, 0x2e, 0x0c, 0x69, 0x72, 0x49, 0x07,
+	0x93, 0xdc, 0x38, 0x33, 0x4d, 0xed, 0x5b, 0xf8, 0x30, 0x3e, 0x44, 0x97, 0xc5, 0x95, 0xb8, 0x28,
+	0xd2, 0xbe, 0x83, 0x6b, 0x99, 0x4c, 0x8b, 0x74, 0xe3, 0xa2, 0xbb, 0x39, 0x77, 0xe6, 0x7c, 0x73,
+	0xe0, 0x1e, 0xf2, 0x26, 0x41, 0x59, 0xa0, 0xdc, 0x02, 0xcf, 0xd6, 0x0a, 0xd2, 0x0c, 0x6b, 0x10,
+	0x65, 0x5c, 0x26, 0x20, 0xd3, 0xcf, 0x41, 0x8d, 0x8a, 0x97, 0x59, 0x50, 0x4f, 0x03, 0x81, 0x39,
+	0x44, 0x2a, 0xce, 0xf3, 0x9d, 0x5f, 0x09, 0x54, 0x48, 0x5f, 0xfd, 0xc7, 0xe7, 0x1b, 0x9f, 0x5f,
+	0x4f, 0x87, 0xcf, 0xcc, 0xb3, 0xa8, 0x31, 0x05, 0x46, 0x18, 0xc2, 0x70, 0x90, 0x61, 0x86, 0x66,
+	0xae, 0x4f, 0x66, 0xfa, 0xf2, 0x4f, 0x8b, 0x38, 0x21, 0xe6, 0xf0, 0x5e, 0xff, 0x45, 0x29, 0x69,
+	0xeb, 0x9f, 0x99, 0x3d, 0xb6, 0x27, 0x4e, 0xd8, 0x9c, 0x29, 0x23, 0xbd, 0x35, 0xe6, 0x29, 0x08,
+	0xc9, 0x1e, 0x8c, 0xed, 0x49, 0x3b, 0xbc, 0x4a, 0xfa, 0x94, 0x74, 0x6b, 0x54, 0xfa, 0xa2, 0xd5,
+	0x5c, 0x5c, 0x14, 0x5d, 0x90, 0x4e, 0x85, 0x5b, 0x10, 0xac, 0xad, 0x31, 0xb3, 0xe9, 0xfe, 0x38,
+	0xb2, 0x7e, 0x1d, 0x47, 0xcf, 0x4d, 0x1c, 0x1d, 0x98, 0x63, 0x50, 0xc4, 0x6a, 0xed, 0xbf, 0x85,
+	0x2c, 0x4e, 0x76, 0x73, 0x48, 0x7e, 0x7c, 0xf7, 0xc8, 0x25, 0xed, 0x1c, 0x92, 0xd0, 0xf8, 0x69,
+	0x48, 0x1e, 0x6a, 0x64, 0x1a, 0x19, 0x5c, 0xe7, 0x5e, 0x1c, 0x69, 0x28, 0xcb, 0x86, 0xf9, 0x81,
+	0x3c, 0xaa, 0x62, 0xa1, 0x78, 0xc2, 0xab, 0x58, 0x71, 0x2c, 0x59, 0xf7, 0x5e, 0xea, 0x2d, 0x87,
+	0x7e, 0x22, 0x4f, 0x0a, 0x5e, 0x46, 0xb7, 0xf0, 0xde, 0xbd, 0xf0, 0xc7, 0x05, 0x2f, 0x97, 0x37,
+	0xfc, 0x01, 0xe9, 0xac, 0x62, 0xc9, 0x25, 0xeb, 0x37, 0xcb, 0x31, 0x82, 0xbe, 0x20, 0xe4, 0xcb,
+	0x06, 0xc5, 0xa6, 0x88, 0x0a, 0x50, 0xcc, 0x19, 0xdb, 0x93, 0x7e, 0xe8, 0x98, 0xc9, 0x3b, 0x50,
+	0x7a, 0x79, 0x02, 0x92, 0x8d, 0x84, 0x94, 0x11, 0xb3, 0xbc, 0x8b, 0x9c, 0x2d, 0xf6, 0x27, 0xd7,
+	0x3e, 0x9c, 0x5c, 0xfb, 0xf7, 0xc9, 0xb5, 0xbf, 0x9d, 0x5d, 0xeb, 0x70, 0x76, 0xad, 0x9f, 0x67,
+	0xd7, 0xfa, 0xe8, 0x99, 0x18, 0xde, 0xb5, 0x6b, 0xde, 0xbf, 0xb2, 0x79, 0xba, 0xa5, 0x5f, 0xaf,
+	0x3d, 0x55, 0xbb, 0x0a, 0xe4, 0xaa, 0xdb, 0x14, 0xe9, 0xf5, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0x53, 0x16, 0x8b, 0x37, 0xda, 0x02, 0x00, 0x00,
+}
+
+func (m *RoleTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Recused != 0 {
+		i = encodeVarintRoleTally(dAtA, i, uint64(m.Recused))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.QuorumMet {
+		i--
+		if m.QuorumMet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Basis) > 0 {
+		i -= len(m.Basis)
+		copy(dAtA[i:], m.Basis)
+		i = encodeVarintRoleTally(dAtA, i, uint64(len(m.Basis)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.MinParticipation.Size()
+		i -= size
+		if _, err := m.MinParticipation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRoleTally(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Participation.Size()
+		i -= size
+		if _, err := m.Participation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRoleTally(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.VotedPower.Size()
+		i -= size
+		if _, err := m.VotedPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRoleTally(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Power.Size()
+		i -= size
+		if _, err := m.Power.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRoleTally(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Voters != 0 {
+		i = encodeVarintRoleTally(dAtA, i, uint64(m.Voters))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Holders != 0 {
+		i = encodeVarintRoleTally(dAtA, i, uint64(m.Holders))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintRoleTally(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRoleTally(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRoleTally(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RoleTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovRoleTally(uint64(l))
+	}
+	if m.Holders != 0 {
+		n += 1 + sovRoleTally(uint64(m.Holders))
+	}
+	if m.Voters != 0 {
+		n += 1 + sovRoleTally(uint64(m.Voters))
+	}
+	l = m.Power.Size()
+	n += 1 + l + sovRoleTally(uint64(l))
+	l = m.VotedPower.Size()
+	n += 1 + l + sovRoleTally(uint64(l))
+	l = m.Participation.Size()
+	n += 1 + l + sovRoleTally(uint64(l))
+	l = m.MinParticipation.Size()
+	n += 1 + l + sovRoleTally(uint64(l))
+	l = len(m.Basis)
+	if l > 0 {
+		n += 1 + l + sovRoleTally(uint64(l))
+	}
+	if m.QuorumMet {
+		n += 2
+	}
+	if m.Recused != 0 {
+		n += 1 + sovRoleTally(uint64(m.Recused))
+	}
+	return n
+}
+
+func sovRoleTally(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRoleTally(x uint64) (n int) {
+	return sovRoleTally(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RoleTally) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoleTally
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleTally: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleTally: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoleTally
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoleTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+			}
+			m.Holders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Holders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+			}
+			m.Voters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Voters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoleTally
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoleTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoleTally
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoleTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotedPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoleTally
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoleTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Participation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinParticipation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoleTally
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoleTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinParticipation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Basis", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoleTally
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoleTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Basis = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumMet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QuorumMet = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recused", wireType)
+			}
+			m.Recused = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoleTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Recused |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoleTally(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoleTally
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRoleTally(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRoleTally
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRoleTally
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRoleTally
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRoleTally
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRoleTally
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRoleTally
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRoleTally        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRoleTally          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRoleTally = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,921 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmosweightedgovernancesdk/voting/v1/tally_preview.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TallyPreview is the outcome of an active proposal if its voting period ended now,
+// evaluated with the x/gov quorum, threshold and veto threshold.
+type TallyPreview struct {
+	Result v1.TallyResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
+	// total_power is the power that voted
+	TotalPower cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=total_power,json=totalPower,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"total_power"`
+	// turnout is the share of the bonded power that voted, compared to the x/gov quorum
+	Turnout          cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=turnout,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"turnout"`
+	QuorumReached    bool                        `protobuf:"varint,4,opt,name=quorum_reached,json=quorumReached,proto3" json:"quorum_reached,omitempty"`
+	ThresholdReached bool                        `protobuf:"varint,5,opt,name=threshold_reached,json=thresholdReached,proto3" json:"threshold_reached,omitempty"`
+	Vetoed           bool                        `protobuf:"varint,6,opt,name=vetoed,proto3" json:"vetoed,omitempty"`
+	Passes           bool                        `protobuf:"varint,7,opt,name=passes,proto3" json:"passes,omitempty"`
+}
+
+func (m *TallyPreview) Reset()         { *m = TallyPreview{} }
+func (m *TallyPreview) String() string { return proto.CompactTextString(m) }
+func (*TallyPreview) ProtoMessage()    {}
+func (*TallyPreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a34967ecea00a55d, []int{0}
+}
+func (m *TallyPreview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyPreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyPreview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TallyPreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyPreview.Merge(m, src)
+}
+func (m *TallyPreview) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyPreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyPreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TallyPreview proto.InternalMessageInfo
+
+func (m *TallyPreview) GetResult() v1.TallyResult {
+	if m != nil {
+		return m.Result
+	}
+	return v1.TallyResult{}
+}
+
+func (m *TallyPreview) GetQuorumReached() bool {
+	if m != nil {
+		return m.QuorumReached
+	}
+	return false
+}
+
+func (m *TallyPreview) GetThresholdReached() bool {
+	if m != nil {
+		return m.ThresholdReached
+	}
+	return false
+}
+
+func (m *TallyPreview) GetVetoed() bool {
+	if m != nil {
+		return m.Vetoed
+	}
+	return false
+}
+
+func (m *TallyPreview) GetPasses() bool {
+	if m != nil {
+		return m.Passes
+	}
+	return false
+}
+
+// ProposalImpact is how applying the messages of a registry proposal would change the
+// weighted tally of another active proposal.
+type ProposalImpact struct {
+	ProposalId uint64       `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Before     TallyPreview `protobuf:"bytes,2,opt,name=before,proto3" json:"before"`
+	After      TallyPreview `protobuf:"bytes,3,opt,name=after,proto3" json:"after"`
+	// difference is the weighted power by option after the change minus before
+	Difference v1.TallyResult `protobuf:"bytes,4,opt,name=difference,proto3" json:"difference"`
+}
+
+func (m *ProposalImpact) Reset()         { *m = ProposalImpact{} }
+func (m *ProposalImpact) String() string { return proto.CompactTextString(m) }
+func (*ProposalImpact) ProtoMessage()    {}
+func (*ProposalImpact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a34967ecea00a55d, []int{1}
+}
+func (m *ProposalImpact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalImpact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalImpact.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalImpact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalImpact.Merge(m, src)
+}
+func (m *ProposalImpact) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalImpact) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalImpact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalImpact proto.InternalMessageInfo
+
+func (m *ProposalImpact) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ProposalImpact) GetBefore() TallyPreview {
+	if m != nil {
+		return m.Before
+	}
+	return TallyPreview{}
+}
+
+func (m *ProposalImpact) GetAfter() TallyPreview {
+	if m != nil {
+		return m.After
+	}
+	return TallyPreview{}
+}
+
+func (m *ProposalImpact) GetDifference() v1.TallyResult {
+	if m != nil {
+		return m.Difference
+	}
+	return v1.TallyResult{}
+}
+
+func init() {
+	proto.RegisterType((*TallyPreview)(nil), "cosmosweightedgovernancesdk.voting.v1.TallyPreview")
+	proto.RegisterType((*ProposalImpact)(nil), "cosmosweightedgovernancesdk.voting.v1.ProposalImpact")
+}
+
+func init() {
+	proto.RegisterFile("cosmosweightedgovernancesdk/voting/v1/tally_preview.proto", fileDescriptor_a34967ecea00a55d)
+}
+
+var fileDescriptor_a34967ecea00a55d = []byte{
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x9b, 0xda, 0xed, 0xea, 0x54, 0x17, 0x1d, 0x44, 0x63, 0x85, 0xb4, 0x2c, 0x2c, 0x14,
+	0x24, 0x09, 0xdd, 0xbd, 0xe8, 0x4d, 0xca, 0x82, 0x2c, 0x0a, 0xd6, 0xe0, 0xc9, 0x4b, 0x98, 0x4d,
+	0x5e, 0x93, 0x60, 0x9a, 0x17, 0x67, 0x26, 0xa9, 0xfd, 0x16, 0xde, 0xfc, 0x22, 0x7e, 0x88, 0x3d,
+	0x2e, 0x9e, 0xc4, 0xc3, 0x22, 0xed, 0xd9, 0xef, 0x20, 0x33, 0x93, 0xac, 0x7b, 0x92, 0xc5, 0xbd,
+	0xe5, 0xfd, 0xdf, 0xff, 0xfd, 0xe6, 0xe5, 0xcd, 0x1b, 0xf2, 0x22, 0x42, 0xb1, 0x44, 0xb1, 0x82,
+	0x2c, 0x49, 0x25, 0xc4, 0x09, 0xd6, 0xc0, 0x0b, 0x56, 0x44, 0x20, 0xe2, 0x8f, 0x7e, 0x8d, 0x32,
+	0x2b, 0x12, 0xbf, 0x9e, 0xfa, 0x92, 0xe5, 0xf9, 0x3a, 0x2c, 0x39, 0xd4, 0x19, 0xac, 0xbc, 0x92,
+	0xa3, 0x44, 0x7a, 0xf0, 0x8f, 0x52, 0xcf, 0x94, 0x7a, 0xf5, 0x74, 0xf8, 0xd8, 0xd8, 0xfc, 0x04,
+	0x6b, 0x45, 0x4a, 0xb0, 0x36, 0xf5, 0xc3, 0x27, 0x26, 0x11, 0xea, 0xc8, 0x37, 0x41, 0x93, 0x7a,
+	0x98, 0x60, 0x82, 0x46, 0x57, 0x5f, 0x46, 0xdd, 0xff, 0xdd, 0x25, 0x77, 0xdf, 0xab, 0x46, 0xe6,
+	0xa6, 0x0f, 0xfa, 0x9c, 0xf4, 0x39, 0x88, 0x2a, 0x97, 0xb6, 0x35, 0xb6, 0x26, 0x83, 0xc3, 0xa1,
+	0xd7, 0x50, 0xd4, 0x21, 0xf5, 0xd4, 0xd3, 0xe6, 0x40, 0x3b, 0x66, 0xbd, 0xb3, 0x8b, 0x51, 0x27,
+	0x68, 0xfc, 0x34, 0x20, 0x03, 0x89, 0x92, 0xe5, 0x61, 0x89, 0x2b, 0xe0, 0x76, 0x77, 0x6c, 0x4d,
+	0xee, 0xcc, 0xa6, 0xca, 0xf2, 0xf3, 0x62, 0xf4, 0xd4, 0x50, 0xd4, 0x6f, 0x64, 0xe8, 0x2f, 0x99,
+	0x4c, 0xbd, 0x37, 0x90, 0xb0, 0x68, 0x7d, 0x0c, 0xd1, 0xf7, 0x6f, 0x2e, 0x69, 0x0e, 0x39, 0x86,
+	0x28, 0x20, 0x9a, 0x32, 0x57, 0x10, 0xfa, 0x9a, 0xec, 0xca, 0x8a, 0x17, 0x58, 0x49, 0xfb, 0xd6,
+	0xff, 0xf2, 0x5a, 0x02, 0x3d, 0x20, 0x7b, 0x9f, 0x2a, 0xe4, 0xd5, 0x32, 0xe4, 0xc0, 0xa2, 0x14,
+	0x62, 0xbb, 0x37, 0xb6, 0x26, 0xb7, 0x83, 0x7b, 0x46, 0x0d, 0x8c, 0x48, 0x9f, 0x91, 0x07, 0x32,
+	0xe5, 0x20, 0x52, 0xcc, 0xe3, 0x4b, 0xe7, 0x8e, 0x76, 0xde, 0xbf, 0x4c, 0xb4, 0xe6, 0x47, 0xa4,
+	0x5f, 0x83, 0x44, 0x88, 0xed, 0xbe, 0x76, 0x34, 0x91, 0xd2, 0x4b, 0x26, 0x04, 0x08, 0x7b, 0xd7,
+	0xe8, 0x26, 0xda, 0xff, 0xda, 0x25, 0x7b, 0x73, 0x8e, 0x25, 0x0a, 0x96, 0x9f, 0x2c, 0x4b, 0x16,
+	0x49, 0x3a, 0x22, 0x83, 0xb2, 0x51, 0xc2, 0x2c, 0xd6, 0x63, 0xef, 0x05, 0xa4, 0x95, 0x4e, 0x62,
+	0xfa, 0x8e, 0xf4, 0x4f, 0x61, 0x81, 0x1c, 0xf4, 0x4c, 0x07, 0x87, 0x47, 0xde, 0xb5, 0xb6, 0xc4,
+	0xbb, 0x7a, 0xaf, 0xed, 0x5d, 0x19, 0x10, 0x7d, 0x4b, 0x76, 0xd8, 0x42, 0x02, 0xd7, 0x53, 0xbd,
+	0x11, 0xd1, 0x70, 0xe8, 0x4b, 0x42, 0xe2, 0x6c, 0xb1, 0x00, 0x0e, 0x45, 0x04, 0x7a, 0xae, 0xd7,
+	0x59, 0x9d, 0x2b, 0x35, 0xb3, 0x57, 0x67, 0x1b, 0xc7, 0x3a, 0xdf, 0x38, 0xd6, 0xaf, 0x8d, 0x63,
+	0x7d, 0xd9, 0x3a, 0x9d, 0xf3, 0xad, 0xd3, 0xf9, 0xb1, 0x75, 0x3a, 0x1f, 0x5c, 0x83, 0x71, 0xdb,
+	0xee, 0xdc, 0xbf, 0xed, 0xb9, 0xea, 0x49, 0x7d, 0x6e, 0x1f, 0x95, 0x5c, 0x97, 0x20, 0x4e, 0xfb,
+	0x7a, 0xb3, 0x8f, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0xad, 0xaf, 0xb8, 0x98, 0x87, 0x03, 0x00,
+	0x00,
+}
+
+func (m *TallyPreview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyPreview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyPreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Passes {
+		i--
+		if m.Passes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Vetoed {
+		i--
+		if m.Vetoed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ThresholdReached {
+		i--
+		if m.ThresholdReached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.QuorumReached {
+		i--
+		if m.QuorumReached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Turnout.Size()
+		i -= size
+		if _, err := m.Turnout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTallyPreview(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalPower.Size()
+		i -= size
+		if _, err := m.TotalPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTallyPreview(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTallyPreview(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalImpact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalImpact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalImpact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Difference.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTallyPreview(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.After.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTallyPreview(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Before.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTallyPreview(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ProposalId != 0 {
+		i = encodeVarintTallyPreview(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTallyPreview(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTallyPreview(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TallyPreview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Result.Size()
+	n += 1 + l + sovTallyPreview(uint64(l))
+	l = m.TotalPower.Size()
+	n += 1 + l + sovTallyPreview(uint64(l))
+	l = m.Turnout.Size()
+	n += 1 + l + sovTallyPreview(uint64(l))
+	if m.QuorumReached {
+		n += 2
+	}
+	if m.ThresholdReached {
+		n += 2
+	}
+	if m.Vetoed {
+		n += 2
+	}
+	if m.Passes {
+		n += 2
+	}
+	return n
+}
+
+func (m *ProposalImpact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTallyPreview(uint64(m.ProposalId))
+	}
+	l = m.Before.Size()
+	n += 1 + l + sovTallyPreview(uint64(l))
+	l = m.After.Size()
+	n += 1 + l + sovTallyPreview(uint64(l))
+	l = m.Difference.Size()
+	n += 1 + l + sovTallyPreview(uint64(l))
+	return n
+}
+
+func sovTallyPreview(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTallyPreview(x uint64) (n int) {
+	return sovTallyPreview(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TallyPreview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTallyPreview
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyPreview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyPreview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTallyPreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTallyPreview
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTallyPreview
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTallyPreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTallyPreview
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTallyPreview
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Turnout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTallyPreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTallyPreview
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTallyPreview
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Turnout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumReached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTallyPreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QuorumReached = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdReached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTallyPreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ThresholdReached = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vetoed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTallyPreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Vetoed = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passes", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTallyPreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passes = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTallyPreview(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTallyPreview
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalImpact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTallyPreview
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalImpact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalImpact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTallyPreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTallyPreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTallyPreview
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTallyPreview
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Before.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTallyPreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTallyPreview
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTallyPreview
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Difference", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTallyPreview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTallyPreview
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTallyPreview
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Difference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTallyPreview(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTallyPreview
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTallyPreview(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTallyPreview
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTallyPreview
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTallyPreview
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTallyPreview
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTallyPreview
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTallyPreview
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTallyPreview        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTallyPreview          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTallyPreview = fmt.Errorf("proto: unexpected end of group")
+)
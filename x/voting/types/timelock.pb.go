@@ -0,0 +1,467 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmosweightedgovernancesdk/voting/v1/timelock.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	any "github.com/cosmos/gogoproto/types/any"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Timelock holds the messages of a passed proposal until its veto window ends.
+type Timelock struct {
+	ProposalId uint64     `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Messages   []*any.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	// execute_after is the unix time at which the messages are executed unless vetoed
+	ExecuteAfter int64 `protobuf:"varint,3,opt,name=execute_after,json=executeAfter,proto3" json:"execute_after,omitempty"`
+	// vetoes are the veto holders that vetoed the proposal
+	Vetoes []string `protobuf:"bytes,4,rep,name=vetoes,proto3" json:"vetoes,omitempty"`
+}
+
+func (m *Timelock) Reset()         { *m = Timelock{} }
+func (m *Timelock) String() string { return proto.CompactTextString(m) }
+func (*Timelock) ProtoMessage()    {}
+func (*Timelock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c56f5eac12e1a838, []int{0}
+}
+func (m *Timelock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Timelock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Timelock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Timelock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Timelock.Merge(m, src)
+}
+func (m *Timelock) XXX_Size() int {
+	return m.Size()
+}
+func (m *Timelock) XXX_DiscardUnknown() {
+	xxx_messageInfo_Timelock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Timelock proto.InternalMessageInfo
+
+func (m *Timelock) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *Timelock) GetMessages() []*any.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *Timelock) GetExecuteAfter() int64 {
+	if m != nil {
+		return m.ExecuteAfter
+	}
+	return 0
+}
+
+func (m *Timelock) GetVetoes() []string {
+	if m != nil {
+		return m.Vetoes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Timelock)(nil), "cosmosweightedgovernancesdk.voting.v1.Timelock")
+}
+
+func init() {
+	proto.RegisterFile("cosmosweightedgovernancesdk/voting/v1/timelock.proto", fileDescriptor_c56f5eac12e1a838)
+}
+
+var fileDescriptor_c56f5eac12e1a838 = []byte{
+	// 307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x31, 0x6e, 0xf2, 0x30,
+	0x1c, 0xc5, 0xf1, 0x17, 0x84, 0xf8, 0x4c, 0xbb, 0x44, 0x0c, 0x81, 0x21, 0x8d, 0x5a, 0x55, 0xca,
+	0x92, 0x18, 0xda, 0x5e, 0x00, 0x96, 0xaa, 0x6b, 0xda, 0xa9, 0x0b, 0x0a, 0xf1, 0x1f, 0x37, 0x02,
+	0xfc, 0x8f, 0x6c, 0x93, 0xc2, 0x2d, 0x7a, 0x18, 0x0e, 0xd1, 0x11, 0x75, 0xea, 0x58, 0xc1, 0x45,
+	0x2a, 0x70, 0xd2, 0x6e, 0x1d, 0xdf, 0xf3, 0x7b, 0xcf, 0xf6, 0x8f, 0xde, 0x65, 0xa8, 0x97, 0xa8,
+	0x5f, 0x21, 0x17, 0x2f, 0x06, 0xb8, 0xc0, 0x12, 0x94, 0x4c, 0x65, 0x06, 0x9a, 0xcf, 0x59, 0x89,
+	0x26, 0x97, 0x82, 0x95, 0x43, 0x66, 0xf2, 0x25, 0x2c, 0x30, 0x9b, 0xc7, 0x85, 0x42, 0x83, 0xee,
+	0xf5, 0x1f, 0xad, 0xd8, 0xb6, 0xe2, 0x72, 0xd8, 0xef, 0xd9, 0xd8, 0xe4, 0x54, 0x62, 0x56, 0xd8,
+	0x85, 0x7e, 0x4f, 0x20, 0x8a, 0x05, 0xb0, 0x93, 0x9a, 0xae, 0x66, 0x2c, 0x95, 0x1b, 0x7b, 0x74,
+	0xb9, 0x25, 0xb4, 0xfd, 0x54, 0xdd, 0xe7, 0x5e, 0xd0, 0x4e, 0xa1, 0xb0, 0x40, 0x9d, 0x2e, 0x26,
+	0x39, 0xf7, 0x48, 0x40, 0xc2, 0x66, 0x42, 0x6b, 0xeb, 0x81, 0xbb, 0x03, 0xda, 0x5e, 0x82, 0xd6,
+	0xa9, 0x00, 0xed, 0xfd, 0x0b, 0x9c, 0xb0, 0x73, 0xd3, 0x8d, 0xed, 0x76, 0x5c, 0x6f, 0xc7, 0x23,
+	0xb9, 0x49, 0x7e, 0x52, 0xee, 0x15, 0x3d, 0x87, 0x35, 0x64, 0x2b, 0x03, 0x93, 0x74, 0x66, 0x40,
+	0x79, 0x4e, 0x40, 0x42, 0x27, 0x39, 0xab, 0xcc, 0xd1, 0xd1, 0x73, 0x07, 0xb4, 0x55, 0x82, 0x41,
+	0xd0, 0x5e, 0x33, 0x70, 0xc2, 0xff, 0x63, 0xef, 0x63, 0x1b, 0x75, 0xab, 0x1f, 0x8c, 0x38, 0x57,
+	0xa0, 0xf5, 0xa3, 0x51, 0xb9, 0x14, 0x49, 0x95, 0x1b, 0xdf, 0xbf, 0xef, 0x7d, 0xb2, 0xdb, 0xfb,
+	0xe4, 0x6b, 0xef, 0x93, 0xb7, 0x83, 0xdf, 0xd8, 0x1d, 0xfc, 0xc6, 0xe7, 0xc1, 0x6f, 0x3c, 0x47,
+	0xb6, 0x17, 0xd5, 0xb8, 0xa2, 0x5f, 0x5e, 0xd1, 0x11, 0xf3, 0xba, 0x06, 0x6d, 0x36, 0x05, 0xe8,
+	0x69, 0xeb, 0xf4, 0xee, 0xdb, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x14, 0x19, 0xa1, 0x19, 0x9b,
+	0x01, 0x00, 0x00,
+}
+
+func (m *Timelock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Timelock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Timelock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vetoes) > 0 {
+		for iNdEx := len(m.Vetoes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Vetoes[iNdEx])
+			copy(dAtA[i:], m.Vetoes[iNdEx])
+			i = encodeVarintTimelock(dAtA, i, uint64(len(m.Vetoes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ExecuteAfter != 0 {
+		i = encodeVarintTimelock(dAtA, i, uint64(m.ExecuteAfter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTimelock(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTimelock(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTimelock(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTimelock(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Timelock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTimelock(uint64(m.ProposalId))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTimelock(uint64(l))
+		}
+	}
+	if m.ExecuteAfter != 0 {
+		n += 1 + sovTimelock(uint64(m.ExecuteAfter))
+	}
+	if len(m.Vetoes) > 0 {
+		for _, s := range m.Vetoes {
+			l = len(s)
+			n += 1 + l + sovTimelock(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTimelock(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTimelock(x uint64) (n int) {
+	return sovTimelock(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Timelock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTimelock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Timelock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Timelock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &any.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfter", wireType)
+			}
+			m.ExecuteAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteAfter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vetoes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vetoes = append(m.Vetoes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTimelock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTimelock(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTimelock
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTimelock
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTimelock
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTimelock
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTimelock        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTimelock          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTimelock = fmt.Errorf("proto: unexpected end of group")
+)
@@ -7,11 +7,14 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	any "github.com/cosmos/gogoproto/types/any"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -126,12 +129,14 @@ var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
 // MsgCreateVoterRole defines the MsgCreateVoterRole message.
 type MsgCreateVoterRole struct {
-	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Address    string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Role       string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
-	Multiplier string `protobuf:"bytes,4,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
-	AddedAt    int64  `protobuf:"varint,5,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
-	AddedBy    string `protobuf:"bytes,6,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
+	Creator            string              `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Address            string              `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Role               string              `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Multiplier         string              `protobuf:"bytes,4,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	AddedAt            int64               `protobuf:"varint,5,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	AddedBy            string              `protobuf:"bytes,6,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
+	MsgTypeMultipliers []MsgTypeMultiplier `protobuf:"bytes,7,rep,name=msg_type_multipliers,json=msgTypeMultipliers,proto3" json:"msg_type_multipliers"`
+	VetoHolder         bool                `protobuf:"varint,8,opt,name=veto_holder,json=vetoHolder,proto3" json:"veto_holder,omitempty"`
 }
 
 func (m *MsgCreateVoterRole) Reset()         { *m = MsgCreateVoterRole{} }
@@ -209,6 +214,20 @@ func (m *MsgCreateVoterRole) GetAddedBy() string {
 	return ""
 }
 
+func (m *MsgCreateVoterRole) GetMsgTypeMultipliers() []MsgTypeMultiplier {
+	if m != nil {
+		return m.MsgTypeMultipliers
+	}
+	return nil
+}
+
+func (m *MsgCreateVoterRole) GetVetoHolder() bool {
+	if m != nil {
+		return m.VetoHolder
+	}
+	return false
+}
+
 // MsgCreateVoterRoleResponse defines the MsgCreateVoterRoleResponse message.
 type MsgCreateVoterRoleResponse struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -256,13 +275,15 @@ func (m *MsgCreateVoterRoleResponse) GetId() uint64 {
 
 // MsgUpdateVoterRole defines the MsgUpdateVoterRole message.
 type MsgUpdateVoterRole struct {
-	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id         uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	Address    string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Role       string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
-	Multiplier string `protobuf:"bytes,5,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
-	AddedAt    int64  `protobuf:"varint,6,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
-	AddedBy    string `protobuf:"bytes,7,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
+	Creator            string              `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id                 uint64              `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Address            string              `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Role               string              `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Multiplier         string              `protobuf:"bytes,5,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	AddedAt            int64               `protobuf:"varint,6,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	AddedBy            string              `protobuf:"bytes,7,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
+	MsgTypeMultipliers []MsgTypeMultiplier `protobuf:"bytes,8,rep,name=msg_type_multipliers,json=msgTypeMultipliers,proto3" json:"msg_type_multipliers"`
+	VetoHolder         bool                `protobuf:"varint,9,opt,name=veto_holder,json=vetoHolder,proto3" json:"veto_holder,omitempty"`
 }
 
 func (m *MsgUpdateVoterRole) Reset()         { *m = MsgUpdateVoterRole{} }
@@ -347,6 +368,20 @@ func (m *MsgUpdateVoterRole) GetAddedBy() string {
 	return ""
 }
 
+func (m *MsgUpdateVoterRole) GetMsgTypeMultipliers() []MsgTypeMultiplier {
+	if m != nil {
+		return m.MsgTypeMultipliers
+	}
+	return nil
+}
+
+func (m *MsgUpdateVoterRole) GetVetoHolder() bool {
+	if m != nil {
+		return m.VetoHolder
+	}
+	return false
+}
+
 // MsgUpdateVoterRoleResponse defines the MsgUpdateVoterRoleResponse message.
 type MsgUpdateVoterRoleResponse struct {
 }
@@ -388,6 +423,8 @@ var xxx_messageInfo_MsgUpdateVoterRoleResponse proto.InternalMessageInfo
 type MsgDeleteVoterRole struct {
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// for_cause slashes the slash fraction of the role bond to the community pool
+	ForCause bool `protobuf:"varint,3,opt,name=for_cause,json=forCause,proto3" json:"for_cause,omitempty"`
 }
 
 func (m *MsgDeleteVoterRole) Reset()         { *m = MsgDeleteVoterRole{} }
@@ -437,6 +474,13 @@ func (m *MsgDeleteVoterRole) GetId() uint64 {
 	return 0
 }
 
+func (m *MsgDeleteVoterRole) GetForCause() bool {
+	if m != nil {
+		return m.ForCause
+	}
+	return false
+}
+
 // MsgDeleteVoterRoleResponse defines the MsgDeleteVoterRoleResponse message.
 type MsgDeleteVoterRoleResponse struct {
 }
@@ -474,720 +518,5927 @@ func (m *MsgDeleteVoterRoleResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteVoterRoleResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateVoterRole)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgCreateVoterRole")
-	proto.RegisterType((*MsgCreateVoterRoleResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgCreateVoterRoleResponse")
-	proto.RegisterType((*MsgUpdateVoterRole)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgUpdateVoterRole")
-	proto.RegisterType((*MsgUpdateVoterRoleResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgUpdateVoterRoleResponse")
-	proto.RegisterType((*MsgDeleteVoterRole)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgDeleteVoterRole")
-	proto.RegisterType((*MsgDeleteVoterRoleResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgDeleteVoterRoleResponse")
+// MsgDelegateVote defines the MsgDelegateVote message.
+type MsgDelegateVote struct {
+	Delegator      string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Representative string `protobuf:"bytes,2,opt,name=representative,proto3" json:"representative,omitempty"`
+	// msg_type_urls optionally limits the delegation to proposals made only of these message types.
+	MsgTypeUrls []string `protobuf:"bytes,3,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("cosmosweightedgovernancesdk/voting/v1/tx.proto", fileDescriptor_31697e12b5f6d2c8)
+func (m *MsgDelegateVote) Reset()         { *m = MsgDelegateVote{} }
+func (m *MsgDelegateVote) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVote) ProtoMessage()    {}
+func (*MsgDelegateVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31697e12b5f6d2c8, []int{8}
 }
-
-var fileDescriptor_31697e12b5f6d2c8 = []byte{
-	// 597 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4d, 0x6b, 0x13, 0x41,
-	0x18, 0xc7, 0x33, 0x49, 0x9a, 0x98, 0xb1, 0x58, 0x1c, 0x0a, 0xdd, 0x2c, 0xb2, 0x86, 0x80, 0x10,
-	0x82, 0xbb, 0x4b, 0xa3, 0x08, 0xed, 0x41, 0x48, 0x14, 0x7a, 0x0a, 0x94, 0x15, 0x3d, 0x78, 0x29,
-	0xdb, 0xcc, 0xb8, 0x5d, 0xcc, 0xee, 0x2c, 0x33, 0xd3, 0xd8, 0xdc, 0xc4, 0x83, 0x07, 0x2f, 0xfa,
-	0x31, 0x3c, 0xe6, 0xe0, 0x87, 0x28, 0x78, 0x29, 0x9e, 0xc4, 0x83, 0x48, 0x22, 0x04, 0xfc, 0x14,
-	0x92, 0x99, 0x6c, 0xd2, 0xac, 0x4d, 0x4c, 0xa2, 0x97, 0xb0, 0xf3, 0xbc, 0xfe, 0x9f, 0x1f, 0xcf,
-	0x4c, 0xa0, 0xd5, 0xa2, 0x3c, 0xa0, 0xfc, 0x15, 0xf1, 0xbd, 0x13, 0x41, 0xb0, 0x47, 0x3b, 0x84,
-	0x85, 0x6e, 0xd8, 0x22, 0x1c, 0xbf, 0xb4, 0x3b, 0x54, 0xf8, 0xa1, 0x67, 0x77, 0x76, 0x6d, 0x71,
-	0x66, 0x45, 0x8c, 0x0a, 0x8a, 0xee, 0x2c, 0x88, 0xb7, 0x54, 0xbc, 0xd5, 0xd9, 0xd5, 0x6f, 0xba,
-	0x81, 0x1f, 0x52, 0x5b, 0xfe, 0xaa, 0x4c, 0x7d, 0x47, 0x65, 0xda, 0x01, 0x97, 0x15, 0x03, 0xee,
-	0x8d, 0x1d, 0x45, 0xe5, 0x38, 0x92, 0x27, 0x5b, 0x1d, 0xc6, 0xae, 0xda, 0x72, 0xea, 0x22, 0x97,
-	0xb9, 0x41, 0x9c, 0xb3, 0xed, 0x51, 0x8f, 0xaa, 0x5a, 0xa3, 0x2f, 0x65, 0x2d, 0xff, 0x04, 0x70,
-	0xab, 0xc9, 0xbd, 0xa7, 0x11, 0x76, 0x05, 0x39, 0x94, 0xf1, 0xe8, 0x01, 0x2c, 0xb8, 0xa7, 0xe2,
-	0x84, 0x32, 0x5f, 0x74, 0x35, 0x50, 0x02, 0x95, 0x42, 0x43, 0xfb, 0xf2, 0xc9, 0xdc, 0x1e, 0x4b,
-	0xa8, 0x63, 0xcc, 0x08, 0xe7, 0x4f, 0x04, 0xf3, 0x43, 0xcf, 0x99, 0x86, 0xa2, 0x43, 0x98, 0x53,
-	0x1d, 0xb5, 0x74, 0x09, 0x54, 0xae, 0xd7, 0x4c, 0x6b, 0x29, 0x28, 0x96, 0x6a, 0xdb, 0x28, 0x9c,
-	0x7f, 0xbf, 0x9d, 0xfa, 0x38, 0xec, 0x55, 0x81, 0x33, 0xae, 0xb3, 0x7f, 0xf0, 0x66, 0xd8, 0xab,
-	0x4e, 0x3b, 0xbc, 0x1b, 0xf6, 0xaa, 0xf7, 0x17, 0x8d, 0x7e, 0x16, 0x0f, 0x9f, 0x18, 0xa9, 0x5c,
-	0x84, 0x3b, 0x09, 0x93, 0x43, 0x78, 0x44, 0x43, 0x4e, 0xca, 0xdf, 0x00, 0x44, 0x4d, 0xee, 0x3d,
-	0x62, 0xc4, 0x15, 0xe4, 0x19, 0x15, 0x84, 0x39, 0xb4, 0x4d, 0x50, 0x0d, 0xe6, 0x5b, 0x23, 0x13,
-	0x65, 0x7f, 0x45, 0x10, 0x07, 0x22, 0x0d, 0xe6, 0x5d, 0xe5, 0x91, 0x04, 0x0a, 0x4e, 0x7c, 0x44,
-	0x08, 0x66, 0x19, 0x6d, 0x13, 0x2d, 0x23, 0xcd, 0xf2, 0x1b, 0x19, 0x10, 0x06, 0xa7, 0x6d, 0xe1,
-	0x47, 0x6d, 0x9f, 0x30, 0x2d, 0x2b, 0x3d, 0x97, 0x2c, 0xa8, 0x08, 0xaf, 0xb9, 0x18, 0x13, 0x7c,
-	0xe4, 0x0a, 0x6d, 0xa3, 0x04, 0x2a, 0x19, 0x59, 0x8e, 0xe0, 0xba, 0x98, 0xba, 0x8e, 0xbb, 0x5a,
-	0x6e, 0xd2, 0x89, 0xe0, 0x46, 0x77, 0x7f, 0x73, 0x84, 0x2c, 0x56, 0x54, 0xbe, 0x0b, 0xf5, 0x3f,
-	0x67, 0x8b, 0x47, 0x47, 0x37, 0x60, 0xda, 0xc7, 0x72, 0xbc, 0xac, 0x93, 0xf6, 0x71, 0xf9, 0x97,
-	0x42, 0xa1, 0x30, 0xfd, 0x1b, 0x0a, 0x55, 0x3a, 0x1d, 0x97, 0xbe, 0x8c, 0x26, 0x73, 0x35, 0x9a,
-	0xec, 0x5c, 0x34, 0x1b, 0x0b, 0xd1, 0xe4, 0xe6, 0xa3, 0xc9, 0x2f, 0x42, 0x73, 0x4b, 0xa2, 0x49,
-	0xcc, 0x3a, 0xd9, 0x8a, 0x17, 0x92, 0xc4, 0x63, 0xd2, 0x26, 0xff, 0x99, 0xc4, 0x95, 0x2a, 0x12,
-	0x7d, 0x62, 0x15, 0xb5, 0xcf, 0x59, 0x98, 0x69, 0x72, 0x0f, 0xbd, 0x05, 0x70, 0x73, 0xf6, 0x8a,
-	0x2e, 0x79, 0xb5, 0x12, 0x4b, 0xaf, 0x3f, 0x5c, 0x2f, 0x6f, 0xb2, 0x31, 0xef, 0x01, 0xdc, 0x4a,
-	0xde, 0x94, 0xbd, 0xe5, 0x6b, 0x26, 0x52, 0xf5, 0xfa, 0xda, 0xa9, 0x33, 0x8a, 0x92, 0x0b, 0xbb,
-	0xb7, 0xea, 0x94, 0x6b, 0x29, 0x9a, 0xb3, 0x3a, 0x52, 0x51, 0x72, 0x71, 0x56, 0x50, 0x94, 0x48,
-	0x5d, 0x45, 0xd1, 0x9c, 0x35, 0xd2, 0x37, 0x5e, 0x8f, 0x5e, 0xd5, 0xc6, 0xc1, 0x79, 0xdf, 0x00,
-	0x17, 0x7d, 0x03, 0xfc, 0xe8, 0x1b, 0xe0, 0xc3, 0xc0, 0x48, 0x5d, 0x0c, 0x8c, 0xd4, 0xd7, 0x81,
-	0x91, 0x7a, 0x6e, 0xaa, 0x16, 0x66, 0xdc, 0xc3, 0x9c, 0x36, 0x31, 0x67, 0xde, 0x55, 0xd1, 0x8d,
-	0x08, 0x3f, 0xce, 0xc9, 0xff, 0x8e, 0x7b, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x8a, 0xee, 0xb2,
-	0x0d, 0x25, 0x07, 0x00, 0x00,
+func (m *MsgDelegateVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVote.Merge(m, src)
+}
+func (m *MsgDelegateVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVote.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgDelegateVote proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgDelegateVote) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// CreateVoterRole defines the CreateVoterRole RPC.
-	CreateVoterRole(ctx context.Context, in *MsgCreateVoterRole, opts ...grpc.CallOption) (*MsgCreateVoterRoleResponse, error)
-	// UpdateVoterRole defines the UpdateVoterRole RPC.
-	UpdateVoterRole(ctx context.Context, in *MsgUpdateVoterRole, opts ...grpc.CallOption) (*MsgUpdateVoterRoleResponse, error)
-	// DeleteVoterRole defines the DeleteVoterRole RPC.
-	DeleteVoterRole(ctx context.Context, in *MsgDeleteVoterRole, opts ...grpc.CallOption) (*MsgDeleteVoterRoleResponse, error)
+func (m *MsgDelegateVote) GetRepresentative() string {
+	if m != nil {
+		return m.Representative
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgDelegateVote) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgDelegateVoteResponse defines the MsgDelegateVoteResponse message.
+type MsgDelegateVoteResponse struct {
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDelegateVoteResponse) Reset()         { *m = MsgDelegateVoteResponse{} }
+func (m *MsgDelegateVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVoteResponse) ProtoMessage()    {}
+func (*MsgDelegateVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31697e12b5f6d2c8, []int{9}
+}
+func (m *MsgDelegateVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDelegateVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVoteResponse.Merge(m, src)
+}
+func (m *MsgDelegateVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVoteResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateVoterRole(ctx context.Context, in *MsgCreateVoterRole, opts ...grpc.CallOption) (*MsgCreateVoterRoleResponse, error) {
-	out := new(MsgCreateVoterRoleResponse)
-	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Msg/CreateVoterRole", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgDelegateVoteResponse proto.InternalMessageInfo
+
+// MsgRevokeVoteDelegation defines the MsgRevokeVoteDelegation message.
+type MsgRevokeVoteDelegation struct {
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
 }
 
-func (c *msgClient) UpdateVoterRole(ctx context.Context, in *MsgUpdateVoterRole, opts ...grpc.CallOption) (*MsgUpdateVoterRoleResponse, error) {
-	out := new(MsgUpdateVoterRoleResponse)
-	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Msg/UpdateVoterRole", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeVoteDelegation) Reset()         { *m = MsgRevokeVoteDelegation{} }
+func (m *MsgRevokeVoteDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeVoteDelegation) ProtoMessage()    {}
+func (*MsgRevokeVoteDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31697e12b5f6d2c8, []int{10}
+}
+func (m *MsgRevokeVoteDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeVoteDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeVoteDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRevokeVoteDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeVoteDelegation.Merge(m, src)
+}
+func (m *MsgRevokeVoteDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeVoteDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeVoteDelegation.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteVoterRole(ctx context.Context, in *MsgDeleteVoterRole, opts ...grpc.CallOption) (*MsgDeleteVoterRoleResponse, error) {
-	out := new(MsgDeleteVoterRoleResponse)
-	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Msg/DeleteVoterRole", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRevokeVoteDelegation proto.InternalMessageInfo
+
+func (m *MsgRevokeVoteDelegation) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// CreateVoterRole defines the CreateVoterRole RPC.
-	CreateVoterRole(context.Context, *MsgCreateVoterRole) (*MsgCreateVoterRoleResponse, error)
-	// UpdateVoterRole defines the UpdateVoterRole RPC.
-	UpdateVoterRole(context.Context, *MsgUpdateVoterRole) (*MsgUpdateVoterRoleResponse, error)
-	// DeleteVoterRole defines the DeleteVoterRole RPC.
-	DeleteVoterRole(context.Context, *MsgDeleteVoterRole) (*MsgDeleteVoterRoleResponse, error)
+// MsgRevokeVoteDelegationResponse defines the MsgRevokeVoteDelegationResponse message.
+type MsgRevokeVoteDelegationResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgRevokeVoteDelegationResponse) Reset()         { *m = MsgRevokeVoteDelegationResponse{} }
+func (m *MsgRevokeVoteDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeVoteDelegationResponse) ProtoMessage()    {}
+func (*MsgRevokeVoteDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31697e12b5f6d2c8, []int{11}
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgRevokeVoteDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateVoterRole(ctx context.Context, req *MsgCreateVoterRole) (*MsgCreateVoterRoleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateVoterRole not implemented")
+func (m *MsgRevokeVoteDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeVoteDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateVoterRole(ctx context.Context, req *MsgUpdateVoterRole) (*MsgUpdateVoterRoleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateVoterRole not implemented")
+func (m *MsgRevokeVoteDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeVoteDelegationResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) DeleteVoterRole(ctx context.Context, req *MsgDeleteVoterRole) (*MsgDeleteVoterRoleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteVoterRole not implemented")
+func (m *MsgRevokeVoteDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeVoteDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeVoteDelegationResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgRevokeVoteDelegationResponse proto.InternalMessageInfo
+
+// MsgTimelockMessages wraps the messages of a proposal. When the proposal passes they are held
+// for the veto window and then executed unless vetoed.
+type MsgTimelockMessages struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string     `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Messages  []*any.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgTimelockMessages) Reset()         { *m = MsgTimelockMessages{} }
+func (m *MsgTimelockMessages) String() string { return proto.CompactTextString(m) }
+func (*MsgTimelockMessages) ProtoMessage()    {}
+func (*MsgTimelockMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31697e12b5f6d2c8, []int{12}
+}
+func (m *MsgTimelockMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTimelockMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTimelockMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgTimelockMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTimelockMessages.Merge(m, src)
+}
+func (m *MsgTimelockMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTimelockMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTimelockMessages.DiscardUnknown(m)
 }
 
-func _Msg_CreateVoterRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateVoterRole)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateVoterRole(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Msg/CreateVoterRole",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateVoterRole(ctx, req.(*MsgCreateVoterRole))
+var xxx_messageInfo_MsgTimelockMessages proto.InternalMessageInfo
+
+func (m *MsgTimelockMessages) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateVoterRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateVoterRole)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateVoterRole(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Msg/UpdateVoterRole",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateVoterRole(ctx, req.(*MsgUpdateVoterRole))
+func (m *MsgTimelockMessages) GetMessages() []*any.Any {
+	if m != nil {
+		return m.Messages
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_DeleteVoterRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteVoterRole)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteVoterRole(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Msg/DeleteVoterRole",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteVoterRole(ctx, req.(*MsgDeleteVoterRole))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgTimelockMessagesResponse defines the MsgTimelockMessagesResponse message.
+type MsgTimelockMessagesResponse struct {
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmosweightedgovernancesdk.voting.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateVoterRole",
-			Handler:    _Msg_CreateVoterRole_Handler,
-		},
-		{
-			MethodName: "UpdateVoterRole",
-			Handler:    _Msg_UpdateVoterRole_Handler,
-		},
-		{
-			MethodName: "DeleteVoterRole",
-			Handler:    _Msg_DeleteVoterRole_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmosweightedgovernancesdk/voting/v1/tx.proto",
+func (m *MsgTimelockMessagesResponse) Reset()         { *m = MsgTimelockMessagesResponse{} }
+func (m *MsgTimelockMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTimelockMessagesResponse) ProtoMessage()    {}
+func (*MsgTimelockMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31697e12b5f6d2c8, []int{13}
 }
-
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTimelockMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTimelockMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTimelockMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgTimelockMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTimelockMessagesResponse.Merge(m, src)
+}
+func (m *MsgTimelockMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTimelockMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTimelockMessagesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgTimelockMessagesResponse proto.InternalMessageInfo
+
+// MsgVetoProposal defines the MsgVetoProposal message.
+type MsgVetoProposal struct {
+	Voter      string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgVetoProposal) Reset()         { *m = MsgVetoProposal{} }
+func (m *MsgVetoProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposal) ProtoMessage()    {}
+func (*MsgVetoProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31697e12b5f6d2c8, []int{14}
+}
+func (m *MsgVetoProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgVetoProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposal.Merge(m, src)
+}
+func (m *MsgVetoProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposal proto.InternalMessageInfo
+
+func (m *MsgVetoProposal) GetVoter() string {
+	if m != nil {
+		return m.Voter
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgVetoProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgVetoProposalResponse defines the MsgVetoProposalResponse message.
+type MsgVetoProposalResponse struct {
+	// vetoed is true when this veto reached the veto threshold
+	Vetoed bool `protobuf:"varint,1,opt,name=vetoed,proto3" json:"vetoed,omitempty"`
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgVetoProposalResponse) Reset()         { *m = MsgVetoProposalResponse{} }
+func (m *MsgVetoProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposalResponse) ProtoMessage()    {}
+func (*MsgVetoProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31697e12b5f6d2c8, []int{15}
+}
+func (m *MsgVetoProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposalResponse.Merge(m, src)
+}
+func (m *MsgVetoProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateVoterRole) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgVetoProposalResponse proto.InternalMessageInfo
+
+func (m *MsgVetoProposalResponse) GetVetoed() bool {
+	if m != nil {
+		return m.Vetoed
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgCreateVoterRole) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSuspendVoterRole defines the MsgSuspendVoterRole message.
+type MsgSuspendVoterRole struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Id        uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// reinstate_at is the unix time the role is reinstated automatically, 0 to wait for
+	// MsgReinstateVoterRole
+	ReinstateAt int64 `protobuf:"varint,4,opt,name=reinstate_at,json=reinstateAt,proto3" json:"reinstate_at,omitempty"`
 }
 
-func (m *MsgCreateVoterRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AddedBy) > 0 {
-		i -= len(m.AddedBy)
-		copy(dAtA[i:], m.AddedBy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AddedBy)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.AddedAt != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AddedAt))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Multiplier) > 0 {
-		i -= len(m.Multiplier)
-		copy(dAtA[i:], m.Multiplier)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Multiplier)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Role) > 0 {
-		i -= len(m.Role)
-		copy(dAtA[i:], m.Role)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Role)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSuspendVoterRole) Reset()         { *m = MsgSuspendVoterRole{} }
+func (m *MsgSuspendVoterRole) String() string { return proto.CompactTextString(m) }
+func (*MsgSuspendVoterRole) ProtoMessage()    {}
+func (*MsgSuspendVoterRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31697e12b5f6d2c8, []int{16}
+}
+func (m *MsgSuspendVoterRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuspendVoterRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuspendVoterRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSuspendVoterRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuspendVoterRole.Merge(m, src)
+}
+func (m *MsgSuspendVoterRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuspendVoterRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuspendVoterRole.DiscardUnknown(m)
 }
 
-func (m *MsgCreateVoterRoleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSuspendVoterRole proto.InternalMessageInfo
+
+func (m *MsgSuspendVoterRole) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateVoterRoleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSuspendVoterRole) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgCreateVoterRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgSuspendVoterRole) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateVoterRole) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSuspendVoterRole) GetReinstateAt() int64 {
+	if m != nil {
+		return m.ReinstateAt
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateVoterRole) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSuspendVoterRoleResponse defines the MsgSuspendVoterRoleResponse message.
+type MsgSuspendVoterRoleResponse struct {
 }
 
-func (m *MsgUpdateVoterRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AddedBy) > 0 {
-		i -= len(m.AddedBy)
-		copy(dAtA[i:], m.AddedBy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AddedBy)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.AddedAt != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AddedAt))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Multiplier) > 0 {
-		i -= len(m.Multiplier)
-		copy(dAtA[i:], m.Multiplier)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Multiplier)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Role) > 0 {
-		i -= len(m.Role)
-		copy(dAtA[i:], m.Role)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Role)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSuspendVoterRoleResponse) Reset()         { *m = MsgSuspendVoterRoleResponse{} }
+func (m *MsgSuspendVoterRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSuspendVoterRoleResponse) ProtoMessage()    {}
+func (*MsgSuspendVoterRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31697e12b5f6d2c8, []int{17}
 }
-
-func (m *MsgUpdateVoterRoleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSuspendVoterRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuspendVoterRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuspendVoterRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateVoterRoleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSuspendVoterRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuspendVoterRoleResponse.Merge(m, src)
+}
+func (m *MsgSuspendVoterRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuspendVoterRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuspendVoterRoleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateVoterRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSuspendVoterRoleResponse proto.InternalMessageInfo
+
+// MsgReinstateVoterRole defines the MsgReinstateVoterRole message.
+type MsgReinstateVoterRole struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Id        uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgDeleteVoterRole) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReinstateVoterRole) Reset()         { *m = MsgReinstateVoterRole{} }
+func (m *MsgReinstateVoterRole) String() string { return proto.CompactTextString(m) }
+func (*MsgReinstateVoterRole) ProtoMessage()    {}
+func (*MsgReinstateVoterRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31697e12b5f6d2c8, []int{18}
+}
+func (m *MsgReinstateVoterRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReinstateVoterRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReinstateVoterRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgDeleteVoterRole) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReinstateVoterRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReinstateVoterRole.Merge(m, src)
+}
+func (m *MsgReinstateVoterRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReinstateVoterRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReinstateVoterRole.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteVoterRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgReinstateVoterRole proto.InternalMessageInfo
+
+func (m *MsgReinstateVoterRole) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDeleteVoterRoleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReinstateVoterRole) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgDeleteVoterRoleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgReinstateVoterRoleResponse defines the MsgReinstateVoterRoleResponse message.
+type MsgReinstateVoterRoleResponse struct {
 }
 
-func (m *MsgDeleteVoterRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgReinstateVoterRoleResponse) Reset()         { *m = MsgReinstateVoterRoleResponse{} }
+func (m *MsgReinstateVoterRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReinstateVoterRoleResponse) ProtoMessage()    {}
+func (*MsgReinstateVoterRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31697e12b5f6d2c8, []int{19}
 }
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgReinstateVoterRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgReinstateVoterRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReinstateVoterRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgReinstateVoterRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReinstateVoterRoleResponse.Merge(m, src)
+}
+func (m *MsgReinstateVoterRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReinstateVoterRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReinstateVoterRoleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgReinstateVoterRoleResponse proto.InternalMessageInfo
+
+// MsgNominateVoterRole defines the MsgNominateVoterRole message.
+type MsgNominateVoterRole struct {
+	Nominator     string `protobuf:"bytes,1,opt,name=nominator,proto3" json:"nominator,omitempty"`
+	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Role          string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Justification string `protobuf:"bytes,4,opt,name=justification,proto3" json:"justification,omitempty"`
+	// deposit must cover the min deposit of the nomination params
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
 }
 
-func (m *MsgCreateVoterRole) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Role)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Multiplier)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgNominateVoterRole) Reset()         { *m = MsgNominateVoterRole{} }
+func (m *MsgNominateVoterRole) String() string { return proto.CompactTextString(m) }
+func (*MsgNominateVoterRole) ProtoMessage()    {}
+func (*MsgNominateVoterRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31697e12b5f6d2c8, []int{20}
+}
+func (m *MsgNominateVoterRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgNominateVoterRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgNominateVoterRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.AddedAt != 0 {
-		n += 1 + sovTx(uint64(m.AddedAt))
+}
+func (m *MsgNominateVoterRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgNominateVoterRole.Merge(m, src)
+}
+func (m *MsgNominateVoterRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgNominateVoterRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgNominateVoterRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgNominateVoterRole proto.InternalMessageInfo
+
+func (m *MsgNominateVoterRole) GetNominator() string {
+	if m != nil {
+		return m.Nominator
 	}
-	l = len(m.AddedBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgNominateVoterRole) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return n
+	return ""
 }
 
-func (m *MsgCreateVoterRoleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgNominateVoterRole) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	return ""
+}
+
+func (m *MsgNominateVoterRole) GetJustification() string {
+	if m != nil {
+		return m.Justification
 	}
-	return n
+	return ""
 }
 
-func (m *MsgUpdateVoterRole) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgNominateVoterRole) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// MsgNominateVoterRoleResponse defines the MsgNominateVoterRoleResponse message.
+type MsgNominateVoterRoleResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgNominateVoterRoleResponse) Reset()         { *m = MsgNominateVoterRoleResponse{} }
+func (m *MsgNominateVoterRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgNominateVoterRoleResponse) ProtoMessage()    {}
+func (*MsgNominateVoterRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31697e12b5f6d2c8, []int{21}
+}
+func (m *MsgNominateVoterRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgNominateVoterRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgNominateVoterRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+}
+func (m *MsgNominateVoterRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgNominateVoterRoleResponse.Merge(m, src)
+}
+func (m *MsgNominateVoterRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgNominateVoterRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgNominateVoterRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgNominateVoterRoleResponse proto.InternalMessageInfo
+
+func (m *MsgNominateVoterRoleResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+// MsgApproveNomination defines the MsgApproveNomination message.
+type MsgApproveNomination struct {
+	// authority is the module authority or a role authority allowed to create the role
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Id        uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// multiplier is the multiplier of the created voter role
+	Multiplier string `protobuf:"bytes,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+}
+
+func (m *MsgApproveNomination) Reset()         { *m = MsgApproveNomination{} }
+func (m *MsgApproveNomination) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveNomination) ProtoMessage()    {}
+func (*MsgApproveNomination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31697e12b5f6d2c8, []int{22}
+}
+func (m *MsgApproveNomination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveNomination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveNomination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Role)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgApproveNomination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveNomination.Merge(m, src)
+}
+func (m *MsgApproveNomination) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveNomination) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveNomination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveNomination proto.InternalMessageInfo
+
+func (m *MsgApproveNomination) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	l = len(m.Multiplier)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgApproveNomination) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.AddedAt != 0 {
-		n += 1 + sovTx(uint64(m.AddedAt))
+	return 0
+}
+
+func (m *MsgApproveNomination) GetMultiplier() string {
+	if m != nil {
+		return m.Multiplier
 	}
-	l = len(m.AddedBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+// MsgApproveNominationResponse defines the MsgApproveNominationResponse message.
+type MsgApproveNominationResponse struct {
+	VoterRoleId uint64 `protobuf:"varint,1,opt,name=voter_role_id,json=voterRoleId,proto3" json:"voter_role_id,omitempty"`
+}
+
+func (m *MsgApproveNominationResponse) Reset()         { *m = MsgApproveNominationResponse{} }
+func (m *MsgApproveNominationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveNominationResponse) ProtoMessage()    {}
+func (*MsgApproveNominationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31697e12b5f6d2c8, []int{23}
+}
+func (m *MsgApproveNominationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveNominationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveNominationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgApproveNominationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveNominationResponse.Merge(m, src)
+}
+func (m *MsgApproveNominationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveNominationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveNominationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateVoterRoleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgApproveNominationResponse proto.InternalMessageInfo
+
+func (m *MsgApproveNominationResponse) GetVoterRoleId() uint64 {
+	if m != nil {
+		return m.VoterRoleId
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *MsgDeleteVoterRole) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgRejectNomination defines the MsgRejectNomination message.
+type MsgRejectNomination struct {
+	// authority is the module authority or a role authority allowed to create the role
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Id        uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// burn_deposit burns the deposit of a spam nomination instead of refunding it
+	BurnDeposit bool `protobuf:"varint,3,opt,name=burn_deposit,json=burnDeposit,proto3" json:"burn_deposit,omitempty"`
+}
+
+func (m *MsgRejectNomination) Reset()         { *m = MsgRejectNomination{} }
+func (m *MsgRejectNomination) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectNomination) ProtoMessage()    {}
+func (*MsgRejectNomination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31697e12b5f6d2c8, []int{24}
+}
+func (m *MsgRejectNomination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectNomination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectNomination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgRejectNomination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectNomination.Merge(m, src)
+}
+func (m *MsgRejectNomination) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectNomination) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectNomination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRejectNomination proto.InternalMessageInfo
+
+func (m *MsgRejectNomination) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	return ""
+}
+
+func (m *MsgRejectNomination) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgRejectNomination) GetBurnDeposit() bool {
+	if m != nil {
+		return m.BurnDeposit
+	}
+	return false
+}
+
+// MsgRejectNominationResponse defines the MsgRejectNominationResponse message.
+type MsgRejectNominationResponse struct {
+}
+
+func (m *MsgRejectNominationResponse) Reset()         { *m = MsgRejectNominationResponse{} }
+func (m *MsgRejectNominationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectNominationResponse) ProtoMessage()    {}
+func (*MsgRejectNominationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31697e12b5f6d2c8, []int{25}
+}
+func (m *MsgRejectNominationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectNominationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectNominationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRejectNominationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectNominationResponse.Merge(m, src)
+}
+func (m *MsgRejectNominationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectNominationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectNominationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRejectNominationResponse proto.InternalMessageInfo
+
+// MsgCreateRoleDistribution defines the MsgCreateRoleDistribution message.
+type MsgCreateRoleDistribution struct {
+	// authority is the module authority
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// merkle_root is the root of the tree over the voter role leaves
+	MerkleRoot []byte `protobuf:"bytes,2,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// claim_start and claim_end bound the unix times roles can be claimed at, end excluded
+	ClaimStart int64 `protobuf:"varint,3,opt,name=claim_start,json=claimStart,proto3" json:"claim_start,omitempty"`
+	ClaimEnd   int64 `protobuf:"varint,4,opt,name=claim_end,json=claimEnd,proto3" json:"claim_end,omitempty"`
+}
+
+func (m *MsgCreateRoleDistribution) Reset()         { *m = MsgCreateRoleDistribution{} }
+func (m *MsgCreateRoleDistribution) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateRoleDistribution) ProtoMessage()    {}
+func (*MsgCreateRoleDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31697e12b5f6d2c8, []int{26}
+}
+func (m *MsgCreateRoleDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateRoleDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateRoleDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateRoleDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateRoleDistribution.Merge(m, src)
+}
+func (m *MsgCreateRoleDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateRoleDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateRoleDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateRoleDistribution proto.InternalMessageInfo
+
+func (m *MsgCreateRoleDistribution) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCreateRoleDistribution) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+func (m *MsgCreateRoleDistribution) GetClaimStart() int64 {
+	if m != nil {
+		return m.ClaimStart
+	}
+	return 0
+}
+
+func (m *MsgCreateRoleDistribution) GetClaimEnd() int64 {
+	if m != nil {
+		return m.ClaimEnd
+	}
+	return 0
+}
+
+// MsgCreateRoleDistributionResponse defines the MsgCreateRoleDistributionResponse message.
+type MsgCreateRoleDistributionResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCreateRoleDistributionResponse) Reset()         { *m = MsgCreateRoleDistributionResponse{} }
+func (m *MsgCreateRoleDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateRoleDistributionResponse) ProtoMessage()    {}
+func (*MsgCreateRoleDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31697e12b5f6d2c8, []int{27}
+}
+func (m *MsgCreateRoleDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateRoleDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateRoleDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateRoleDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateRoleDistributionResponse.Merge(m, src)
+}
+func (m *MsgCreateRoleDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateRoleDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateRoleDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateRoleDistributionResponse proto.InternalMessageInfo
+
+func (m *MsgCreateRoleDistributionResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgClaimVoterRole defines the MsgClaimVoterRole message.
+type MsgClaimVoterRole struct {
+	// claimant is the address of the leaf, which receives the role
+	Claimant       string `protobuf:"bytes,1,opt,name=claimant,proto3" json:"claimant,omitempty"`
+	DistributionId uint64 `protobuf:"varint,2,opt,name=distribution_id,json=distributionId,proto3" json:"distribution_id,omitempty"`
+	Role           string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Multiplier     string `protobuf:"bytes,4,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	ExpiresAt      int64  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// proof holds the sibling hashes from the leaf up to the root
+	Proof [][]byte `protobuf:"bytes,6,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *MsgClaimVoterRole) Reset()         { *m = MsgClaimVoterRole{} }
+func (m *MsgClaimVoterRole) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimVoterRole) ProtoMessage()    {}
+func (*MsgClaimVoterRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31697e12b5f6d2c8, []int{28}
+}
+func (m *MsgClaimVoterRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimVoterRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimVoterRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimVoterRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimVoterRole.Merge(m, src)
+}
+func (m *MsgClaimVoterRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimVoterRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimVoterRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimVoterRole proto.InternalMessageInfo
+
+func (m *MsgClaimVoterRole) GetClaimant() string {
+	if m != nil {
+		return m.Claimant
+	}
+	return ""
+}
+
+func (m *MsgClaimVoterRole) GetDistributionId() uint64 {
+	if m != nil {
+		return m.DistributionId
+	}
+	return 0
+}
+
+func (m *MsgClaimVoterRole) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *MsgClaimVoterRole) GetMultiplier() string {
+	if m != nil {
+		return m.Multiplier
+	}
+	return ""
+}
+
+func (m *MsgClaimVoterRole) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *MsgClaimVoterRole) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// MsgClaimVoterRoleResponse defines the MsgClaimVoterRoleResponse message.
+type MsgClaimVoterRoleResponse struct {
+	VoterRoleId uint64 `protobuf:"varint,1,opt,name=voter_role_id,json=voterRoleId,proto3" json:"voter_role_id,omitempty"`
+}
+
+func (m *MsgClaimVoterRoleResponse) Reset()         { *m = MsgClaimVoterRoleResponse{} }
+func (m *MsgClaimVoterRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimVoterRoleResponse) ProtoMessage()    {}
+func (*MsgClaimVoterRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31697e12b5f6d2c8, []int{29}
+}
+func (m *MsgClaimVoterRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimVoterRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimVoterRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimVoterRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimVoterRoleResponse.Merge(m, src)
+}
+func (m *MsgClaimVoterRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimVoterRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimVoterRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimVoterRoleResponse proto.InternalMessageInfo
+
+func (m *MsgClaimVoterRoleResponse) GetVoterRoleId() uint64 {
+	if m != nil {
+		return m.VoterRoleId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateVoterRole)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgCreateVoterRole")
+	proto.RegisterType((*MsgCreateVoterRoleResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgCreateVoterRoleResponse")
+	proto.RegisterType((*MsgUpdateVoterRole)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgUpdateVoterRole")
+	proto.RegisterType((*MsgUpdateVoterRoleResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgUpdateVoterRoleResponse")
+	proto.RegisterType((*MsgDeleteVoterRole)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgDeleteVoterRole")
+	proto.RegisterType((*MsgDeleteVoterRoleResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgDeleteVoterRoleResponse")
+	proto.RegisterType((*MsgDelegateVote)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgDelegateVote")
+	proto.RegisterType((*MsgDelegateVoteResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgDelegateVoteResponse")
+	proto.RegisterType((*MsgRevokeVoteDelegation)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgRevokeVoteDelegation")
+	proto.RegisterType((*MsgRevokeVoteDelegationResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgRevokeVoteDelegationResponse")
+	proto.RegisterType((*MsgTimelockMessages)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgTimelockMessages")
+	proto.RegisterType((*MsgTimelockMessagesResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgTimelockMessagesResponse")
+	proto.RegisterType((*MsgVetoProposal)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgVetoProposal")
+	proto.RegisterType((*MsgVetoProposalResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgVetoProposalResponse")
+	proto.RegisterType((*MsgSuspendVoterRole)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgSuspendVoterRole")
+	proto.RegisterType((*MsgSuspendVoterRoleResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgSuspendVoterRoleResponse")
+	proto.RegisterType((*MsgReinstateVoterRole)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgReinstateVoterRole")
+	proto.RegisterType((*MsgReinstateVoterRoleResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgReinstateVoterRoleResponse")
+	proto.RegisterType((*MsgNominateVoterRole)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgNominateVoterRole")
+	proto.RegisterType((*MsgNominateVoterRoleResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgNominateVoterRoleResponse")
+	proto.RegisterType((*MsgApproveNomination)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgApproveNomination")
+	proto.RegisterType((*MsgApproveNominationResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgApproveNominationResponse")
+	proto.RegisterType((*MsgRejectNomination)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgRejectNomination")
+	proto.RegisterType((*MsgRejectNominationResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgRejectNominationResponse")
+	proto.RegisterType((*MsgCreateRoleDistribution)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgCreateRoleDistribution")
+	proto.RegisterType((*MsgCreateRoleDistributionResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgCreateRoleDistributionResponse")
+	proto.RegisterType((*MsgClaimVoterRole)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgClaimVoterRole")
+	proto.RegisterType((*MsgClaimVoterRoleResponse)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgClaimVoterRoleResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmosweightedgovernancesdk/voting/v1/tx.proto", fileDescriptor_31697e12b5f6d2c8)
+}
+
+var fileDescriptor_31697e12b5f6d2c8 = []byte{
+	// 1644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0xd5,
+	0x16, 0xcf, 0xd8, 0xf9, 0xb0, 0xaf, 0xd3, 0xf4, 0x65, 0x5e, 0x5e, 0xeb, 0xb8, 0xad, 0x93, 0x8c,
+	0xde, 0xd3, 0xb3, 0x22, 0x32, 0x26, 0x69, 0x55, 0xb5, 0x06, 0x95, 0xda, 0x09, 0xfd, 0x10, 0x18,
+	0x95, 0x29, 0xed, 0x82, 0x8d, 0x35, 0xf6, 0xdc, 0x4c, 0xa6, 0xb1, 0xe7, 0x8e, 0xe6, 0x5e, 0x9b,
+	0x5a, 0x6c, 0x10, 0x08, 0x16, 0x08, 0x09, 0x84, 0x84, 0x84, 0x58, 0xb0, 0x02, 0x09, 0xb1, 0x40,
+	0x59, 0xb0, 0x86, 0x6d, 0x17, 0x45, 0xaa, 0x58, 0xb1, 0x02, 0xd4, 0x82, 0xb2, 0xe2, 0x4f, 0x40,
+	0x42, 0xf7, 0x63, 0xc6, 0xf6, 0x8c, 0x9d, 0xcc, 0xd8, 0x85, 0x4d, 0xe2, 0x7b, 0xce, 0xfd, 0xdd,
+	0x7b, 0xce, 0xf9, 0xdd, 0x7b, 0xee, 0x39, 0x03, 0xd4, 0x06, 0xc2, 0x2d, 0x84, 0xdf, 0x80, 0x96,
+	0xb9, 0x47, 0xa0, 0x61, 0xa2, 0x0e, 0x74, 0x6d, 0xdd, 0x6e, 0x40, 0x6c, 0xec, 0x17, 0x3b, 0x88,
+	0x58, 0xb6, 0x59, 0xec, 0x6c, 0x16, 0xc9, 0x7d, 0xd5, 0x71, 0x11, 0x41, 0xf2, 0xff, 0x8e, 0x98,
+	0xaf, 0xf2, 0xf9, 0x6a, 0x67, 0x33, 0xb7, 0xa8, 0xb7, 0x2c, 0x1b, 0x15, 0xd9, 0x5f, 0x8e, 0xcc,
+	0xe5, 0x39, 0xb2, 0x58, 0xd7, 0x31, 0x2c, 0x76, 0x36, 0xeb, 0x90, 0xe8, 0x9b, 0xc5, 0x06, 0xb2,
+	0x6c, 0xa1, 0x3f, 0x2d, 0xf4, 0x2d, 0xcc, 0x76, 0x6c, 0x61, 0x53, 0x28, 0x96, 0xb9, 0xa2, 0xc6,
+	0x46, 0x45, 0x3e, 0x10, 0xaa, 0xad, 0x68, 0xd6, 0x3b, 0xba, 0xab, 0xb7, 0x3c, 0xcc, 0xc5, 0x68,
+	0x98, 0x0e, 0x22, 0xd0, 0xad, 0xb9, 0xa8, 0x09, 0x05, 0x6e, 0xc9, 0x44, 0x26, 0xe2, 0x36, 0xd0,
+	0x5f, 0x9e, 0x71, 0x26, 0x42, 0x66, 0x13, 0x16, 0xd9, 0xa8, 0xde, 0xde, 0x2d, 0xea, 0x76, 0x97,
+	0xab, 0x94, 0xdf, 0x24, 0x70, 0xb2, 0x8a, 0xcd, 0x3b, 0x8e, 0xa1, 0x13, 0x78, 0x8b, 0x99, 0x20,
+	0x5f, 0x04, 0x69, 0xbd, 0x4d, 0xf6, 0x90, 0x6b, 0x91, 0x6e, 0x56, 0x5a, 0x95, 0x0a, 0xe9, 0x4a,
+	0xf6, 0xc7, 0x6f, 0x37, 0x96, 0x84, 0x57, 0x65, 0xc3, 0x70, 0x21, 0xc6, 0xb7, 0x89, 0x6b, 0xd9,
+	0xa6, 0xd6, 0x9b, 0x2a, 0xdf, 0x02, 0xb3, 0xdc, 0x89, 0x6c, 0x62, 0x55, 0x2a, 0x64, 0xb6, 0x36,
+	0xd4, 0x48, 0x3c, 0xa8, 0x7c, 0xdb, 0x4a, 0xfa, 0xc1, 0xcf, 0x2b, 0x53, 0x5f, 0x1d, 0x1e, 0xac,
+	0x4b, 0x9a, 0x58, 0xa7, 0x74, 0xfd, 0xed, 0xc3, 0x83, 0xf5, 0xde, 0x0e, 0xef, 0x1f, 0x1e, 0xac,
+	0x5f, 0x38, 0x2a, 0x32, 0xf7, 0xbd, 0xd8, 0x04, 0x5c, 0x52, 0x96, 0xc1, 0xe9, 0x80, 0x48, 0x83,
+	0xd8, 0x41, 0x36, 0x86, 0xca, 0x1f, 0x09, 0x20, 0x57, 0xb1, 0xb9, 0xed, 0x42, 0x9d, 0xc0, 0xbb,
+	0x34, 0xa0, 0x1a, 0x6a, 0x42, 0x79, 0x0b, 0xcc, 0x35, 0xa8, 0x08, 0xb9, 0xc7, 0x86, 0xc0, 0x9b,
+	0x28, 0x67, 0xc1, 0x9c, 0xce, 0x35, 0x2c, 0x02, 0x69, 0xcd, 0x1b, 0xca, 0x32, 0x98, 0xa6, 0x2c,
+	0x65, 0x93, 0x4c, 0xcc, 0x7e, 0xcb, 0x79, 0x00, 0x5a, 0xed, 0x26, 0xb1, 0x9c, 0xa6, 0x05, 0xdd,
+	0xec, 0x34, 0xd3, 0xf4, 0x49, 0xe4, 0x65, 0x90, 0xd2, 0x0d, 0x03, 0x1a, 0x35, 0x9d, 0x64, 0x67,
+	0x56, 0xa5, 0x42, 0x92, 0x2d, 0x07, 0x8d, 0x32, 0xe9, 0xa9, 0xea, 0xdd, 0xec, 0xac, 0xbf, 0x13,
+	0x34, 0x2a, 0x5d, 0xd9, 0x01, 0x4b, 0x2d, 0x6c, 0xd6, 0x48, 0xd7, 0x81, 0xb5, 0xde, 0x62, 0x38,
+	0x3b, 0xb7, 0x9a, 0x2c, 0x64, 0xb6, 0x2e, 0x45, 0xa4, 0xa4, 0x8a, 0xcd, 0xd7, 0xba, 0x0e, 0xac,
+	0xfa, 0x0b, 0x54, 0xa6, 0x29, 0x3b, 0x9a, 0xdc, 0x0a, 0x2a, 0xb0, 0xbc, 0x02, 0x32, 0x1d, 0x48,
+	0x50, 0x6d, 0x0f, 0x35, 0x0d, 0xe8, 0x66, 0x53, 0xab, 0x52, 0x21, 0xa5, 0x01, 0x2a, 0xba, 0xc1,
+	0x24, 0xa5, 0x79, 0xca, 0xa2, 0x17, 0x24, 0xe5, 0x19, 0x90, 0x0b, 0x87, 0xdb, 0x63, 0x43, 0x5e,
+	0x00, 0x09, 0xcb, 0x60, 0x11, 0x9f, 0xd6, 0x12, 0x96, 0xa1, 0xfc, 0xc9, 0xd9, 0xe1, 0xcc, 0x4d,
+	0xc6, 0x0e, 0x5f, 0x3a, 0xe1, 0x2d, 0xdd, 0xcf, 0x56, 0x72, 0x38, 0x5b, 0xd3, 0x23, 0xd9, 0x9a,
+	0x39, 0x92, 0xad, 0xd9, 0xd1, 0x6c, 0xcd, 0x45, 0x63, 0x2b, 0xf5, 0x4f, 0xb1, 0x95, 0x3e, 0x86,
+	0xad, 0xb3, 0x8c, 0xad, 0x40, 0xf8, 0xfd, 0xbb, 0xf3, 0x8e, 0xc4, 0xd8, 0xd9, 0x81, 0x4d, 0xf8,
+	0xb4, 0xd9, 0x39, 0x03, 0xd2, 0xbb, 0xc8, 0xad, 0x35, 0xf4, 0x36, 0xe6, 0xd7, 0x26, 0xa5, 0xa5,
+	0x76, 0x91, 0xbb, 0x4d, 0xc7, 0x43, 0x6d, 0x0c, 0x18, 0xe1, 0xdb, 0xf8, 0x3d, 0xcf, 0x70, 0x54,
+	0x6d, 0x0a, 0x27, 0x68, 0x86, 0x33, 0xf8, 0x38, 0x82, 0x89, 0xbd, 0xa9, 0xf2, 0x55, 0xb0, 0xe0,
+	0x42, 0xc7, 0x85, 0x18, 0xda, 0x44, 0x27, 0x56, 0x07, 0xf2, 0x7b, 0x7e, 0x04, 0x38, 0x30, 0x5f,
+	0x56, 0xc0, 0x09, 0x9f, 0xf0, 0xb6, 0xdb, 0xa4, 0x47, 0x2f, 0x59, 0x48, 0x6b, 0x19, 0xc1, 0xd4,
+	0x1d, 0xb7, 0x89, 0x4b, 0x0b, 0x2c, 0xeb, 0xf9, 0xbb, 0x8a, 0xe4, 0xd5, 0xef, 0x80, 0xef, 0x9c,
+	0xce, 0x54, 0x1a, 0xec, 0xa0, 0x7d, 0xa6, 0x10, 0x93, 0x2c, 0x64, 0x8f, 0xeb, 0x63, 0x68, 0xf7,
+	0x35, 0xb0, 0x32, 0x62, 0x0b, 0xdf, 0x8a, 0x87, 0x12, 0xf8, 0x37, 0x3d, 0x83, 0x56, 0x0b, 0x36,
+	0x51, 0x63, 0xbf, 0x0a, 0x31, 0xd6, 0x4d, 0x38, 0xfe, 0x43, 0xf2, 0x2c, 0x48, 0xb5, 0xc4, 0x1a,
+	0xd9, 0x04, 0xbb, 0x09, 0x4b, 0x2a, 0x7f, 0xc2, 0x54, 0xef, 0x09, 0x53, 0xcb, 0x76, 0x57, 0xf3,
+	0x67, 0x95, 0x5e, 0x0a, 0x3f, 0x14, 0x97, 0xa2, 0x3e, 0x14, 0x41, 0xb3, 0x95, 0x73, 0xe0, 0xcc,
+	0x10, 0xb1, 0xef, 0xad, 0xcd, 0xce, 0xd3, 0x5d, 0x48, 0xd0, 0x2d, 0x17, 0x39, 0x08, 0xeb, 0x4d,
+	0x59, 0x05, 0x33, 0xec, 0x29, 0x3e, 0xd6, 0x49, 0x3e, 0x8d, 0x5e, 0x42, 0x47, 0x60, 0x6b, 0xfe,
+	0xa9, 0x07, 0x9e, 0xe8, 0xa6, 0x51, 0x02, 0xd4, 0x1f, 0x3e, 0x59, 0xd9, 0x64, 0x1c, 0xf7, 0xef,
+	0xe7, 0x67, 0xcb, 0x53, 0x60, 0x96, 0xde, 0x5c, 0xc8, 0x33, 0x66, 0x4a, 0x13, 0x23, 0xe5, 0x77,
+	0x4e, 0xc8, 0xed, 0x36, 0x76, 0xa0, 0x6d, 0xf4, 0x2e, 0xe6, 0xb8, 0x84, 0x04, 0x2f, 0xe7, 0x29,
+	0x30, 0xeb, 0x42, 0x1d, 0x23, 0x5b, 0x64, 0x4e, 0x31, 0x92, 0xd7, 0xc0, 0xbc, 0x0b, 0x2d, 0x1b,
+	0x13, 0x9d, 0x40, 0x9a, 0x08, 0xa7, 0x59, 0x22, 0xcc, 0xf8, 0xb2, 0x32, 0x99, 0x88, 0xa9, 0xa0,
+	0x3f, 0x82, 0xa9, 0xa0, 0xd8, 0x67, 0xea, 0x1b, 0x09, 0xfc, 0x87, 0x9d, 0x5d, 0xb1, 0xfd, 0x53,
+	0x0f, 0x44, 0xa9, 0x1a, 0xf6, 0xa6, 0x14, 0xd5, 0x9b, 0xb0, 0x59, 0xca, 0x0a, 0x38, 0x37, 0x54,
+	0xd1, 0x5f, 0xac, 0x2c, 0x55, 0xb1, 0xf9, 0x0a, 0x6a, 0x59, 0x76, 0xd0, 0x21, 0x9b, 0x0b, 0xa3,
+	0xdc, 0x76, 0x7f, 0x6a, 0xcc, 0x92, 0xe5, 0xbf, 0xe0, 0xc4, 0xbd, 0x36, 0x26, 0xd6, 0xae, 0xd5,
+	0x60, 0x19, 0x40, 0xbc, 0x90, 0x83, 0x42, 0xf9, 0x4d, 0x30, 0x67, 0x40, 0x07, 0x61, 0x8b, 0xd6,
+	0x2d, 0xf4, 0xf6, 0x2e, 0x8b, 0x77, 0x4c, 0xa5, 0x65, 0xb5, 0x2a, 0xca, 0x6a, 0x75, 0x1b, 0x59,
+	0x76, 0xe5, 0x1a, 0x7d, 0xa8, 0xbe, 0xfe, 0x65, 0xa5, 0x60, 0x5a, 0x64, 0xaf, 0x5d, 0x57, 0x1b,
+	0xa8, 0x25, 0xaa, 0x67, 0xf1, 0x6f, 0x83, 0x86, 0x8d, 0xa6, 0x4b, 0xcc, 0x00, 0xf8, 0xb3, 0xc3,
+	0x83, 0xf5, 0x79, 0x9a, 0x81, 0x1a, 0xdd, 0x1a, 0x2d, 0xcc, 0x31, 0xaf, 0x18, 0xbd, 0x1d, 0x4b,
+	0x2f, 0x33, 0x46, 0x7c, 0x07, 0x29, 0x23, 0x97, 0xa3, 0x32, 0x12, 0x0a, 0xab, 0xa2, 0x82, 0xb3,
+	0xc3, 0xe4, 0x23, 0xcb, 0x95, 0x87, 0x12, 0xe3, 0xa7, 0xec, 0x38, 0x2e, 0xea, 0x40, 0x81, 0x13,
+	0xd9, 0xf8, 0xa9, 0xdc, 0xbc, 0xc1, 0x32, 0x24, 0x19, 0x2c, 0x43, 0x84, 0xfb, 0x03, 0x07, 0x32,
+	0xb2, 0xfb, 0x21, 0xab, 0x95, 0x0a, 0x73, 0x3f, 0x24, 0xf7, 0xdd, 0x57, 0xc0, 0x89, 0x5e, 0x0b,
+	0x52, 0xf3, 0x23, 0x91, 0xe9, 0x78, 0x81, 0xba, 0x69, 0x28, 0x3f, 0xf0, 0x5c, 0xa4, 0xc1, 0x7b,
+	0xb0, 0x41, 0xfe, 0x86, 0x88, 0xac, 0x81, 0xf9, 0x7a, 0xdb, 0xb5, 0x6b, 0xde, 0x91, 0xe3, 0xb5,
+	0x42, 0x86, 0xca, 0x76, 0xc4, 0x99, 0x98, 0x24, 0xe7, 0x04, 0xed, 0x16, 0x39, 0x27, 0x28, 0xf6,
+	0x6f, 0xe8, 0xbb, 0x09, 0xb0, 0xec, 0xd7, 0xb7, 0x34, 0x04, 0x3b, 0x16, 0x26, 0xae, 0x55, 0x6f,
+	0x4f, 0xe4, 0xf4, 0x0a, 0xc8, 0xb4, 0xa0, 0xbb, 0xdf, 0x84, 0x35, 0x17, 0x21, 0xc2, 0xbc, 0x9f,
+	0xd7, 0x00, 0x17, 0x69, 0x08, 0x11, 0x3a, 0xa1, 0xd1, 0xd4, 0xad, 0x56, 0x0d, 0x13, 0xdd, 0xe5,
+	0x41, 0x48, 0x6a, 0x80, 0x89, 0x6e, 0x53, 0x09, 0xad, 0xa7, 0xf8, 0x04, 0x68, 0x1b, 0x22, 0x2f,
+	0xa7, 0x98, 0xe0, 0x45, 0xdb, 0x28, 0xbd, 0x1a, 0x0e, 0xd0, 0x95, 0xa8, 0x01, 0x1a, 0xee, 0xa9,
+	0x72, 0x1e, 0xac, 0x8d, 0x54, 0x8e, 0xbc, 0x3e, 0x5f, 0x26, 0xc0, 0x22, 0x45, 0x51, 0xbb, 0x7a,
+	0xb9, 0xed, 0x02, 0xe0, 0x96, 0xea, 0x36, 0x39, 0x36, 0x66, 0xfe, 0x4c, 0xf9, 0xff, 0xe0, 0xa4,
+	0xd1, 0xb7, 0x67, 0xef, 0x9d, 0x5d, 0xe8, 0x17, 0xdf, 0x34, 0xc6, 0xea, 0xcd, 0xce, 0x01, 0x00,
+	0xef, 0x3b, 0x96, 0x0b, 0x71, 0xaf, 0x3b, 0x4b, 0x0b, 0x49, 0x99, 0xc8, 0x4b, 0x60, 0xc6, 0x71,
+	0x11, 0xda, 0xcd, 0xce, 0xae, 0x26, 0x0b, 0xf3, 0x1a, 0x1f, 0x94, 0x6e, 0xd0, 0x28, 0xfb, 0x06,
+	0xd2, 0x20, 0x5f, 0x8c, 0x1c, 0xe4, 0x81, 0x88, 0x28, 0x2f, 0xf0, 0x33, 0x36, 0x20, 0x8c, 0x73,
+	0x29, 0xb7, 0xbe, 0x5b, 0x04, 0xc9, 0x2a, 0x36, 0xe5, 0xf7, 0x24, 0x30, 0x3f, 0xd8, 0xfb, 0x47,
+	0x6f, 0x39, 0xfa, 0x71, 0xb9, 0x2b, 0xe3, 0xe1, 0x7c, 0xa3, 0x3f, 0x94, 0xc0, 0xc9, 0x60, 0x0b,
+	0x7e, 0x39, 0xfa, 0x9a, 0x01, 0x68, 0xae, 0x3c, 0x36, 0x74, 0xc0, 0xa2, 0x60, 0xdb, 0x79, 0x39,
+	0xae, 0x97, 0x63, 0x59, 0x34, 0xa2, 0xdb, 0x62, 0x16, 0x05, 0x5b, 0xad, 0x18, 0x16, 0x05, 0xa0,
+	0x71, 0x2c, 0x1a, 0xd1, 0x5b, 0xb1, 0xe3, 0x33, 0xd8, 0x58, 0xc5, 0x5b, 0xd3, 0xc3, 0xc5, 0x39,
+	0x3e, 0xc3, 0xfa, 0x20, 0xf9, 0x73, 0x09, 0x2c, 0x0d, 0xed, 0x82, 0x62, 0x2c, 0x3c, 0x0c, 0x9f,
+	0xbb, 0x36, 0x19, 0xde, 0x37, 0xf0, 0x63, 0x09, 0xfc, 0x2b, 0xd4, 0x1f, 0x95, 0x62, 0xf4, 0xf7,
+	0x01, 0x6c, 0xae, 0x32, 0x3e, 0x76, 0x80, 0xbe, 0x81, 0x3e, 0x26, 0x06, 0x7d, 0xfd, 0xb8, 0x38,
+	0xf4, 0x0d, 0xed, 0x63, 0x68, 0x74, 0x42, 0xcd, 0x4a, 0x8c, 0xe8, 0x04, 0xb1, 0x71, 0xa2, 0x33,
+	0xaa, 0x7b, 0x90, 0x3f, 0x95, 0x80, 0x3c, 0xa4, 0x75, 0x78, 0x3e, 0xce, 0x89, 0x08, 0xa2, 0x73,
+	0x3b, 0x93, 0xa0, 0x7d, 0xd3, 0x3e, 0x91, 0xc0, 0x62, 0xb8, 0x07, 0x78, 0x2e, 0xfa, 0xda, 0x21,
+	0x70, 0x6e, 0x7b, 0x02, 0xf0, 0x80, 0x5d, 0xe1, 0xda, 0x37, 0x86, 0x5d, 0x21, 0x70, 0x1c, 0xbb,
+	0x46, 0xd7, 0xa9, 0xf4, 0x7c, 0x85, 0x0a, 0xd0, 0x52, 0x1c, 0x2a, 0x06, 0xb1, 0x71, 0xce, 0xd7,
+	0xa8, 0x4a, 0x51, 0xfe, 0x42, 0x02, 0xa7, 0x46, 0x94, 0x89, 0x57, 0xe3, 0x3e, 0x5f, 0xc1, 0x15,
+	0x72, 0x37, 0x26, 0x5d, 0xc1, 0x37, 0xf3, 0x03, 0x09, 0x2c, 0x04, 0x0a, 0xb2, 0x18, 0xdf, 0x25,
+	0x07, 0x91, 0xb9, 0xab, 0xe3, 0x22, 0x3d, 0x73, 0x72, 0x33, 0x6f, 0xd1, 0x7e, 0xaf, 0x72, 0xfd,
+	0xc1, 0xe3, 0xbc, 0xf4, 0xe8, 0x71, 0x5e, 0xfa, 0xf5, 0x71, 0x5e, 0xfa, 0xe8, 0x49, 0x7e, 0xea,
+	0xd1, 0x93, 0xfc, 0xd4, 0x4f, 0x4f, 0xf2, 0x53, 0xaf, 0x6f, 0x88, 0xbe, 0xd1, 0xdb, 0x62, 0xa3,
+	0xb7, 0xc7, 0xc6, 0x40, 0x59, 0xc5, 0x9a, 0xca, 0xfa, 0x2c, 0xfb, 0xa2, 0x74, 0xfe, 0xaf, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x43, 0xdd, 0x50, 0x64, 0x1a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateVoterRole defines the CreateVoterRole RPC.
+	CreateVoterRole(ctx context.Context, in *MsgCreateVoterRole, opts ...grpc.CallOption) (*MsgCreateVoterRoleResponse, error)
+	// UpdateVoterRole defines the UpdateVoterRole RPC.
+	UpdateVoterRole(ctx context.Context, in *MsgUpdateVoterRole, opts ...grpc.CallOption) (*MsgUpdateVoterRoleResponse, error)
+	// DeleteVoterRole defines the DeleteVoterRole RPC.
+	DeleteVoterRole(ctx context.Context, in *MsgDeleteVoterRole, opts ...grpc.CallOption) (*MsgDeleteVoterRoleResponse, error)
+	// DelegateVote delegates the sender's role-weighted voting power to a representative.
+	DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error)
+	// RevokeVoteDelegation removes the sender's vote delegation.
+	RevokeVoteDelegation(ctx context.Context, in *MsgRevokeVoteDelegation, opts ...grpc.CallOption) (*MsgRevokeVoteDelegationResponse, error)
+	// TimelockMessages holds the messages of a passed proposal until the veto window ends.
+	TimelockMessages(ctx context.Context, in *MsgTimelockMessages, opts ...grpc.CallOption) (*MsgTimelockMessagesResponse, error)
+	// VetoProposal casts the veto of a veto holder on a timelocked proposal.
+	VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error)
+	// SuspendVoterRole suspends a voter role without deleting it.
+	SuspendVoterRole(ctx context.Context, in *MsgSuspendVoterRole, opts ...grpc.CallOption) (*MsgSuspendVoterRoleResponse, error)
+	// ReinstateVoterRole lifts the suspension of a voter role.
+	ReinstateVoterRole(ctx context.Context, in *MsgReinstateVoterRole, opts ...grpc.CallOption) (*MsgReinstateVoterRoleResponse, error)
+	// NominateVoterRole nominates an address for a voter role, locking a deposit.
+	NominateVoterRole(ctx context.Context, in *MsgNominateVoterRole, opts ...grpc.CallOption) (*MsgNominateVoterRoleResponse, error)
+	// ApproveNomination creates the voter role of a pending nomination and refunds its deposit.
+	ApproveNomination(ctx context.Context, in *MsgApproveNomination, opts ...grpc.CallOption) (*MsgApproveNominationResponse, error)
+	// RejectNomination drops a pending nomination, refunding or burning its deposit.
+	RejectNomination(ctx context.Context, in *MsgRejectNomination, opts ...grpc.CallOption) (*MsgRejectNominationResponse, error)
+	// CreateRoleDistribution publishes the Merkle root of voter roles to be claimed.
+	CreateRoleDistribution(ctx context.Context, in *MsgCreateRoleDistribution, opts ...grpc.CallOption) (*MsgCreateRoleDistributionResponse, error)
+	// ClaimVoterRole activates the voter role of the sender listed in a role distribution.
+	ClaimVoterRole(ctx context.Context, in *MsgClaimVoterRole, opts ...grpc.CallOption) (*MsgClaimVoterRoleResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateVoterRole(ctx context.Context, in *MsgCreateVoterRole, opts ...grpc.CallOption) (*MsgCreateVoterRoleResponse, error) {
+	out := new(MsgCreateVoterRoleResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Msg/CreateVoterRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateVoterRole(ctx context.Context, in *MsgUpdateVoterRole, opts ...grpc.CallOption) (*MsgUpdateVoterRoleResponse, error) {
+	out := new(MsgUpdateVoterRoleResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Msg/UpdateVoterRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteVoterRole(ctx context.Context, in *MsgDeleteVoterRole, opts ...grpc.CallOption) (*MsgDeleteVoterRoleResponse, error) {
+	out := new(MsgDeleteVoterRoleResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Msg/DeleteVoterRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error) {
+	out := new(MsgDelegateVoteResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Msg/DelegateVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeVoteDelegation(ctx context.Context, in *MsgRevokeVoteDelegation, opts ...grpc.CallOption) (*MsgRevokeVoteDelegationResponse, error) {
+	out := new(MsgRevokeVoteDelegationResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Msg/RevokeVoteDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TimelockMessages(ctx context.Context, in *MsgTimelockMessages, opts ...grpc.CallOption) (*MsgTimelockMessagesResponse, error) {
+	out := new(MsgTimelockMessagesResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Msg/TimelockMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error) {
+	out := new(MsgVetoProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Msg/VetoProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SuspendVoterRole(ctx context.Context, in *MsgSuspendVoterRole, opts ...grpc.CallOption) (*MsgSuspendVoterRoleResponse, error) {
+	out := new(MsgSuspendVoterRoleResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Msg/SuspendVoterRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReinstateVoterRole(ctx context.Context, in *MsgReinstateVoterRole, opts ...grpc.CallOption) (*MsgReinstateVoterRoleResponse, error) {
+	out := new(MsgReinstateVoterRoleResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Msg/ReinstateVoterRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) NominateVoterRole(ctx context.Context, in *MsgNominateVoterRole, opts ...grpc.CallOption) (*MsgNominateVoterRoleResponse, error) {
+	out := new(MsgNominateVoterRoleResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Msg/NominateVoterRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveNomination(ctx context.Context, in *MsgApproveNomination, opts ...grpc.CallOption) (*MsgApproveNominationResponse, error) {
+	out := new(MsgApproveNominationResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Msg/ApproveNomination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RejectNomination(ctx context.Context, in *MsgRejectNomination, opts ...grpc.CallOption) (*MsgRejectNominationResponse, error) {
+	out := new(MsgRejectNominationResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Msg/RejectNomination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateRoleDistribution(ctx context.Context, in *MsgCreateRoleDistribution, opts ...grpc.CallOption) (*MsgCreateRoleDistributionResponse, error) {
+	out := new(MsgCreateRoleDistributionResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Msg/CreateRoleDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimVoterRole(ctx context.Context, in *MsgClaimVoterRole, opts ...grpc.CallOption) (*MsgClaimVoterRoleResponse, error) {
+	out := new(MsgClaimVoterRoleResponse)
+	err := c.cc.Invoke(ctx, "/cosmosweightedgovernancesdk.voting.v1.Msg/ClaimVoterRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CreateVoterRole defines the CreateVoterRole RPC.
+	CreateVoterRole(context.Context, *MsgCreateVoterRole) (*MsgCreateVoterRoleResponse, error)
+	// UpdateVoterRole defines the UpdateVoterRole RPC.
+	UpdateVoterRole(context.Context, *MsgUpdateVoterRole) (*MsgUpdateVoterRoleResponse, error)
+	// DeleteVoterRole defines the DeleteVoterRole RPC.
+	DeleteVoterRole(context.Context, *MsgDeleteVoterRole) (*MsgDeleteVoterRoleResponse, error)
+	// DelegateVote delegates the sender's role-weighted voting power to a representative.
+	DelegateVote(context.Context, *MsgDelegateVote) (*MsgDelegateVoteResponse, error)
+	// RevokeVoteDelegation removes the sender's vote delegation.
+	RevokeVoteDelegation(context.Context, *MsgRevokeVoteDelegation) (*MsgRevokeVoteDelegationResponse, error)
+	// TimelockMessages holds the messages of a passed proposal until the veto window ends.
+	TimelockMessages(context.Context, *MsgTimelockMessages) (*MsgTimelockMessagesResponse, error)
+	// VetoProposal casts the veto of a veto holder on a timelocked proposal.
+	VetoProposal(context.Context, *MsgVetoProposal) (*MsgVetoProposalResponse, error)
+	// SuspendVoterRole suspends a voter role without deleting it.
+	SuspendVoterRole(context.Context, *MsgSuspendVoterRole) (*MsgSuspendVoterRoleResponse, error)
+	// ReinstateVoterRole lifts the suspension of a voter role.
+	ReinstateVoterRole(context.Context, *MsgReinstateVoterRole) (*MsgReinstateVoterRoleResponse, error)
+	// NominateVoterRole nominates an address for a voter role, locking a deposit.
+	NominateVoterRole(context.Context, *MsgNominateVoterRole) (*MsgNominateVoterRoleResponse, error)
+	// ApproveNomination creates the voter role of a pending nomination and refunds its deposit.
+	ApproveNomination(context.Context, *MsgApproveNomination) (*MsgApproveNominationResponse, error)
+	// RejectNomination drops a pending nomination, refunding or burning its deposit.
+	RejectNomination(context.Context, *MsgRejectNomination) (*MsgRejectNominationResponse, error)
+	// CreateRoleDistribution publishes the Merkle root of voter roles to be claimed.
+	CreateRoleDistribution(context.Context, *MsgCreateRoleDistribution) (*MsgCreateRoleDistributionResponse, error)
+	// ClaimVoterRole activates the voter role of the sender listed in a role distribution.
+	ClaimVoterRole(context.Context, *MsgClaimVoterRole) (*MsgClaimVoterRoleResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreateVoterRole(ctx context.Context, req *MsgCreateVoterRole) (*MsgCreateVoterRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVoterRole not implemented")
+}
+func (*UnimplementedMsgServer) UpdateVoterRole(ctx context.Context, req *MsgUpdateVoterRole) (*MsgUpdateVoterRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVoterRole not implemented")
+}
+func (*UnimplementedMsgServer) DeleteVoterRole(ctx context.Context, req *MsgDeleteVoterRole) (*MsgDeleteVoterRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVoterRole not implemented")
+}
+func (*UnimplementedMsgServer) DelegateVote(ctx context.Context, req *MsgDelegateVote) (*MsgDelegateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateVote not implemented")
+}
+func (*UnimplementedMsgServer) RevokeVoteDelegation(ctx context.Context, req *MsgRevokeVoteDelegation) (*MsgRevokeVoteDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeVoteDelegation not implemented")
+}
+func (*UnimplementedMsgServer) TimelockMessages(ctx context.Context, req *MsgTimelockMessages) (*MsgTimelockMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimelockMessages not implemented")
+}
+func (*UnimplementedMsgServer) VetoProposal(ctx context.Context, req *MsgVetoProposal) (*MsgVetoProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VetoProposal not implemented")
+}
+func (*UnimplementedMsgServer) SuspendVoterRole(ctx context.Context, req *MsgSuspendVoterRole) (*MsgSuspendVoterRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendVoterRole not implemented")
+}
+func (*UnimplementedMsgServer) ReinstateVoterRole(ctx context.Context, req *MsgReinstateVoterRole) (*MsgReinstateVoterRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReinstateVoterRole not implemented")
+}
+func (*UnimplementedMsgServer) NominateVoterRole(ctx context.Context, req *MsgNominateVoterRole) (*MsgNominateVoterRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NominateVoterRole not implemented")
+}
+func (*UnimplementedMsgServer) ApproveNomination(ctx context.Context, req *MsgApproveNomination) (*MsgApproveNominationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveNomination not implemented")
+}
+func (*UnimplementedMsgServer) RejectNomination(ctx context.Context, req *MsgRejectNomination) (*MsgRejectNominationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectNomination not implemented")
+}
+func (*UnimplementedMsgServer) CreateRoleDistribution(ctx context.Context, req *MsgCreateRoleDistribution) (*MsgCreateRoleDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoleDistribution not implemented")
+}
+func (*UnimplementedMsgServer) ClaimVoterRole(ctx context.Context, req *MsgClaimVoterRole) (*MsgClaimVoterRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimVoterRole not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateVoterRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateVoterRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateVoterRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Msg/CreateVoterRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateVoterRole(ctx, req.(*MsgCreateVoterRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateVoterRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateVoterRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateVoterRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Msg/UpdateVoterRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateVoterRole(ctx, req.(*MsgUpdateVoterRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteVoterRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteVoterRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteVoterRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Msg/DeleteVoterRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteVoterRole(ctx, req.(*MsgDeleteVoterRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Msg/DelegateVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateVote(ctx, req.(*MsgDelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeVoteDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeVoteDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeVoteDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Msg/RevokeVoteDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeVoteDelegation(ctx, req.(*MsgRevokeVoteDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TimelockMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTimelockMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TimelockMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Msg/TimelockMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TimelockMessages(ctx, req.(*MsgTimelockMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VetoProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVetoProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VetoProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Msg/VetoProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VetoProposal(ctx, req.(*MsgVetoProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SuspendVoterRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuspendVoterRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuspendVoterRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Msg/SuspendVoterRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuspendVoterRole(ctx, req.(*MsgSuspendVoterRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReinstateVoterRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReinstateVoterRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReinstateVoterRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Msg/ReinstateVoterRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReinstateVoterRole(ctx, req.(*MsgReinstateVoterRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_NominateVoterRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgNominateVoterRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).NominateVoterRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Msg/NominateVoterRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).NominateVoterRole(ctx, req.(*MsgNominateVoterRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveNomination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveNomination)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveNomination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Msg/ApproveNomination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveNomination(ctx, req.(*MsgApproveNomination))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RejectNomination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectNomination)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectNomination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Msg/RejectNomination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectNomination(ctx, req.(*MsgRejectNomination))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateRoleDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRoleDistribution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRoleDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Msg/CreateRoleDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRoleDistribution(ctx, req.(*MsgCreateRoleDistribution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimVoterRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimVoterRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimVoterRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmosweightedgovernancesdk.voting.v1.Msg/ClaimVoterRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimVoterRole(ctx, req.(*MsgClaimVoterRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmosweightedgovernancesdk.voting.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateVoterRole",
+			Handler:    _Msg_CreateVoterRole_Handler,
+		},
+		{
+			MethodName: "UpdateVoterRole",
+			Handler:    _Msg_UpdateVoterRole_Handler,
+		},
+		{
+			MethodName: "DeleteVoterRole",
+			Handler:    _Msg_DeleteVoterRole_Handler,
+		},
+		{
+			MethodName: "DelegateVote",
+			Handler:    _Msg_DelegateVote_Handler,
+		},
+		{
+			MethodName: "RevokeVoteDelegation",
+			Handler:    _Msg_RevokeVoteDelegation_Handler,
+		},
+		{
+			MethodName: "TimelockMessages",
+			Handler:    _Msg_TimelockMessages_Handler,
+		},
+		{
+			MethodName: "VetoProposal",
+			Handler:    _Msg_VetoProposal_Handler,
+		},
+		{
+			MethodName: "SuspendVoterRole",
+			Handler:    _Msg_SuspendVoterRole_Handler,
+		},
+		{
+			MethodName: "ReinstateVoterRole",
+			Handler:    _Msg_ReinstateVoterRole_Handler,
+		},
+		{
+			MethodName: "NominateVoterRole",
+			Handler:    _Msg_NominateVoterRole_Handler,
+		},
+		{
+			MethodName: "ApproveNomination",
+			Handler:    _Msg_ApproveNomination_Handler,
+		},
+		{
+			MethodName: "RejectNomination",
+			Handler:    _Msg_RejectNomination_Handler,
+		},
+		{
+			MethodName: "CreateRoleDistribution",
+			Handler:    _Msg_CreateRoleDistribution_Handler,
+		},
+		{
+			MethodName: "ClaimVoterRole",
+			Handler:    _Msg_ClaimVoterRole_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmosweightedgovernancesdk/voting/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateVoterRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateVoterRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateVoterRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VetoHolder {
+		i--
+		if m.VetoHolder {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.MsgTypeMultipliers) > 0 {
+		for iNdEx := len(m.MsgTypeMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgTypeMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AddedBy) > 0 {
+		i -= len(m.AddedBy)
+		copy(dAtA[i:], m.AddedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AddedBy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AddedAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AddedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Multiplier) > 0 {
+		i -= len(m.Multiplier)
+		copy(dAtA[i:], m.Multiplier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Multiplier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateVoterRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateVoterRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateVoterRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateVoterRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateVoterRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateVoterRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VetoHolder {
+		i--
+		if m.VetoHolder {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.MsgTypeMultipliers) > 0 {
+		for iNdEx := len(m.MsgTypeMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgTypeMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AddedBy) > 0 {
+		i -= len(m.AddedBy)
+		copy(dAtA[i:], m.AddedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AddedBy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AddedAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AddedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Multiplier) > 0 {
+		i -= len(m.Multiplier)
+		copy(dAtA[i:], m.Multiplier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Multiplier)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateVoterRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateVoterRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateVoterRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteVoterRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteVoterRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteVoterRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForCause {
+		i--
+		if m.ForCause {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteVoterRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteVoterRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteVoterRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Representative) > 0 {
+		i -= len(m.Representative)
+		copy(dAtA[i:], m.Representative)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Representative)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeVoteDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeVoteDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeVoteDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeVoteDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeVoteDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeVoteDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTimelockMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTimelockMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTimelockMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTimelockMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTimelockMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTimelockMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Vetoed {
+		i--
+		if m.Vetoed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuspendVoterRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuspendVoterRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuspendVoterRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReinstateAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReinstateAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuspendVoterRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuspendVoterRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuspendVoterRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReinstateVoterRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReinstateVoterRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReinstateVoterRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReinstateVoterRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReinstateVoterRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReinstateVoterRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgNominateVoterRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNominateVoterRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNominateVoterRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Justification) > 0 {
+		i -= len(m.Justification)
+		copy(dAtA[i:], m.Justification)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Justification)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nominator) > 0 {
+		i -= len(m.Nominator)
+		copy(dAtA[i:], m.Nominator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Nominator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgNominateVoterRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNominateVoterRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNominateVoterRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveNomination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveNomination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveNomination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Multiplier) > 0 {
+		i -= len(m.Multiplier)
+		copy(dAtA[i:], m.Multiplier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Multiplier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveNominationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveNominationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveNominationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VoterRoleId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VoterRoleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectNomination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectNomination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectNomination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BurnDeposit {
+		i--
+		if m.BurnDeposit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectNominationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectNominationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectNominationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRoleDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRoleDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRoleDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClaimEnd != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClaimEnd))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ClaimStart != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClaimStart))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRoleDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRoleDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRoleDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimVoterRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimVoterRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimVoterRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Multiplier) > 0 {
+		i -= len(m.Multiplier)
+		copy(dAtA[i:], m.Multiplier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Multiplier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DistributionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DistributionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Claimant) > 0 {
+		i -= len(m.Claimant)
+		copy(dAtA[i:], m.Claimant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimVoterRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimVoterRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimVoterRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VoterRoleId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VoterRoleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateVoterRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Multiplier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AddedAt != 0 {
+		n += 1 + sovTx(uint64(m.AddedAt))
+	}
+	l = len(m.AddedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MsgTypeMultipliers) > 0 {
+		for _, e := range m.MsgTypeMultipliers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.VetoHolder {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreateVoterRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgUpdateVoterRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Multiplier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AddedAt != 0 {
+		n += 1 + sovTx(uint64(m.AddedAt))
+	}
+	l = len(m.AddedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MsgTypeMultipliers) > 0 {
+		for _, e := range m.MsgTypeMultipliers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.VetoHolder {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateVoterRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteVoterRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.ForCause {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgDeleteVoterRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Representative)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDelegateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeVoteDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeVoteDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTimelockMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTimelockMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVetoProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgVetoProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Vetoed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSuspendVoterRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReinstateAt != 0 {
+		n += 1 + sovTx(uint64(m.ReinstateAt))
+	}
+	return n
+}
+
+func (m *MsgSuspendVoterRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReinstateVoterRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgReinstateVoterRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgNominateVoterRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Nominator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Justification)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgNominateVoterRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgApproveNomination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Multiplier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveNominationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VoterRoleId != 0 {
+		n += 1 + sovTx(uint64(m.VoterRoleId))
+	}
+	return n
+}
+
+func (m *MsgRejectNomination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.BurnDeposit {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRejectNominationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateRoleDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClaimStart != 0 {
+		n += 1 + sovTx(uint64(m.ClaimStart))
+	}
+	if m.ClaimEnd != 0 {
+		n += 1 + sovTx(uint64(m.ClaimEnd))
+	}
+	return n
+}
+
+func (m *MsgCreateRoleDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgClaimVoterRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DistributionId != 0 {
+		n += 1 + sovTx(uint64(m.DistributionId))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Multiplier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAt))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimVoterRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VoterRoleId != 0 {
+		n += 1 + sovTx(uint64(m.VoterRoleId))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateVoterRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVoterRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVoterRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Multiplier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+			}
+			m.AddedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeMultipliers = append(m.MsgTypeMultipliers, MsgTypeMultiplier{})
+			if err := m.MsgTypeMultipliers[len(m.MsgTypeMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoHolder", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VetoHolder = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateVoterRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVoterRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVoterRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateVoterRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVoterRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVoterRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Multiplier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+			}
+			m.AddedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeMultipliers = append(m.MsgTypeMultipliers, MsgTypeMultiplier{})
+			if err := m.MsgTypeMultipliers[len(m.MsgTypeMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoHolder", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VetoHolder = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateVoterRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVoterRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVoterRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteVoterRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteVoterRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteVoterRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForCause", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForCause = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteVoterRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteVoterRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteVoterRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Representative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Representative = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeVoteDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeVoteDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeVoteDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeVoteDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeVoteDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeVoteDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTimelockMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTimelockMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTimelockMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &any.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTimelockMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTimelockMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTimelockMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVetoProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vetoed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Vetoed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuspendVoterRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuspendVoterRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuspendVoterRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReinstateAt", wireType)
+			}
+			m.ReinstateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReinstateAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuspendVoterRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuspendVoterRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuspendVoterRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReinstateVoterRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReinstateVoterRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReinstateVoterRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReinstateVoterRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReinstateVoterRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReinstateVoterRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNominateVoterRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNominateVoterRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNominateVoterRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nominator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nominator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Justification", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Justification = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgDeleteVoterRoleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgNominateVoterRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNominateVoterRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNominateVoterRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveNomination) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1210,10 +6461,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveNomination: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveNomination: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1249,10 +6500,29 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1262,24 +6532,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Multiplier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1302,7 +6571,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveNominationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1325,12 +6594,31 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveNominationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveNominationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterRoleId", wireType)
+			}
+			m.VoterRoleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoterRoleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1352,7 +6640,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateVoterRole) Unmarshal(dAtA []byte) error {
+func (m *MsgRejectNomination) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1375,15 +6663,15 @@ func (m *MsgCreateVoterRole) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateVoterRole: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejectNomination: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateVoterRole: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejectNomination: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1411,13 +6699,13 @@ func (m *MsgCreateVoterRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1427,27 +6715,134 @@ func (m *MsgCreateVoterRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnDeposit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnDeposit = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectNominationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectNominationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectNominationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRoleDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRoleDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRoleDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1475,13 +6870,13 @@ func (m *MsgCreateVoterRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1491,29 +6886,31 @@ func (m *MsgCreateVoterRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Multiplier = string(dAtA[iNdEx:postIndex])
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimStart", wireType)
 			}
-			m.AddedAt = 0
+			m.ClaimStart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1523,16 +6920,16 @@ func (m *MsgCreateVoterRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AddedAt |= int64(b&0x7F) << shift
+				m.ClaimStart |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimEnd", wireType)
 			}
-			var stringLen uint64
+			m.ClaimEnd = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1542,24 +6939,11 @@ func (m *MsgCreateVoterRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ClaimEnd |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AddedBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1581,7 +6965,7 @@ func (m *MsgCreateVoterRole) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateVoterRoleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateRoleDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1604,10 +6988,10 @@ func (m *MsgCreateVoterRoleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateVoterRoleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateRoleDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateVoterRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateRoleDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1650,7 +7034,7 @@ func (m *MsgCreateVoterRoleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateVoterRole) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimVoterRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1673,15 +7057,15 @@ func (m *MsgUpdateVoterRole) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateVoterRole: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimVoterRole: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateVoterRole: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimVoterRole: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1709,13 +7093,13 @@ func (m *MsgUpdateVoterRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Claimant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
 			}
-			m.Id = 0
+			m.DistributionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1725,44 +7109,12 @@ func (m *MsgUpdateVoterRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.DistributionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
@@ -1794,7 +7146,7 @@ func (m *MsgUpdateVoterRole) Unmarshal(dAtA []byte) error {
 			}
 			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
 			}
@@ -1826,11 +7178,11 @@ func (m *MsgUpdateVoterRole) Unmarshal(dAtA []byte) error {
 			}
 			m.Multiplier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			m.AddedAt = 0
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1840,16 +7192,16 @@ func (m *MsgUpdateVoterRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AddedAt |= int64(b&0x7F) << shift
+				m.ExpiresAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1859,23 +7211,23 @@ func (m *MsgUpdateVoterRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddedBy = string(dAtA[iNdEx:postIndex])
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1898,57 +7250,7 @@ func (m *MsgUpdateVoterRole) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateVoterRoleResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateVoterRoleResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateVoterRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDeleteVoterRole) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimVoterRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1971,49 +7273,17 @@ func (m *MsgDeleteVoterRole) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteVoterRole: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimVoterRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteVoterRole: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimVoterRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterRoleId", wireType)
 			}
-			m.Id = 0
+			m.VoterRoleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2023,7 +7293,7 @@ func (m *MsgDeleteVoterRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.VoterRoleId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2049,56 +7319,6 @@ func (m *MsgDeleteVoterRole) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteVoterRoleResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteVoterRoleResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteVoterRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
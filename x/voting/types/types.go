@@ -1 +1,6 @@
 package types
+
+// IsSuspended reports whether the voter role is suspended.
+func (r VoterRole) IsSuspended() bool {
+	return r.Suspension != nil
+}
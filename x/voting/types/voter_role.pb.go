@@ -5,6 +5,10 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -31,6 +35,19 @@ type VoterRole struct {
 	AddedAt    int64  `protobuf:"varint,5,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
 	AddedBy    string `protobuf:"bytes,6,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
 	Creator    string `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
+	// msg_type_multipliers override the multiplier on proposals containing these message types.
+	MsgTypeMultipliers []MsgTypeMultiplier `protobuf:"bytes,8,rep,name=msg_type_multipliers,json=msgTypeMultipliers,proto3" json:"msg_type_multipliers"`
+	// veto_holder lets the holder veto passed proposals during the veto window.
+	VetoHolder bool `protobuf:"varint,9,opt,name=veto_holder,json=vetoHolder,proto3" json:"veto_holder,omitempty"`
+	// auto_assigned marks roles granted by the module itself rather than by governance.
+	AutoAssigned bool `protobuf:"varint,10,opt,name=auto_assigned,json=autoAssigned,proto3" json:"auto_assigned,omitempty"`
+	// suspension is set while the role is suspended, which counts it with a multiplier of 1.0.
+	Suspension *RoleSuspension `protobuf:"bytes,11,opt,name=suspension,proto3" json:"suspension,omitempty"`
+	// bond is held by the module account while the role is active, and returned when the
+	// role is removed unless it is revoked for cause
+	Bond github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=bond,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bond"`
+	// expires_at is the unix time the role is removed at, 0 for roles that do not expire
+	ExpiresAt int64 `protobuf:"varint,13,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (m *VoterRole) Reset()         { *m = VoterRole{} }
@@ -115,8 +132,169 @@ func (m *VoterRole) GetCreator() string {
 	return ""
 }
 
+func (m *VoterRole) GetMsgTypeMultipliers() []MsgTypeMultiplier {
+	if m != nil {
+		return m.MsgTypeMultipliers
+	}
+	return nil
+}
+
+func (m *VoterRole) GetVetoHolder() bool {
+	if m != nil {
+		return m.VetoHolder
+	}
+	return false
+}
+
+func (m *VoterRole) GetAutoAssigned() bool {
+	if m != nil {
+		return m.AutoAssigned
+	}
+	return false
+}
+
+func (m *VoterRole) GetSuspension() *RoleSuspension {
+	if m != nil {
+		return m.Suspension
+	}
+	return nil
+}
+
+func (m *VoterRole) GetBond() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Bond
+	}
+	return nil
+}
+
+func (m *VoterRole) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+// RoleSuspension records why and until when a voter role is suspended.
+type RoleSuspension struct {
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// suspended_at is the unix time the role was suspended
+	SuspendedAt int64 `protobuf:"varint,2,opt,name=suspended_at,json=suspendedAt,proto3" json:"suspended_at,omitempty"`
+	// reinstate_at is the unix time the role is reinstated automatically, 0 to wait for
+	// MsgReinstateVoterRole
+	ReinstateAt int64 `protobuf:"varint,3,opt,name=reinstate_at,json=reinstateAt,proto3" json:"reinstate_at,omitempty"`
+}
+
+func (m *RoleSuspension) Reset()         { *m = RoleSuspension{} }
+func (m *RoleSuspension) String() string { return proto.CompactTextString(m) }
+func (*RoleSuspension) ProtoMessage()    {}
+func (*RoleSuspension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a5f266a470c7d92, []int{1}
+}
+func (m *RoleSuspension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleSuspension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleSuspension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleSuspension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleSuspension.Merge(m, src)
+}
+func (m *RoleSuspension) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleSuspension) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleSuspension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleSuspension proto.InternalMessageInfo
+
+func (m *RoleSuspension) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *RoleSuspension) GetSuspendedAt() int64 {
+	if m != nil {
+		return m.SuspendedAt
+	}
+	return 0
+}
+
+func (m *RoleSuspension) GetReinstateAt() int64 {
+	if m != nil {
+		return m.ReinstateAt
+	}
+	return 0
+}
+
+// MsgTypeMultiplier overrides a role's multiplier for one proposal message type.
+type MsgTypeMultiplier struct {
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Multiplier string `protobuf:"bytes,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+}
+
+func (m *MsgTypeMultiplier) Reset()         { *m = MsgTypeMultiplier{} }
+func (m *MsgTypeMultiplier) String() string { return proto.CompactTextString(m) }
+func (*MsgTypeMultiplier) ProtoMessage()    {}
+func (*MsgTypeMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a5f266a470c7d92, []int{2}
+}
+func (m *MsgTypeMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTypeMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTypeMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTypeMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTypeMultiplier.Merge(m, src)
+}
+func (m *MsgTypeMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTypeMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTypeMultiplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTypeMultiplier proto.InternalMessageInfo
+
+func (m *MsgTypeMultiplier) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgTypeMultiplier) GetMultiplier() string {
+	if m != nil {
+		return m.Multiplier
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*VoterRole)(nil), "cosmosweightedgovernancesdk.voting.v1.VoterRole")
+	proto.RegisterType((*RoleSuspension)(nil), "cosmosweightedgovernancesdk.voting.v1.RoleSuspension")
+	proto.RegisterType((*MsgTypeMultiplier)(nil), "cosmosweightedgovernancesdk.voting.v1.MsgTypeMultiplier")
 }
 
 func init() {
@@ -124,24 +302,45 @@ func init() {
 }
 
 var fileDescriptor_5a5f266a470c7d92 = []byte{
-	// 268 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x50, 0xb1, 0x4e, 0xc3, 0x30,
-	0x14, 0x8c, 0xd3, 0xd0, 0x52, 0x0f, 0x0c, 0x9e, 0xcc, 0x62, 0x45, 0x48, 0x48, 0x59, 0x92, 0xaa,
-	0x42, 0x62, 0xa7, 0x0b, 0x7b, 0x06, 0x06, 0x96, 0x2a, 0xcd, 0x7b, 0x0a, 0x16, 0x69, 0x1c, 0xd9,
-	0x26, 0x90, 0xbf, 0xe0, 0x77, 0xf8, 0x03, 0xc6, 0x8e, 0x8c, 0x28, 0xf9, 0x11, 0x14, 0x87, 0x50,
-	0xa6, 0x6e, 0xef, 0xee, 0x74, 0x3e, 0xdf, 0xd1, 0xdb, 0x5c, 0x99, 0xbd, 0x32, 0xaf, 0x28, 0x8b,
-	0x27, 0x8b, 0x50, 0xa8, 0x06, 0x75, 0x95, 0x55, 0x39, 0x1a, 0x78, 0x5e, 0x35, 0xca, 0xca, 0xaa,
-	0x58, 0x35, 0xeb, 0xe1, 0x42, 0xbd, 0xd5, 0xaa, 0xc4, 0xa4, 0xd6, 0xca, 0x2a, 0x76, 0x7d, 0xc2,
-	0x97, 0x8c, 0xbe, 0xa4, 0x59, 0x5f, 0x7d, 0x10, 0xba, 0x7c, 0x18, 0xbc, 0xa9, 0x2a, 0x91, 0x5d,
-	0x50, 0x5f, 0x02, 0x27, 0x21, 0x89, 0x82, 0xd4, 0x97, 0xc0, 0x38, 0x5d, 0x64, 0x00, 0x1a, 0x8d,
-	0xe1, 0x7e, 0x48, 0xa2, 0x65, 0x3a, 0x41, 0xc6, 0x68, 0x30, 0x84, 0xf1, 0x99, 0xa3, 0xdd, 0xcd,
-	0x04, 0xa5, 0xfb, 0x97, 0xd2, 0xca, 0xba, 0x94, 0xa8, 0x79, 0xe0, 0x94, 0x7f, 0x0c, 0xbb, 0xa4,
-	0xe7, 0x19, 0x00, 0xc2, 0x36, 0xb3, 0xfc, 0x2c, 0x24, 0xd1, 0xcc, 0x3d, 0x87, 0x70, 0x67, 0x8f,
-	0xd2, 0xae, 0xe5, 0xf3, 0xbf, 0x24, 0x84, 0x4d, 0x3b, 0xfc, 0x21, 0xd7, 0x98, 0x59, 0xa5, 0xf9,
-	0x62, 0x54, 0x7e, 0xe1, 0xe6, 0xfe, 0xb3, 0x13, 0xe4, 0xd0, 0x09, 0xf2, 0xdd, 0x09, 0xf2, 0xde,
-	0x0b, 0xef, 0xd0, 0x0b, 0xef, 0xab, 0x17, 0xde, 0x63, 0x3c, 0x96, 0x8f, 0xa7, 0xf6, 0xf1, 0xb1,
-	0x7e, 0x3c, 0xec, 0xf6, 0x36, 0x2d, 0x67, 0xdb, 0x1a, 0xcd, 0x6e, 0xee, 0x26, 0xbb, 0xf9, 0x09,
-	0x00, 0x00, 0xff, 0xff, 0x17, 0x5a, 0x96, 0x3f, 0x6c, 0x01, 0x00, 0x00,
+	// 598 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0x6e, 0xda, 0xfe, 0xb6, 0xd5, 0xed, 0x26, 0xcd, 0x9a, 0x7e, 0xf2, 0x26, 0x91, 0x85, 0x21,
+	0xa4, 0x68, 0xd2, 0x12, 0x75, 0x08, 0xc4, 0xb5, 0x45, 0x02, 0x2e, 0xbb, 0x04, 0xc6, 0x81, 0x4b,
+	0xe4, 0xd6, 0xaf, 0x32, 0x8b, 0xd4, 0x8e, 0x6c, 0xb7, 0xac, 0xdf, 0x82, 0x33, 0x9f, 0x00, 0x71,
+	0xda, 0xc7, 0xd8, 0x71, 0x47, 0x4e, 0x03, 0x6d, 0x87, 0x7d, 0x0d, 0x64, 0xc7, 0xeb, 0x46, 0x91,
+	0xd0, 0x2e, 0xc9, 0xeb, 0xe7, 0xfd, 0xeb, 0xf7, 0x79, 0x8c, 0x5e, 0x8c, 0xa5, 0x9e, 0x48, 0xfd,
+	0x19, 0x78, 0x71, 0x62, 0x80, 0x15, 0x72, 0x06, 0x4a, 0x50, 0x31, 0x06, 0xcd, 0x3e, 0xa5, 0x33,
+	0x69, 0xb8, 0x28, 0xd2, 0x59, 0xdf, 0x5a, 0xa0, 0x72, 0x25, 0x4b, 0x48, 0x2a, 0x25, 0x8d, 0xc4,
+	0x4f, 0xff, 0x91, 0x97, 0xd4, 0x79, 0xc9, 0xac, 0xbf, 0xb3, 0x49, 0x27, 0x5c, 0xc8, 0xd4, 0x7d,
+	0xeb, 0xcc, 0x9d, 0xb0, 0xce, 0x4c, 0x47, 0x54, 0x43, 0x3a, 0xeb, 0x8f, 0xc0, 0xd0, 0x7e, 0x3a,
+	0x96, 0x5c, 0x78, 0xff, 0x56, 0x21, 0x0b, 0xe9, 0xcc, 0xd4, 0x5a, 0x35, 0xba, 0x77, 0xd9, 0x46,
+	0x9d, 0x0f, 0x76, 0x88, 0x4c, 0x96, 0x80, 0x37, 0x50, 0x93, 0x33, 0x12, 0x44, 0x41, 0xdc, 0xce,
+	0x9a, 0x9c, 0x61, 0x82, 0x56, 0x29, 0x63, 0x0a, 0xb4, 0x26, 0xcd, 0x28, 0x88, 0x3b, 0xd9, 0xed,
+	0x11, 0x63, 0xd4, 0xb6, 0x53, 0x93, 0x96, 0x83, 0x9d, 0x8d, 0x43, 0x84, 0x26, 0xd3, 0xd2, 0xf0,
+	0xaa, 0xe4, 0xa0, 0x48, 0xdb, 0x79, 0xee, 0x21, 0x78, 0x1b, 0xad, 0x51, 0xc6, 0x80, 0xe5, 0xd4,
+	0x90, 0xff, 0xa2, 0x20, 0x6e, 0xb9, 0x72, 0xc0, 0x06, 0xe6, 0xce, 0x35, 0x9a, 0x93, 0x95, 0x45,
+	0x27, 0x60, 0xc3, 0xb9, 0x9d, 0x61, 0xac, 0x80, 0x1a, 0xa9, 0xc8, 0x6a, 0xed, 0xf1, 0x47, 0x5c,
+	0xa1, 0xad, 0x89, 0x2e, 0x72, 0x33, 0xaf, 0x20, 0xbf, 0x6b, 0xa3, 0xc9, 0x5a, 0xd4, 0x8a, 0xbb,
+	0x87, 0x2f, 0x93, 0x07, 0xad, 0x32, 0x39, 0xd2, 0xc5, 0xfb, 0x79, 0x05, 0x47, 0x8b, 0x02, 0xc3,
+	0xf6, 0xf9, 0xe5, 0x6e, 0x23, 0xc3, 0x93, 0x65, 0x87, 0xc6, 0xbb, 0xa8, 0x3b, 0x03, 0x23, 0xf3,
+	0x13, 0x59, 0x32, 0x50, 0xa4, 0x13, 0x05, 0xf1, 0x5a, 0x86, 0x2c, 0xf4, 0xd6, 0x21, 0xf8, 0x09,
+	0x5a, 0xa7, 0x53, 0x23, 0x73, 0xaa, 0x35, 0x2f, 0x04, 0x30, 0x82, 0x5c, 0x48, 0xcf, 0x82, 0x03,
+	0x8f, 0xe1, 0x63, 0x84, 0xf4, 0x54, 0x57, 0x20, 0x34, 0x97, 0x82, 0x74, 0xa3, 0x20, 0xee, 0x1e,
+	0x3e, 0x7f, 0xe0, 0xb4, 0x96, 0xa6, 0x77, 0x8b, 0xe4, 0xec, 0x5e, 0x21, 0x3c, 0x45, 0xed, 0x91,
+	0x14, 0x8c, 0xf4, 0xdc, 0xf5, 0xb7, 0x7d, 0xc1, 0xc4, 0xea, 0x21, 0xf1, 0x7a, 0x48, 0x5e, 0x49,
+	0x2e, 0x86, 0xaf, 0xed, 0xfd, 0xbe, 0xff, 0xdc, 0x8d, 0x0b, 0x6e, 0x4e, 0xa6, 0xa3, 0x64, 0x2c,
+	0x27, 0xa9, 0x17, 0x4f, 0xfd, 0x3b, 0xb0, 0x22, 0xb5, 0x6b, 0xd5, 0x2e, 0x41, 0x7f, 0xbd, 0x39,
+	0xdb, 0xef, 0x95, 0x50, 0xd0, 0xf1, 0x3c, 0xb7, 0x8a, 0xd2, 0xdf, 0x6e, 0xce, 0xf6, 0x83, 0xcc,
+	0xb5, 0xc3, 0x8f, 0x10, 0x82, 0xd3, 0x8a, 0x2b, 0xd0, 0x96, 0xd7, 0x75, 0xc7, 0x6b, 0xc7, 0x23,
+	0x03, 0xb3, 0x27, 0xd0, 0xc6, 0x9f, 0x33, 0xe3, 0xff, 0xd1, 0x8a, 0x02, 0xaa, 0xa5, 0x70, 0x42,
+	0xeb, 0x64, 0xfe, 0x84, 0x1f, 0xa3, 0x5e, 0x7d, 0x1b, 0x2f, 0x91, 0xa6, 0x2b, 0xd5, 0x5d, 0x60,
+	0x03, 0x63, 0x43, 0x14, 0x70, 0xa1, 0x0d, 0x35, 0x60, 0x43, 0x5a, 0x75, 0xc8, 0x02, 0x1b, 0x98,
+	0xbd, 0x63, 0xb4, 0xf9, 0x17, 0xa3, 0x38, 0x42, 0xbd, 0x85, 0x52, 0xa6, 0xaa, 0xf4, 0x8d, 0x91,
+	0x67, 0xf8, 0x58, 0x95, 0x4b, 0xda, 0x6d, 0x2e, 0x6b, 0x77, 0xf8, 0xe6, 0xfc, 0x2a, 0x0c, 0x2e,
+	0xae, 0xc2, 0xe0, 0xd7, 0x55, 0x18, 0x7c, 0xb9, 0x0e, 0x1b, 0x17, 0xd7, 0x61, 0xe3, 0xc7, 0x75,
+	0xd8, 0xf8, 0x78, 0xe0, 0x77, 0x76, 0xcb, 0xdc, 0xc1, 0x1d, 0x75, 0x6e, 0x8f, 0xa7, 0xb7, 0xcf,
+	0xdd, 0x2d, 0x74, 0xb4, 0xe2, 0xde, 0xdd, 0xb3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x39,
+	0x66, 0x91, 0x21, 0x04, 0x00, 0x00,
 }
 
 func (m *VoterRole) Marshal() (dAtA []byte, err error) {
@@ -164,6 +363,71 @@ func (m *VoterRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintVoterRole(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Bond) > 0 {
+		for iNdEx := len(m.Bond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoterRole(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.Suspension != nil {
+		{
+			size, err := m.Suspension.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVoterRole(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.AutoAssigned {
+		i--
+		if m.AutoAssigned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.VetoHolder {
+		i--
+		if m.VetoHolder {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.MsgTypeMultipliers) > 0 {
+		for iNdEx := len(m.MsgTypeMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgTypeMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoterRole(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -212,6 +476,83 @@ func (m *VoterRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RoleSuspension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleSuspension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleSuspension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReinstateAt != 0 {
+		i = encodeVarintVoterRole(dAtA, i, uint64(m.ReinstateAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SuspendedAt != 0 {
+		i = encodeVarintVoterRole(dAtA, i, uint64(m.SuspendedAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintVoterRole(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTypeMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTypeMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTypeMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Multiplier) > 0 {
+		i -= len(m.Multiplier)
+		copy(dAtA[i:], m.Multiplier)
+		i = encodeVarintVoterRole(dAtA, i, uint64(len(m.Multiplier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintVoterRole(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVoterRole(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVoterRole(v)
 	base := offset
@@ -255,6 +596,67 @@ func (m *VoterRole) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovVoterRole(uint64(l))
 	}
+	if len(m.MsgTypeMultipliers) > 0 {
+		for _, e := range m.MsgTypeMultipliers {
+			l = e.Size()
+			n += 1 + l + sovVoterRole(uint64(l))
+		}
+	}
+	if m.VetoHolder {
+		n += 2
+	}
+	if m.AutoAssigned {
+		n += 2
+	}
+	if m.Suspension != nil {
+		l = m.Suspension.Size()
+		n += 1 + l + sovVoterRole(uint64(l))
+	}
+	if len(m.Bond) > 0 {
+		for _, e := range m.Bond {
+			l = e.Size()
+			n += 1 + l + sovVoterRole(uint64(l))
+		}
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovVoterRole(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *RoleSuspension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovVoterRole(uint64(l))
+	}
+	if m.SuspendedAt != 0 {
+		n += 1 + sovVoterRole(uint64(m.SuspendedAt))
+	}
+	if m.ReinstateAt != 0 {
+		n += 1 + sovVoterRole(uint64(m.ReinstateAt))
+	}
+	return n
+}
+
+func (m *MsgTypeMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovVoterRole(uint64(l))
+	}
+	l = len(m.Multiplier)
+	if l > 0 {
+		n += 1 + l + sovVoterRole(uint64(l))
+	}
 	return n
 }
 
@@ -491,6 +893,403 @@ func (m *VoterRole) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoterRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoterRole
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoterRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeMultipliers = append(m.MsgTypeMultipliers, MsgTypeMultiplier{})
+			if err := m.MsgTypeMultipliers[len(m.MsgTypeMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoHolder", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoterRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VetoHolder = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoAssigned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoterRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoAssigned = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspension", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoterRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoterRole
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoterRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Suspension == nil {
+				m.Suspension = &RoleSuspension{}
+			}
+			if err := m.Suspension.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoterRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoterRole
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoterRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bond = append(m.Bond, types.Coin{})
+			if err := m.Bond[len(m.Bond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoterRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoterRole(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoterRole
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleSuspension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoterRole
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleSuspension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleSuspension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoterRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoterRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoterRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedAt", wireType)
+			}
+			m.SuspendedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoterRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuspendedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReinstateAt", wireType)
+			}
+			m.ReinstateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoterRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReinstateAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoterRole(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoterRole
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTypeMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoterRole
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTypeMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTypeMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoterRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoterRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoterRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoterRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVoterRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoterRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Multiplier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVoterRole(dAtA[iNdEx:])